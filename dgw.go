@@ -5,17 +5,21 @@ import (
 	"context"
 	"database/sql"
 	_ "embed"
+	"encoding/json"
 	"fmt"
 	"go/format"
 	"os"
+	"path"
 	"slices"
 	"sort"
+	"strconv"
 	"strings"
 	"text/template"
 
 	"github.com/BurntSushi/toml"
 	"github.com/achiku/varfmt"
-	_ "github.com/lib/pq" // postgres
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/stdlib"
 	"github.com/pkg/errors"
 )
 
@@ -29,13 +33,26 @@ type Queryer interface {
 	QueryRowContext(context.Context, string, ...interface{}) *sql.Row
 }
 
-// OpenDB opens database connection
+// OpenDB opens database connection with pgx. connStr is either in URL or
+// keyword/value format, and the parameters missing in it are taken from the
+// libpq environment variables (e.g. PGHOST, PGSERVICE, PGSSLMODE), the
+// service file and the password file, so connStr can even be empty.
 func OpenDB(connStr string) (*sql.DB, error) {
-	conn, err := sql.Open("postgres", connStr)
+	cfg, err := ParseConnConfig(connStr)
+	if err != nil {
+		return nil, err
+	}
+	return stdlib.OpenDB(*cfg), nil
+}
+
+// ParseConnConfig parses connStr into the pgx connection config as OpenDB
+// does, without connecting to the database.
+func ParseConnConfig(connStr string) (*pgx.ConnConfig, error) {
+	cfg, err := pgx.ParseConfig(connStr)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	return conn, nil
+	return cfg, nil
 }
 
 const queryInterface = `
@@ -50,6 +67,25 @@ type Queryer interface {
 }
 `
 
+const pgxQueryInterface = `
+// Queryer pgx compatible query interface
+type Queryer interface {
+	Exec(context.Context, string, ...interface{}) (pgconn.CommandTag, error)
+	Query(context.Context, string, ...interface{}) (pgx.Rows, error)
+	QueryRow(context.Context, string, ...interface{}) pgx.Row
+	SendBatch(context.Context, *pgx.Batch) pgx.BatchResults
+	CopyFrom(context.Context, pgx.Identifier, []string, pgx.CopyFromSource) (int64, error)
+}
+`
+
+// QueryInterface returns the Queryer interface used by the generated code
+func QueryInterface(genCfg *GenConfig) string {
+	if genCfg != nil && genCfg.Driver == DriverPgx {
+		return pgxQueryInterface
+	}
+	return queryInterface
+}
+
 const pgLoadColumnDef = `
 SELECT
     a.attnum AS field_ordinal,
@@ -78,7 +114,8 @@ SELECT
             THEN 'autogenuuid'
         ELSE format_type(a.atttypid, a.atttypmod)
     END AS data_type,
-    a.attidentity AS identity
+    a.attidentity::text AS identity,
+    a.attgenerated::text AS generated
 FROM pg_attribute a
 JOIN ONLY pg_class c ON c.oid = a.attrelid
 JOIN ONLY pg_namespace n ON n.oid = c.relnamespace
@@ -92,6 +129,53 @@ AND a.attnum > 0
 ORDER BY a.attnum
 `
 
+const pgLoadIndexDef = `
+SELECT
+    ic.relname AS index_name,
+    to_json(ARRAY(
+        SELECT CASE WHEN i.indkey[k.n - 1] = 0
+                    THEN pg_get_indexdef(i.indexrelid, k.n, true)
+                    ELSE (SELECT a.attname::text FROM pg_attribute a
+                          WHERE a.attrelid = i.indrelid AND a.attnum = i.indkey[k.n - 1])
+               END
+        FROM generate_series(1, i.indnkeyatts) AS k(n)
+        ORDER BY k.n
+    ))::text AS column_names,
+    i.indisunique AS is_unique,
+    i.indisprimary AS is_primary,
+    COALESCE(pg_get_expr(i.indpred, i.indrelid, true), '') AS predicate,
+    am.amname AS method,
+    pg_get_indexdef(i.indexrelid) AS definition
+FROM pg_index i
+JOIN pg_class ic ON ic.oid = i.indexrelid
+JOIN pg_am am ON am.oid = ic.relam
+JOIN ONLY pg_class c ON c.oid = i.indrelid
+JOIN ONLY pg_namespace n ON n.oid = c.relnamespace
+WHERE n.nspname = $1
+AND c.relname = $2
+ORDER BY ic.relname
+`
+
+const pgLoadCheckConstraintDef = `
+SELECT
+    ct.conname AS constraint_name,
+    to_json(ARRAY(
+        SELECT a.attname
+        FROM unnest(ct.conkey) WITH ORDINALITY AS k(attnum, ord)
+        JOIN pg_attribute a ON a.attrelid = ct.conrelid AND a.attnum = k.attnum
+        ORDER BY k.ord
+    ))::text AS column_names,
+    pg_get_constraintdef(ct.oid) AS definition,
+    ct.convalidated AS validated
+FROM pg_constraint ct
+JOIN ONLY pg_class c ON c.oid = ct.conrelid
+JOIN ONLY pg_namespace n ON n.oid = c.relnamespace
+WHERE ct.contype = 'c'
+AND n.nspname = $1
+AND c.relname = $2
+ORDER BY ct.conname
+`
+
 const pgLoadTableDef = `
 SELECT
 c.relkind AS type,
@@ -126,23 +210,36 @@ type PgTable struct {
 	AutoGenPk   bool
 	PrimaryKeys []*PgColumn
 	Columns     []*PgColumn
+	Checks      []*PgCheckConstraint
+	Indexes     []*PgIndex
 }
 
 var autoGenKeyCfg = &AutoKeyMap{
 	Types: []string{"smallserial", "serial", "bigserial", "autogenuuid"},
 }
 
+// serialTypes are the DDL types of the columns assigned by a sequence
+var serialTypes = []string{"smallserial", "serial", "bigserial"}
+
+// setPrimaryKeyInfo sets the primary keys and which columns are generated.
+// AutoGenPk is true if any of the primary keys is generated.
 func (t *PgTable) setPrimaryKeyInfo(cfg *AutoKeyMap) {
 	t.AutoGenPk = false
 	for _, c := range t.Columns {
+		// https://www.postgresql.jp/docs/16/catalog-pg-attribute.html
+		c.AutoGen = c.Identity == "a" || c.Identity == "d"
 		if c.IsPrimaryKey {
 			t.PrimaryKeys = append(t.PrimaryKeys, c)
 			for _, typ := range cfg.Types {
-				// https://www.postgresql.jp/docs/16/catalog-pg-attribute.html
-				if c.DDLType == typ || c.Identity == "a" || c.Identity == "d" {
-					t.AutoGenPk = true
+				if c.DDLType == typ {
+					c.AutoGen = true
 				}
 			}
+			if c.AutoGen {
+				t.AutoGenPk = true
+			}
+		} else if contains(c.DDLType, serialTypes) {
+			c.AutoGen = true
 		}
 	}
 }
@@ -231,6 +328,34 @@ func (t *PgTable) excludeColumns(ex *ExcludeColumns) {
 	t.Columns = cols
 }
 
+// PgCheckConstraint postgres check constraint
+type PgCheckConstraint struct {
+	Name    string
+	Columns []string
+	// Definition is the output of pg_get_constraintdef, e.g. "CHECK ((i >= 0))"
+	Definition string
+	// Expression is the boolean expression of the definition, e.g. "(i >= 0)"
+	Expression string
+	// Validated is false if the constraint is added with NOT VALID, and the
+	// existing rows have not been validated
+	Validated bool
+}
+
+// PgIndex postgres index
+type PgIndex struct {
+	Name string
+	// Columns are the key columns, or the expressions of the expression
+	// index, e.g. "lower(email)"
+	Columns   []string
+	IsUnique  bool
+	IsPrimary bool
+	// Predicate is the WHERE clause of the partial index, or empty
+	Predicate string
+	// Method is the index access method, e.g. "btree" and "gin"
+	Method     string
+	Definition string
+}
+
 // PgColumn postgres columns
 type PgColumn struct {
 	FieldOrdinal int
@@ -241,6 +366,154 @@ type PgColumn struct {
 	DefaultValue sql.NullString
 	IsPrimaryKey bool
 	Identity     string
+	// Generated is attgenerated, "s" for the stored generated columns
+	Generated string
+	// AutoGen is true if the value is assigned by a serial sequence or an
+	// identity, and the column is not written by INSERT
+	AutoGen bool
+	// FormattedType is the type with the type modifier, e.g. "character varying(16)"
+	FormattedType string
+	// MaxLength is the length of character, character varying, bit and bit varying
+	MaxLength int
+	// Precision is the precision of numeric, or the fractional seconds
+	// precision of time, timestamp and interval
+	Precision int
+	// Scale is the scale of numeric
+	Scale int
+}
+
+// Error wrapping styles of the generated code
+const (
+	ErrorWrapPkg  = "pkg"  // errors.WithStack of github.com/pkg/errors
+	ErrorWrapStd  = "std"  // fmt.Errorf with "%w" and the operation and table name
+	ErrorWrapNone = "none" // return the errors as they are
+)
+
+// Database drivers the generated code is written for
+const (
+	DriverPq  = "pq"  // database/sql with github.com/lib/pq
+	DriverPgx = "pgx" // github.com/jackc/pgx/v5
+)
+
+// Styles of the Go types of nullable columns
+const (
+	NullStyleLegacy  = "legacy"  // nullable_go_type of the type map
+	NullStyleGeneric = "generic" // sql.Null[T] of notnull_go_type
+	NullStylePointer = "pointer" // *T of notnull_go_type
+)
+
+// Go types of numeric columns
+const (
+	NumericFloat   = "float64" // the type map as it is, float64 by default
+	NumericDecimal = "decimal" // decimal.Decimal of github.com/shopspring/decimal
+)
+
+// Handling of the columns with defaults on insert
+const (
+	DefaultsSend = "send" // send the values of the fields as they are
+	DefaultsZero = "zero" // leave out the columns whose fields are zero
+	DefaultsOmit = "omit" // always leave out the columns
+)
+
+// Clocks of the created_at and updated_at columns
+const (
+	TimestampClockDB = "db" // now() of the database, scanned back by RETURNING
+	TimestampClockGo = "go" // Now() of the generated code, which can be replaced in tests
+)
+
+// Kinds of the timestamp columns maintained by the generated writes
+const (
+	TimestampCreated = "created" // set by INSERT
+	TimestampUpdated = "updated" // set by INSERT and UPDATE
+)
+
+// GenConfig holds the options of the generated code
+type GenConfig struct {
+	ErrorWrap string
+	Driver    string
+	NullStyle string
+	Numeric   string
+	Defaults  string
+	// IndexLookup generates ListTByColumnContext for the leading columns of the indexes
+	IndexLookup bool
+	// OverridingSystemValue generates CreateOverridingSystemValueContext
+	// inserting the values of the generated keys and identities as they are
+	OverridingSystemValue bool
+	// VersionColumns are the version columns of the optimistic locking, either
+	// "column" for every table having it or "table.column"
+	VersionColumns []string
+	// SoftDeleteColumn is the nullable timestamp column set by the delete
+	// methods instead of deleting the row, e.g. "deleted_at"
+	SoftDeleteColumn string
+	// CreatedAtColumns and UpdatedAtColumns are the names or the patterns of
+	// path.Match of the timestamp columns set by the generated writes
+	CreatedAtColumns []string
+	UpdatedAtColumns []string
+	TimestampClock   string
+	// DirtyTracking generates UpdateChangedContext, which updates the columns
+	// changed since the struct was read or written
+	DirtyTracking bool
+	// NoHelper leaves out the helper declarations shared by the generated
+	// code, e.g. for the second output into the same package
+	NoHelper bool
+}
+
+// NewGenConfig creates GenConfig with the default options
+func NewGenConfig() *GenConfig {
+	return &GenConfig{
+		ErrorWrap: ErrorWrapPkg,
+		Driver:    DriverPq,
+		NullStyle: NullStyleLegacy,
+		Numeric:   NumericFloat,
+		Defaults:  DefaultsSend,
+
+		TimestampClock: TimestampClockDB,
+	}
+}
+
+// Validate checks the options
+func (c *GenConfig) Validate() error {
+	switch c.ErrorWrap {
+	case ErrorWrapPkg, ErrorWrapStd, ErrorWrapNone:
+	default:
+		return errors.Errorf("invalid error wrapping style %q", c.ErrorWrap)
+	}
+	switch c.Driver {
+	case DriverPq, DriverPgx:
+	default:
+		return errors.Errorf("invalid driver %q", c.Driver)
+	}
+	switch c.NullStyle {
+	case NullStyleLegacy, NullStyleGeneric, NullStylePointer:
+	default:
+		return errors.Errorf("invalid null style %q", c.NullStyle)
+	}
+	switch c.Numeric {
+	case NumericFloat, NumericDecimal:
+	default:
+		return errors.Errorf("invalid numeric type %q", c.Numeric)
+	}
+	switch c.Defaults {
+	case DefaultsSend, DefaultsZero, DefaultsOmit:
+	default:
+		return errors.Errorf("invalid handling of defaults %q", c.Defaults)
+	}
+	switch c.TimestampClock {
+	case TimestampClockDB, TimestampClockGo:
+	default:
+		return errors.Errorf("invalid timestamp clock %q", c.TimestampClock)
+	}
+	for _, pattern := range append(append([]string{}, c.CreatedAtColumns...), c.UpdatedAtColumns...) {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return errors.Errorf("invalid timestamp column pattern %q", pattern)
+		}
+	}
+	for _, spec := range c.VersionColumns {
+		if spec == "" || strings.HasPrefix(spec, ".") || strings.HasSuffix(spec, ".") {
+			return errors.Errorf(`invalid version column %q: must be "column" or "table.column"`, spec)
+		}
+	}
+	return nil
 }
 
 // Struct go struct
@@ -251,6 +524,12 @@ type Struct struct {
 	Fields     []*StructField
 	Deprecated bool
 	Queryer    string
+	Config     *GenConfig
+	// Version is the field of the version column of the optimistic locking,
+	// or nil
+	Version *StructField
+	// SoftDelete is the field of the soft delete column, or nil
+	SoftDelete *StructField
 }
 
 // StructTmpl go struct passed to template
@@ -258,12 +537,29 @@ type StructTmpl struct {
 	Struct *Struct
 }
 
+// HelperTmpl is passed to the helper template
+type HelperTmpl struct {
+	*GenConfig
+	// Interval is true if any field uses the generated Interval type
+	Interval bool
+	// Version is true if any struct has the version column
+	Version bool
+	// Now is true if any struct sets the timestamp columns by Now(), which is
+	// the Go clock or COPY of the database clock
+	Now bool
+}
+
 // StructField go struct field
 type StructField struct {
 	Name   string
 	Type   string
 	Tag    string
 	Column *PgColumn
+	// Checks are the check constraints of the table referring to the column
+	Checks []*PgCheckConstraint
+	// Timestamp is TimestampCreated or TimestampUpdated if the column is
+	// maintained by the generated writes, or empty
+	Timestamp string
 }
 
 // PgLoadTypeMapFromFile load type map from toml file
@@ -294,22 +590,123 @@ func PgLoadColumnDef(db Queryer, schema string, table string) ([]*PgColumn, erro
 			&c.IsPrimaryKey,
 			&c.DDLType,
 			&c.Identity,
+			&c.Generated,
 		)
 		if err != nil {
 			return nil, errors.WithStack(err)
 		}
 
-		// Some data types have an extra part e.g, "character varying(16)" and
-		// "numeric(10, 5)". We want to drop the extra part.
-		if i := strings.Index(c.DataType, "("); i > 0 {
-			c.DataType = c.DataType[0:i]
-		}
+		// Some data types have a type modifier e.g, "character varying(16)" and
+		// "numeric(10,5)". The base type is used for the type mapping.
+		c.FormattedType = c.DataType
+		var mods []int
+		c.DataType, mods = pgParseFormattedType(c.FormattedType)
+		pgSetTypeModifiers(c, mods)
 
 		cols = append(cols, c)
 	}
 	return cols, nil
 }
 
+// PgLoadCheckConstraintDef load Postgres check constraint definition
+func PgLoadCheckConstraintDef(db Queryer, schema string, table string) ([]*PgCheckConstraint, error) {
+	ckDefs, err := db.Query(pgLoadCheckConstraintDef, schema, table)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	cks := []*PgCheckConstraint{}
+	for ckDefs.Next() {
+		ck := &PgCheckConstraint{}
+		var colNames string
+		err := ckDefs.Scan(
+			&ck.Name,
+			&colNames,
+			&ck.Definition,
+			&ck.Validated,
+		)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		ck.Expression = strings.TrimSuffix(strings.TrimPrefix(ck.Definition, "CHECK "), " NOT VALID")
+		if err := json.Unmarshal([]byte(colNames), &ck.Columns); err != nil {
+			return nil, errors.WithStack(err)
+		}
+		cks = append(cks, ck)
+	}
+	return cks, nil
+}
+
+// PgLoadIndexDef load Postgres index definition
+func PgLoadIndexDef(db Queryer, schema string, table string) ([]*PgIndex, error) {
+	idxDefs, err := db.Query(pgLoadIndexDef, schema, table)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	idxs := []*PgIndex{}
+	for idxDefs.Next() {
+		idx := &PgIndex{}
+		var colNames string
+		err := idxDefs.Scan(
+			&idx.Name,
+			&colNames,
+			&idx.IsUnique,
+			&idx.IsPrimary,
+			&idx.Predicate,
+			&idx.Method,
+			&idx.Definition,
+		)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		if err := json.Unmarshal([]byte(colNames), &idx.Columns); err != nil {
+			return nil, errors.WithStack(err)
+		}
+		idxs = append(idxs, idx)
+	}
+	return idxs, nil
+}
+
+// pgParseFormattedType splits the output of format_type into the base type
+// and the type modifier, e.g. "timestamp(3) with time zone" into
+// "timestamp with time zone" and [3]
+func pgParseFormattedType(formatted string) (string, []int) {
+	i := strings.Index(formatted, "(")
+	j := strings.Index(formatted, ")")
+	if i <= 0 || j < i {
+		return formatted, nil
+	}
+	var mods []int
+	for _, m := range strings.Split(formatted[i+1:j], ",") {
+		n, err := strconv.Atoi(strings.TrimSpace(m))
+		if err != nil {
+			return formatted[:i] + formatted[j+1:], nil
+		}
+		mods = append(mods, n)
+	}
+	return formatted[:i] + formatted[j+1:], mods
+}
+
+// pgSetTypeModifiers sets the type modifier to MaxLength, Precision and Scale
+// of the column by its base type
+func pgSetTypeModifiers(c *PgColumn, mods []int) {
+	if len(mods) == 0 {
+		return
+	}
+	switch typ := strings.TrimSuffix(c.DataType, "[]"); {
+	case typ == "character varying", typ == "character", typ == "bit", typ == "bit varying":
+		c.MaxLength = mods[0]
+	case typ == "numeric":
+		c.Precision = mods[0]
+		if len(mods) > 1 {
+			c.Scale = mods[1]
+		}
+	case strings.HasPrefix(typ, "time"), strings.HasPrefix(typ, "interval"):
+		c.Precision = mods[0]
+	}
+}
+
 // PgLoadTableDef load Postgres table definition
 func PgLoadTableDef(db Queryer, schema string) ([]*PgTable, error) {
 	tbDefs, err := db.Query(pgLoadTableDef, schema)
@@ -331,6 +728,16 @@ func PgLoadTableDef(db Queryer, schema string) ([]*PgTable, error) {
 			return nil, errors.Wrap(err, fmt.Sprintf("failed to get columns of %s", t.Name))
 		}
 		t.Columns = cols
+		cks, err := PgLoadCheckConstraintDef(db, schema, t.Name)
+		if err != nil {
+			return nil, errors.Wrap(err, fmt.Sprintf("failed to get check constraints of %s", t.Name))
+		}
+		t.Checks = cks
+		idxs, err := PgLoadIndexDef(db, schema, t.Name)
+		if err != nil {
+			return nil, errors.Wrap(err, fmt.Sprintf("failed to get indexes of %s", t.Name))
+		}
+		t.Indexes = idxs
 		tbs = append(tbs, t)
 	}
 	return tbs, nil
@@ -345,8 +752,21 @@ func contains(v string, l []string) bool {
 	return false
 }
 
+// usesInterval returns true if any field of the struct is the generated
+// Interval type, which may be wrapped by the null style
+func usesInterval(st *Struct) bool {
+	for _, f := range st.Fields {
+		typ := strings.TrimPrefix(f.Type, "*")
+		typ = strings.TrimSuffix(strings.TrimPrefix(typ, "sql.Null["), "]")
+		if typ == "Interval" {
+			return true
+		}
+	}
+	return false
+}
+
 // PgConvertType converts type
-func PgConvertType(col *PgColumn, typeCfg *PgTypeMapConfig) string {
+func PgConvertType(col *PgColumn, typeCfg *PgTypeMapConfig, nullStyle string) string {
 	cfg := map[string]TypeMap(*typeCfg)
 	typ := cfg["default"].NotNullGoType
 	for _, v := range cfg {
@@ -354,15 +774,46 @@ func PgConvertType(col *PgColumn, typeCfg *PgTypeMapConfig) string {
 			if col.NotNull {
 				return v.NotNullGoType
 			}
-			return v.NullableGoType
+			return nullableGoType(v, nullStyle)
 		}
 	}
 	return typ
 }
 
+// nullableGoType returns the Go type of a nullable column in the null style.
+// The generic and pointer styles derive it from notnull_go_type, except for
+// the types which can hold NULL by themselves.
+func nullableGoType(tm TypeMap, nullStyle string) string {
+	typ := tm.NotNullGoType
+	switch nullStyle {
+	case NullStyleGeneric, NullStylePointer:
+		if isNilableGoType(typ) {
+			return typ
+		}
+		if nullStyle == NullStyleGeneric {
+			return "sql.Null[" + typ + "]"
+		}
+		return "*" + typ
+	default:
+		return tm.NullableGoType
+	}
+}
+
+// isNilableGoType returns true if the Go type can represent NULL without
+// wrapping, i.e. slices, pointers, interfaces and the pgtype types of pgx
+// which have the Valid field.
+func isNilableGoType(typ string) bool {
+	for _, p := range []string{"[]", "*", "map[", "pgtype."} {
+		if strings.HasPrefix(typ, p) {
+			return true
+		}
+	}
+	return typ == "interface{}" || typ == "any"
+}
+
 // PgColToField converts pg column to go struct field
-func PgColToField(col *PgColumn, typeCfg *PgTypeMapConfig) (*StructField, error) {
-	stfType := PgConvertType(col, typeCfg)
+func PgColToField(col *PgColumn, typeCfg *PgTypeMapConfig, nullStyle string) (*StructField, error) {
+	stfType := PgConvertType(col, typeCfg, nullStyle)
 	stf := &StructField{
 		Name:   varfmt.PublicVarName(col.Name),
 		Type:   stfType,
@@ -372,30 +823,180 @@ func PgColToField(col *PgColumn, typeCfg *PgTypeMapConfig) (*StructField, error)
 }
 
 // PgTableToStruct converts table def to go struct
-func PgTableToStruct(t *PgTable, typeCfg *PgTypeMapConfig, keyConfig *AutoKeyMap, deprecated []string, queryer string, exCols *ExcludeColumns) (*Struct, error) {
+func PgTableToStruct(t *PgTable, typeCfg *PgTypeMapConfig, keyConfig *AutoKeyMap, deprecated []string, queryer string, exCols *ExcludeColumns, genCfg *GenConfig) (*Struct, error) {
 	t.excludeColumns(exCols)
 	t.setPrimaryKeyInfo(keyConfig)
 	if queryer == "" {
 		queryer = "Queryer"
 	}
+	if genCfg == nil {
+		genCfg = NewGenConfig()
+	}
 	s := &Struct{
 		Name:       varfmt.PublicVarName(t.Name),
 		Table:      t,
 		Deprecated: slices.Contains(deprecated, t.Name),
 		Queryer:    queryer,
+		Config:     genCfg,
 	}
 	var fs []*StructField
 	for _, c := range t.Columns {
-		f, err := PgColToField(c, typeCfg)
+		f, err := PgColToField(c, typeCfg, genCfg.NullStyle)
 		if err != nil {
 			return nil, errors.WithStack(err)
 		}
+		for _, ck := range t.Checks {
+			if slices.Contains(ck.Columns, c.Name) {
+				f.Checks = append(f.Checks, ck)
+			}
+		}
+		f.Timestamp = timestampKind(c, genCfg)
+		if f.Timestamp != "" && genCfg.TimestampClock == TimestampClockGo && nowAssignment(f) == "" {
+			return nil, errors.Errorf("timestamp column %s.%s of %s cannot be set by the Go clock", t.Name, c.Name, f.Type)
+		}
 		fs = append(fs, f)
 	}
 	s.Fields = fs
+	v, err := versionField(s, genCfg.VersionColumns)
+	if err != nil {
+		return nil, err
+	}
+	s.Version = v
+	s.SoftDelete = softDeleteField(s, genCfg.SoftDeleteColumn)
 	return s, nil
 }
 
+// timestampKind returns the kind of the timestamp column maintained by the
+// generated writes, or empty. created_at takes precedence over updated_at, and
+// the soft delete column is never maintained.
+func timestampKind(c *PgColumn, genCfg *GenConfig) string {
+	if c.IsPrimaryKey || c.AutoGen || c.Generated != "" || !contains(c.DataType, softDeleteTypes) ||
+		c.Name == genCfg.SoftDeleteColumn {
+		return ""
+	}
+	match := func(patterns []string) bool {
+		for _, p := range patterns {
+			if ok, _ := path.Match(p, c.Name); ok {
+				return true
+			}
+		}
+		return false
+	}
+	switch {
+	case match(genCfg.CreatedAtColumns):
+		return TimestampCreated
+	case match(genCfg.UpdatedAtColumns):
+		return TimestampUpdated
+	}
+	return ""
+}
+
+// softDeleteTypes are the types of the soft delete columns
+var softDeleteTypes = []string{"timestamp with time zone", "timestamp without time zone"}
+
+// softDeleteField returns the field of the soft delete column of the struct,
+// which must be a nullable timestamp out of the primary key.
+func softDeleteField(st *Struct, name string) *StructField {
+	if name == "" {
+		return nil
+	}
+	for _, f := range st.Fields {
+		c := f.Column
+		if c.Name == name && !c.NotNull && !c.IsPrimaryKey && contains(c.DataType, softDeleteTypes) {
+			return f
+		}
+	}
+	return nil
+}
+
+// versionTypes are the types of the version columns
+var versionTypes = []string{"smallint", "integer", "bigint"}
+
+// versionField returns the field of the version column of the struct given by
+// the specs. "table.column" takes precedence over "column", and the column
+// given by "column" is skipped unless it is a NOT NULL integer.
+func versionField(st *Struct, specs []string) (*StructField, error) {
+	find := func(name string) *StructField {
+		for _, f := range st.Fields {
+			if f.Column.Name == name {
+				return f
+			}
+		}
+		return nil
+	}
+	isVersion := func(f *StructField) bool {
+		return f.Column.NotNull && !f.Column.IsPrimaryKey && contains(f.Column.DataType, versionTypes)
+	}
+	for _, spec := range specs {
+		if i := strings.LastIndex(spec, "."); i >= 0 && spec[:i] == st.Table.Name {
+			f := find(spec[i+1:])
+			if f == nil {
+				return nil, errors.Errorf("version column %q not found", spec)
+			}
+			if !isVersion(f) {
+				return nil, errors.Errorf("version column %q must be a NOT NULL integer out of the primary key", spec)
+			}
+			return f, nil
+		}
+	}
+	for _, spec := range specs {
+		if strings.Contains(spec, ".") {
+			continue
+		}
+		if f := find(spec); f != nil && isVersion(f) {
+			return f, nil
+		}
+	}
+	return nil, nil
+}
+
+// validateVersionColumns returns an error if a "table.column" spec of the
+// version columns does not name a NOT NULL integer column out of the primary
+// key of a table in tbls, which would otherwise be ignored.
+func validateVersionColumns(specs []string, tbls []*PgTable) error {
+	for _, spec := range specs {
+		i := strings.LastIndex(spec, ".")
+		if i < 0 {
+			continue
+		}
+		tblName, colName := spec[:i], spec[i+1:]
+		var tbl *PgTable
+		for _, t := range tbls {
+			if t.Name == tblName {
+				tbl = t
+			}
+		}
+		if tbl == nil {
+			return errors.Errorf("version column %q: no such table %s", spec, tblName)
+		}
+		var col *PgColumn
+		for _, c := range tbl.Columns {
+			if c.Name == colName {
+				col = c
+			}
+		}
+		if col == nil {
+			return errors.Errorf("version column %q not found", spec)
+		}
+		if !col.NotNull || col.IsPrimaryKey || !contains(col.DataType, versionTypes) {
+			return errors.Errorf("version column %q must be a NOT NULL integer out of the primary key", spec)
+		}
+	}
+	return nil
+}
+
+// checkMethodNames rejects the fields named after the methods generated on
+// the struct by the default method template, which would not compile.
+func checkMethodNames(st *Struct) error {
+	for _, f := range st.Fields {
+		if slices.Contains(methodNames(st), f.Name) {
+			return errors.Errorf("column %s.%s collides with the generated method %s.%s, exclude it with --exclude-column",
+				st.Table.Name, f.Column.Name, st.Name, f.Name)
+		}
+	}
+	return nil
+}
+
 //go:embed template/struct.tmpl
 var structTemplate string
 
@@ -440,6 +1041,55 @@ func PgExecuteDefaultMethodTmpl(st *StructTmpl) ([]byte, error) {
 	return src, nil
 }
 
+//go:embed template/helper.tmpl
+var helperTemplate string
+
+// PgExecuteDefaultHelperTmpl execute helper template, which is used by the
+// code of the default method template and emitted once per output
+func PgExecuteDefaultHelperTmpl(ht *HelperTmpl) ([]byte, error) {
+	var src []byte
+
+	tpl, err := template.New("helper").Funcs(tmplFuncMap).Parse(helperTemplate)
+	if err != nil {
+		return src, errors.WithStack(err)
+	}
+	if _, err := tpl.New("interval").Parse(intervalTemplate); err != nil {
+		return src, errors.WithStack(err)
+	}
+	buf := new(bytes.Buffer)
+	if err := tpl.Execute(buf, ht); err != nil {
+		return src, errors.Wrap(err, fmt.Sprintf("failed to execute template:\n%s", src))
+	}
+	src, err = format.Source(buf.Bytes())
+	if err != nil {
+		return src, errors.Wrap(err, fmt.Sprintf("failed to format code:\n%s", src))
+	}
+	return src, nil
+}
+
+//go:embed template/interval.tmpl
+var intervalTemplate string
+
+// PgExecuteDefaultIntervalTmpl execute interval template, which declares the
+// Interval type of the interval columns as a part of the helper template
+func PgExecuteDefaultIntervalTmpl() ([]byte, error) {
+	var src []byte
+
+	tpl, err := template.New("interval").Parse(intervalTemplate)
+	if err != nil {
+		return src, errors.WithStack(err)
+	}
+	buf := new(bytes.Buffer)
+	if err := tpl.Execute(buf, nil); err != nil {
+		return src, errors.Wrap(err, fmt.Sprintf("failed to execute template:\n%s", src))
+	}
+	src, err = format.Source(buf.Bytes())
+	if err != nil {
+		return src, errors.Wrap(err, fmt.Sprintf("failed to format code:\n%s", src))
+	}
+	return src, nil
+}
+
 // PgExecuteCustomTmpl execute custom template
 func PgExecuteCustomTmpl(st *StructTmpl, customTmpl string) ([]byte, error) {
 	var src []byte
@@ -460,18 +1110,31 @@ func PgExecuteCustomTmpl(st *StructTmpl, customTmpl string) ([]byte, error) {
 
 // PgCreateStruct creates struct from given schema
 func PgCreateStruct(
-	db Queryer, schema, typeMapPath, pkgName, customTmpl string, exTbls []string, exColList []string, autoGenKeyList []string, deprecated []string, queryer string) ([]byte, error) {
+	db Queryer, schema, typeMapPath, pkgName, customTmpl string, exTbls []string, exColList []string, autoGenKeyList []string, deprecated []string, queryer string, genCfg *GenConfig) ([]byte, error) {
 	src := []byte("// Code generated by dgw. DO NOT EDIT.\n\n")
 	pkgDef := []byte(fmt.Sprintf("package %s\n\n", pkgName))
 	src = append(src, pkgDef...)
 
+	if genCfg == nil {
+		genCfg = NewGenConfig()
+	}
+	if err := genCfg.Validate(); err != nil {
+		return src, errors.WithStack(err)
+	}
+
 	tbls, err := PgLoadTableDef(db, schema)
 	if err != nil {
 		return src, errors.WithStack(err)
 	}
 	cfg := &PgTypeMapConfig{}
 	if typeMapPath == "" {
-		if _, err := toml.Decode(typeMap, cfg); err != nil {
+		tm := typeMap
+		if genCfg.Driver == DriverPgx {
+			tm = pgxTypeMap
+		} else if genCfg.NullStyle != NullStyleLegacy {
+			tm = sizedTypeMap
+		}
+		if _, err := toml.Decode(tm, cfg); err != nil {
 			return src, errors.WithStack(err)
 		}
 	} else {
@@ -479,6 +1142,9 @@ func PgCreateStruct(
 			return src, errors.Wrap(err, fmt.Sprintf("failed to decode type map file %s", typeMapPath))
 		}
 	}
+	if genCfg.Numeric == NumericDecimal {
+		cfg.set("decimal", decimalTypeMap)
+	}
 	agkCfg := autoGenKeyCfg
 	if len(autoGenKeyList) > 0 {
 		agkCfg = &AutoKeyMap{
@@ -492,14 +1158,21 @@ func PgCreateStruct(
 	if err := exCols.Validate(tbls); err != nil {
 		return src, errors.WithStack(err)
 	}
+	if err := validateVersionColumns(genCfg.VersionColumns, tbls); err != nil {
+		return src, err
+	}
+	ht := &HelperTmpl{GenConfig: genCfg}
 	for _, tbl := range tbls {
 		if contains(tbl.Name, exTbls) {
 			continue
 		}
-		st, err := PgTableToStruct(tbl, cfg, agkCfg, deprecated, queryer, exCols)
+		st, err := PgTableToStruct(tbl, cfg, agkCfg, deprecated, queryer, exCols, genCfg)
 		if err != nil {
 			return src, errors.WithStack(err)
 		}
+		ht.Interval = ht.Interval || usesInterval(st)
+		ht.Version = ht.Version || st.Version != nil
+		ht.Now = ht.Now || setNow(st, "create") != "" || (st.Config.Driver == DriverPgx && setCopyNow(st) != "")
 		if customTmpl != "" {
 			tmpl, err := os.ReadFile(customTmpl)
 			if err != nil {
@@ -511,6 +1184,9 @@ func PgCreateStruct(
 			}
 			src = append(src, s...)
 		} else {
+			if err := checkMethodNames(st); err != nil {
+				return src, err
+			}
 			s, err := PgExecuteDefaultStructTmpl(&StructTmpl{Struct: st})
 			if err != nil {
 				return src, errors.WithStack(err)
@@ -523,6 +1199,20 @@ func PgCreateStruct(
 			src = append(src, m...)
 		}
 	}
+	if !genCfg.NoHelper && (customTmpl == "" || ht.Interval) {
+		var h []byte
+		if customTmpl == "" {
+			h, err = PgExecuteDefaultHelperTmpl(ht)
+		} else {
+			// the custom template has no helper, but its fields still use Interval
+			h, err = PgExecuteDefaultIntervalTmpl()
+		}
+		if err != nil {
+			return src, errors.WithStack(err)
+		}
+		src = append(src, '\n')
+		src = append(src, h...)
+	}
 	// WORKAROUND: `format.Source()` strips empty comments (e.g., lines with only `//`), which can break code generation
 	// See Go issue https://github.com/golang/go/issues/54489 for details.
 	// This workaround replaces the placeholder `// %EMPTY_COMMENT%` with a bare comment after formatting.
@@ -3,30 +3,43 @@ package main
 import (
 	"database/sql"
 	"os"
+	"os/exec"
 	"path/filepath"
 	"regexp"
 	"testing"
 
-	_ "github.com/lib/pq"
+	"github.com/BurntSushi/toml"
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
+	"golang.org/x/tools/imports"
 )
 
 // before running test, create user and database
 // CREATE USER dgw_test;
 // CREATE DATABASE  dgw_test OWNER dgw_test;
 
-func testPgSetup(t *testing.T) (*sql.DB, func()) {
-	conn, err := sql.Open("postgres", "user=dgw_test dbname=dgw_test sslmode=disable")
+const testPgConnStr = "host=localhost user=dgw_test dbname=dgw_test sslmode=disable"
+
+// testPgSetup creates the tables of sql/test.sql, and then the ones of
+// sql/test_<fixture>.sql for each of the fixtures.
+func testPgSetup(t *testing.T, fixtures ...string) (*sql.DB, func()) {
+	conn, err := OpenDB(testPgConnStr)
 	if err != nil {
 		t.Fatal(err)
 	}
-	setupSQL, err := os.ReadFile(filepath.Join("sql", "test.sql"))
-	if err != nil {
-		t.Fatal(err)
+	files := []string{"test.sql"}
+	for _, f := range fixtures {
+		files = append(files, "test_"+f+".sql")
 	}
-	_, err = conn.Exec(string(setupSQL))
-	if err != nil {
-		t.Fatal(err)
+	for _, f := range files {
+		setupSQL, err := os.ReadFile(filepath.Join("sql", f))
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = conn.Exec(string(setupSQL))
+		if err != nil {
+			t.Fatal(err)
+		}
 	}
 	cleanup := func() {
 		conn.Close()
@@ -43,7 +56,7 @@ func testSetupStruct(t *testing.T, conn *sql.DB) []*Struct {
 
 	var sts []*Struct
 	for _, tbl := range tbls {
-		st, err := PgTableToStruct(tbl, &defaultTypeMapCfg, autoGenKeyCfg, []string{}, "", nil)
+		st, err := PgTableToStruct(tbl, &defaultTypeMapCfg, autoGenKeyCfg, []string{}, "", nil, nil)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -52,6 +65,96 @@ func testSetupStruct(t *testing.T, conn *sql.DB) []*Struct {
 	return sts
 }
 
+func TestParseConnConfig(t *testing.T) {
+	serviceFile := filepath.Join(t.TempDir(), "pg_service.conf")
+	err := os.WriteFile(serviceFile, []byte("[dgw]\nhost=service.example.com\nport=5434\nuser=service_user\ndbname=service_db\nsslmode=require\n"), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name     string
+		connStr  string
+		env      map[string]string
+		host     string
+		port     uint16
+		user     string
+		database string
+		tls      bool
+	}{
+		{
+			name:     "url",
+			connStr:  "postgres://dgw_test@localhost:5433/dgw_test?sslmode=disable",
+			host:     "localhost",
+			port:     5433,
+			user:     "dgw_test",
+			database: "dgw_test",
+		},
+		{
+			name:     "keyword/value",
+			connStr:  "host=localhost user=dgw_test dbname=dgw_test sslmode=disable",
+			host:     "localhost",
+			port:     5432,
+			user:     "dgw_test",
+			database: "dgw_test",
+		},
+		{
+			name:     "environment variables",
+			env:      map[string]string{"PGHOST": "db.example.com", "PGPORT": "5433", "PGUSER": "env_user", "PGDATABASE": "env_db", "PGSSLMODE": "disable"},
+			host:     "db.example.com",
+			port:     5433,
+			user:     "env_user",
+			database: "env_db",
+		},
+		{
+			name:     "connection string over environment variables",
+			connStr:  "host=localhost dbname=dgw_test",
+			env:      map[string]string{"PGHOST": "db.example.com", "PGUSER": "env_user", "PGSSLMODE": "disable"},
+			host:     "localhost",
+			port:     5432,
+			user:     "env_user",
+			database: "dgw_test",
+		},
+		{
+			name:     "service file",
+			env:      map[string]string{"PGSERVICEFILE": serviceFile, "PGSERVICE": "dgw"},
+			host:     "service.example.com",
+			port:     5434,
+			user:     "service_user",
+			database: "service_db",
+			tls:      true,
+		},
+		{
+			name:     "sslmode",
+			connStr:  "postgres://dgw_test@localhost/dgw_test?sslmode=require",
+			host:     "localhost",
+			port:     5432,
+			user:     "dgw_test",
+			database: "dgw_test",
+			tls:      true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, k := range []string{"PGHOST", "PGPORT", "PGUSER", "PGDATABASE", "PGSSLMODE", "PGSERVICE", "PGSERVICEFILE"} {
+				t.Setenv(k, tt.env[k])
+			}
+			cfg, err := ParseConnConfig(tt.connStr)
+			if err != nil {
+				t.Fatal(err)
+			}
+			assert.Equal(t, tt.host, cfg.Host)
+			assert.Equal(t, tt.port, cfg.Port)
+			assert.Equal(t, tt.user, cfg.User)
+			assert.Equal(t, tt.database, cfg.Database)
+			assert.Equal(t, tt.tls, cfg.TLSConfig != nil)
+		})
+	}
+
+	_, err = ParseConnConfig("postgres://dgw_test@localhost:notaport/dgw_test")
+	assert.Error(t, err)
+}
+
 func TestPgLoadColumnDef(t *testing.T) {
 	conn, cleanup := testPgSetup(t)
 	defer cleanup()
@@ -67,6 +170,36 @@ func TestPgLoadColumnDef(t *testing.T) {
 	}
 }
 
+func TestPgParseFormattedType(t *testing.T) {
+	tests := []struct {
+		formatted string
+		expected  PgColumn
+	}{
+		{"text", PgColumn{DataType: "text"}},
+		{"character varying", PgColumn{DataType: "character varying"}},
+		{"character varying(16)", PgColumn{DataType: "character varying", MaxLength: 16}},
+		{"character varying(16)[]", PgColumn{DataType: "character varying[]", MaxLength: 16}},
+		{"character(3)", PgColumn{DataType: "character", MaxLength: 3}},
+		{"bit varying(8)", PgColumn{DataType: "bit varying", MaxLength: 8}},
+		{"numeric", PgColumn{DataType: "numeric"}},
+		{"numeric(10,5)", PgColumn{DataType: "numeric", Precision: 10, Scale: 5}},
+		{"numeric(10,0)", PgColumn{DataType: "numeric", Precision: 10}},
+		{"timestamp(3) with time zone", PgColumn{DataType: "timestamp with time zone", Precision: 3}},
+		{"time(0) without time zone", PgColumn{DataType: "time without time zone", Precision: 0}},
+		{"interval(6)", PgColumn{DataType: "interval", Precision: 6}},
+		{"geometry(Point,4326)", PgColumn{DataType: "geometry"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.formatted, func(t *testing.T) {
+			c := &PgColumn{}
+			var mods []int
+			c.DataType, mods = pgParseFormattedType(tt.formatted)
+			pgSetTypeModifiers(c, mods)
+			assert.Equal(t, tt.expected, *c)
+		})
+	}
+}
+
 func TestPgLoadTableDef(t *testing.T) {
 	conn, cleanup := testPgSetup(t)
 	defer cleanup()
@@ -81,6 +214,68 @@ func TestPgLoadTableDef(t *testing.T) {
 	}
 }
 
+func TestPgLoadCheckConstraintDef(t *testing.T) {
+	conn, cleanup := testPgSetup(t, "checks")
+	defer cleanup()
+
+	cks, err := PgLoadCheckConstraintDef(conn, "public", "t2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, []*PgCheckConstraint{
+		{
+			Name:       "t2_i_check",
+			Columns:    []string{"i"},
+			Definition: "CHECK ((i >= 0))",
+			Expression: "((i >= 0))",
+			Validated:  true,
+		},
+		{
+			Name:       "t2_str_check",
+			Columns:    []string{"str"},
+			Definition: "CHECK ((length(str) > 0)) NOT VALID",
+			Expression: "((length(str) > 0))",
+			Validated:  false,
+		},
+	}, cks)
+
+	sts := testSetupStruct(t, conn)
+	for _, st := range sts {
+		if st.Table.Name != "t2" {
+			continue
+		}
+		assert.Equal(t, cks, st.Table.Checks)
+		for _, f := range st.Fields {
+			switch f.Column.Name {
+			case "i":
+				assert.Equal(t, cks[:1], f.Checks)
+			case "str":
+				assert.Equal(t, cks[1:], f.Checks)
+			default:
+				assert.Empty(t, f.Checks)
+			}
+		}
+	}
+}
+
+func TestPgCreateStructWithCheckConstraints(t *testing.T) {
+	conn, cleanup := testPgSetup(t, "checks")
+	defer cleanup()
+	assert := assert.New(t)
+
+	src, err := PgCreateStruct(conn, "public", "", "mypkg", "", []string{}, []string{}, []string{}, []string{}, "", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	srcStr := string(src)
+
+	// the comparison is checked by Validate, and the function call is left to the database
+	assert.Contains(srcStr, `	if !(r.I >= 0) {
+		errs = append(errs, FieldError{Field: "I", Column: "i", Constraint: "t2_i_check", Message: "must be >= 0"})
+	}`)
+	assert.NotContains(srcStr, "t2_str_check")
+}
+
 func TestPgColToField(t *testing.T) {
 	conn, cleanup := testPgSetup(t)
 	defer cleanup()
@@ -93,7 +288,7 @@ func TestPgColToField(t *testing.T) {
 	}
 
 	for _, c := range cols {
-		f, err := PgColToField(c, &defaultTypeMapCfg)
+		f, err := PgColToField(c, &defaultTypeMapCfg, NullStyleLegacy)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -101,6 +296,161 @@ func TestPgColToField(t *testing.T) {
 	}
 }
 
+func TestPgConvertTypeNullStyle(t *testing.T) {
+	var sizedTypeMapCfg PgTypeMapConfig
+	if _, err := toml.Decode(sizedTypeMap, &sizedTypeMapCfg); err != nil {
+		t.Fatal(err)
+	}
+	var pgxTypeMapCfg PgTypeMapConfig
+	if _, err := toml.Decode(pgxTypeMap, &pgxTypeMapCfg); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name      string
+		typeCfg   *PgTypeMapConfig
+		nullStyle string
+		col       *PgColumn
+		expected  string
+	}{
+		{"legacy nullable", &defaultTypeMapCfg, NullStyleLegacy, &PgColumn{DataType: "integer"}, "sql.NullInt64"},
+		{"legacy not null", &defaultTypeMapCfg, NullStyleLegacy, &PgColumn{DataType: "integer", NotNull: true}, "int"},
+		{"generic nullable", &sizedTypeMapCfg, NullStyleGeneric, &PgColumn{DataType: "integer"}, "sql.Null[int32]"},
+		{"generic not null", &sizedTypeMapCfg, NullStyleGeneric, &PgColumn{DataType: "integer", NotNull: true}, "int32"},
+		{"generic bool", &sizedTypeMapCfg, NullStyleGeneric, &PgColumn{DataType: "boolean"}, "sql.Null[bool]"},
+		{"generic time", &sizedTypeMapCfg, NullStyleGeneric, &PgColumn{DataType: "timestamp with time zone"}, "sql.Null[time.Time]"},
+		{"generic bytea", &sizedTypeMapCfg, NullStyleGeneric, &PgColumn{DataType: "bytea"}, "[]byte"},
+		{"generic json", &sizedTypeMapCfg, NullStyleGeneric, &PgColumn{DataType: "json"}, "[]byte"},
+		{"generic unknown", &sizedTypeMapCfg, NullStyleGeneric, &PgColumn{DataType: "tsvector"}, "interface{}"},
+		{"pointer nullable", &sizedTypeMapCfg, NullStylePointer, &PgColumn{DataType: "smallint"}, "*int16"},
+		{"pointer text", &sizedTypeMapCfg, NullStylePointer, &PgColumn{DataType: "text"}, "*string"},
+		{"pointer json", &sizedTypeMapCfg, NullStylePointer, &PgColumn{DataType: "jsonb"}, "[]byte"},
+		{"pgx pointer", &pgxTypeMapCfg, NullStylePointer, &PgColumn{DataType: "text"}, "*string"},
+		{"pgx generic", &pgxTypeMapCfg, NullStyleGeneric, &PgColumn{DataType: "integer"}, "sql.Null[int32]"},
+		{"pgx pgtype", &pgxTypeMapCfg, NullStyleGeneric, &PgColumn{DataType: "interval"}, "pgtype.Interval"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, PgConvertType(tt.col, tt.typeCfg, tt.nullStyle))
+		})
+	}
+}
+
+func TestPgTypeRoundTrip(t *testing.T) {
+	conn, cleanup := testPgSetup(t, "types")
+	defer cleanup()
+
+	cols, err := PgLoadColumnDef(conn, "public", "t_types")
+	if err != nil {
+		t.Fatal(err)
+	}
+	decimalTypeMapCfg := PgTypeMapConfig{}
+	for k, v := range defaultTypeMapCfg {
+		decimalTypeMapCfg[k] = v
+	}
+	decimalTypeMapCfg.set("decimal", decimalTypeMap)
+	var types, decimalTypes []string
+	for _, c := range cols {
+		types = append(types, PgConvertType(c, &defaultTypeMapCfg, NullStyleLegacy))
+		decimalTypes = append(decimalTypes, PgConvertType(c, &decimalTypeMapCfg, NullStyleLegacy))
+	}
+	assert.Equal(t, []string{"[]byte", "Interval", "float64"}, types)
+	assert.Equal(t, []string{"[]byte", "Interval", "decimal.Decimal"}, decimalTypes)
+
+	b := []byte{0x00, 0x01, 0xfe, 0xff}
+	n := decimal.RequireFromString("12345678901234567890.123456789")
+	_, err = conn.Exec(`INSERT INTO t_types (b, iv, n) VALUES ($1, $2, $3)`,
+		b, "1 mons 2 days -04:05:06.000789", n)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var (
+		gotB  []byte
+		gotIv string
+		gotN  decimal.Decimal
+	)
+	err = conn.QueryRow(`SELECT b, iv, n FROM t_types`).Scan(&gotB, &gotIv, &gotN)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, b, gotB)
+	// the output of IntervalStyle postgres, which the generated Interval parses
+	assert.Equal(t, "1 mon 2 days -04:05:06.000789", gotIv)
+	assert.True(t, n.Equal(gotN), "want %s, got %s", n, gotN)
+
+	// the struct generated for lib/pq inserts the Interval by its Value, and
+	// the Interval scans the row back
+	genCfg := NewGenConfig()
+	genCfg.ErrorWrap = ErrorWrapStd
+	src, err := PgCreateStruct(conn, "public", "", "main", "", []string{}, []string{}, []string{}, []string{}, "", genCfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	out := runGenerated(t, string(src)+QueryInterface(genCfg), intervalRoundTripMain,
+		testPgConnStr, "1 mon 2 days 03:04:05.000006", "-1 years -2 mons -3 days -04:05:06.000007")
+	assert.Equal(t, "1 2 11045000006 true\n-14 -3 -14706000007 true\n", out)
+}
+
+const intervalRoundTripMain = `package main
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"os"
+
+	_ "github.com/lib/pq"
+)
+
+func main() {
+	db, err := sql.Open("postgres", os.Args[1])
+	if err != nil {
+		panic(err)
+	}
+	defer db.Close()
+	ctx := context.Background()
+	for _, s := range os.Args[2:] {
+		var iv Interval
+		if err := db.QueryRow("SELECT $1::interval", s).Scan(&iv); err != nil {
+			panic(err)
+		}
+		r := &TTypes{B: []byte{0x00}, Iv: iv}
+		if err := r.CreateContext(ctx, db); err != nil {
+			panic(err)
+		}
+		var back Interval
+		if err := db.QueryRow("SELECT iv FROM t_types WHERE iv = $1", iv).Scan(&back); err != nil {
+			panic(err)
+		}
+		fmt.Println(iv.Months, iv.Days, iv.Microseconds, back == iv)
+	}
+}
+`
+
+// runGenerated runs the generated src with mainSrc as a command of the
+// module, and returns its output.
+func runGenerated(t *testing.T, src, mainSrc string, args ...string) string {
+	dir, err := os.MkdirTemp(".", "generated")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	for name, s := range map[string]string{"gen.go": src, "main.go": mainSrc} {
+		b, err := imports.Process(name, []byte(s), nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(dir, name), b, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	out, err := exec.Command("go", append([]string{"run", "./" + dir}, args...)...).CombinedOutput()
+	if err != nil {
+		t.Fatalf("%s\n%s", err, out)
+	}
+	return string(out)
+}
+
 func TestPgLoadTypeMap(t *testing.T) {
 	path := "./typemap.toml"
 	c, err := PgLoadTypeMapFromFile(path)
@@ -123,7 +473,7 @@ func TestPgTableToStruct(t *testing.T) {
 	}
 
 	for _, tbl := range tbls {
-		st, err := PgTableToStruct(tbl, &defaultTypeMapCfg, autoGenKeyCfg, []string{}, "", nil)
+		st, err := PgTableToStruct(tbl, &defaultTypeMapCfg, autoGenKeyCfg, []string{}, "", nil, nil)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -135,6 +485,80 @@ func TestPgTableToStruct(t *testing.T) {
 	}
 }
 
+func TestSetPrimaryKeyInfo(t *testing.T) {
+	tests := []struct {
+		name      string
+		columns   []*PgColumn
+		cfg       *AutoKeyMap
+		autoGen   []bool
+		autoGenPk bool
+	}{
+		{
+			name: "t3 bigserial and plain composite key",
+			columns: []*PgColumn{
+				{Name: "id", DDLType: "bigserial", IsPrimaryKey: true},
+				{Name: "i", DDLType: "integer", IsPrimaryKey: true},
+				{Name: "str", DDLType: "text"},
+			},
+			cfg:       autoGenKeyCfg,
+			autoGen:   []bool{true, false, false},
+			autoGenPk: true,
+		},
+		{
+			name: "t4 plain composite key",
+			columns: []*PgColumn{
+				{Name: "id", DDLType: "integer", IsPrimaryKey: true},
+				{Name: "i", DDLType: "integer", IsPrimaryKey: true},
+			},
+			cfg:     autoGenKeyCfg,
+			autoGen: []bool{false, false},
+		},
+		{
+			name: "t5 identity by default and plain composite key",
+			columns: []*PgColumn{
+				{Name: "id", DDLType: "integer", IsPrimaryKey: true, Identity: "d"},
+				{Name: "i", DDLType: "integer", IsPrimaryKey: true},
+			},
+			cfg:       autoGenKeyCfg,
+			autoGen:   []bool{true, false},
+			autoGenPk: true,
+		},
+		{
+			name: "t6 identity always and plain composite key",
+			columns: []*PgColumn{
+				{Name: "id", DDLType: "integer", IsPrimaryKey: true, Identity: "a"},
+				{Name: "i", DDLType: "integer", IsPrimaryKey: true},
+			},
+			cfg:       &AutoKeyMap{Types: []string{"autogenuuid"}},
+			autoGen:   []bool{true, false},
+			autoGenPk: true,
+		},
+		{
+			name: "autogenkey types apply to the primary keys only",
+			columns: []*PgColumn{
+				{Name: "id", DDLType: "autogenuuid", IsPrimaryKey: true},
+				{Name: "ref", DDLType: "autogenuuid"},
+				{Name: "n", DDLType: "serial"},
+			},
+			cfg:       autoGenKeyCfg,
+			autoGen:   []bool{true, false, true},
+			autoGenPk: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tbl := &PgTable{Columns: tt.columns}
+			tbl.setPrimaryKeyInfo(tt.cfg)
+			var autoGen []bool
+			for _, c := range tbl.Columns {
+				autoGen = append(autoGen, c.AutoGen)
+			}
+			assert.Equal(t, tt.autoGen, autoGen)
+			assert.Equal(t, tt.autoGenPk, tbl.AutoGenPk)
+		})
+	}
+}
+
 func TestPgTableToMethod(t *testing.T) {
 	conn, cleanup := testPgSetup(t)
 	defer cleanup()
@@ -145,7 +569,7 @@ func TestPgTableToMethod(t *testing.T) {
 		t.Fatal(err)
 	}
 	for _, tbl := range tbls {
-		st, err := PgTableToStruct(tbl, &defaultTypeMapCfg, autoGenKeyCfg, []string{}, "", nil)
+		st, err := PgTableToStruct(tbl, &defaultTypeMapCfg, autoGenKeyCfg, []string{}, "", nil, nil)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -176,7 +600,7 @@ func TestPgExecuteCustomTemplate(t *testing.T) {
 		t.Fatal(err)
 	}
 	for _, tbl := range tbls {
-		st, err := PgTableToStruct(tbl, &defaultTypeMapCfg, autoGenKeyCfg, []string{}, "", nil)
+		st, err := PgTableToStruct(tbl, &defaultTypeMapCfg, autoGenKeyCfg, []string{}, "", nil, nil)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -188,14 +612,43 @@ func TestPgExecuteCustomTemplate(t *testing.T) {
 	}
 }
 
+func TestPgCreateStructWithCustomTemplate(t *testing.T) {
+	conn, cleanup := testPgSetup(t, "types")
+	defer cleanup()
+	assert := assert.New(t)
+
+	customTmpl := filepath.Join(t.TempDir(), "custom.tmpl")
+	if err := os.WriteFile(customTmpl, []byte(testTmpl), 0644); err != nil {
+		t.Fatal(err)
+	}
+	src, err := PgCreateStruct(conn, "public", "", "mypkg", customTmpl, []string{}, []string{}, []string{}, []string{}, "", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	srcStr := string(src)
+
+	// Interval is emitted for the fields without the rest of the helper
+	assert.Contains(srcStr, "Iv Interval // iv")
+	assert.Contains(srcStr, "type Interval struct {")
+	assert.NotContains(srcStr, "func translateError(")
+
+	genCfg := NewGenConfig()
+	genCfg.NoHelper = true
+	src, err = PgCreateStruct(conn, "public", "", "mypkg", customTmpl, []string{}, []string{}, []string{}, []string{}, "", genCfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.NotContains(string(src), "type Interval struct {")
+}
+
 func TestCreateInsertOnConflictDoNothingSQL(t *testing.T) {
-	conn, cleanup := testPgSetup(t)
+	conn, cleanup := testPgSetup(t, "generated")
 	defer cleanup()
 
 	structs := testSetupStruct(t, conn)
 
-	if len(structs) != 6 {
-		t.Fatalf("Expected the number of testing structs is 6, got: %d", len(structs))
+	if len(structs) != 7 {
+		t.Fatalf("Expected the number of testing structs is 7, got: %d", len(structs))
 	}
 
 	tests := []struct {
@@ -212,12 +665,24 @@ func TestCreateInsertOnConflictDoNothingSQL(t *testing.T) {
 		},
 		{
 			tableStruct: structs[2],
-			expectSQL:   "INSERT INTO t3 (str, t_with_tz, t_without_tz) VALUES ($1, $2, $3) ON CONFLICT DO NOTHING RETURNING id, i",
+			expectSQL:   "INSERT INTO t3 (i, str, t_with_tz, t_without_tz) VALUES ($1, $2, $3, $4) ON CONFLICT DO NOTHING RETURNING id",
 		},
 		{
 			tableStruct: structs[3],
 			expectSQL:   "INSERT INTO t4 (id, i) VALUES ($1, $2) ON CONFLICT DO NOTHING",
 		},
+		{
+			tableStruct: structs[4],
+			expectSQL:   "INSERT INTO t5 (i) VALUES ($1) ON CONFLICT DO NOTHING RETURNING id",
+		},
+		{
+			tableStruct: structs[5],
+			expectSQL:   "INSERT INTO t6 (i) VALUES ($1) ON CONFLICT DO NOTHING RETURNING id",
+		},
+		{
+			tableStruct: structs[6],
+			expectSQL:   "INSERT INTO t7 (i) VALUES ($1) ON CONFLICT DO NOTHING RETURNING id, doubled",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.tableStruct.Table.Name, func(t *testing.T) {
@@ -230,6 +695,94 @@ func TestCreateInsertOnConflictDoNothingSQL(t *testing.T) {
 	}
 }
 
+func TestCreateSelectByPksSQL(t *testing.T) {
+	conn, cleanup := testPgSetup(t)
+	defer cleanup()
+
+	structs := testSetupStruct(t, conn)
+
+	tests := []struct {
+		tableStruct  *Struct
+		expectSQL    string
+		expectValues string
+		expectPkType string
+	}{
+		{
+			tableStruct:  structs[0],
+			expectSQL:    "SELECT id, i, str, nullable_str, t_with_tz, t_without_tz, tm FROM t1 WHERE id = ANY($1) ORDER BY id",
+			expectValues: `"($%d::bigint)", len(args)`,
+			expectPkType: "int64",
+		},
+		{
+			tableStruct:  structs[2],
+			expectSQL:    "SELECT id, i, str, t_with_tz, t_without_tz FROM t3 WHERE (id, i) IN (VALUES %s) ORDER BY id, i",
+			expectValues: `"($%d::bigint, $%d::integer)", len(args)-1, len(args)`,
+			expectPkType: "T3Pk",
+		},
+		{
+			tableStruct:  structs[3],
+			expectSQL:    "SELECT id, i FROM t4 WHERE (id, i) IN (VALUES %s) ORDER BY id, i",
+			expectValues: `"($%d::integer, $%d::integer)", len(args)-1, len(args)`,
+			expectPkType: "T4Pk",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.tableStruct.Table.Name, func(t *testing.T) {
+			assert.Equal(t, tt.expectSQL, createSelectByPksSQL(tt.tableStruct))
+			assert.Equal(t, tt.expectValues, createSelectByPksValues(tt.tableStruct))
+			assert.Equal(t, tt.expectPkType, createPkType(tt.tableStruct))
+		})
+	}
+}
+
+func TestCreateExistsByPkSQL(t *testing.T) {
+	conn, cleanup := testPgSetup(t)
+	defer cleanup()
+
+	structs := testSetupStruct(t, conn)
+
+	assert.Equal(t, "SELECT EXISTS (SELECT 1 FROM t1 WHERE id = $1)", createExistsByPkSQL(structs[0]))
+	assert.Equal(t, "SELECT EXISTS (SELECT 1 FROM t3 WHERE id = $1 AND i = $2)", createExistsByPkSQL(structs[2]))
+}
+
+func TestCreateListSQL(t *testing.T) {
+	conn, cleanup := testPgSetup(t)
+	defer cleanup()
+
+	structs := testSetupStruct(t, conn)
+
+	tests := []struct {
+		tableStruct  *Struct
+		expectSQL    string
+		expectAfter  string
+		expectParams string
+		expectOrder  string
+	}{
+		{
+			tableStruct:  structs[0],
+			expectSQL:    "SELECT id, i, str, nullable_str, t_with_tz, t_without_tz, tm FROM t1",
+			expectAfter:  "id > $1",
+			expectParams: "*opts.After",
+			expectOrder:  "id",
+		},
+		{
+			tableStruct:  structs[2],
+			expectSQL:    "SELECT id, i, str, t_with_tz, t_without_tz FROM t3",
+			expectAfter:  "(id, i) > ($1, $2)",
+			expectParams: "opts.After.ID, opts.After.I",
+			expectOrder:  "id, i",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.tableStruct.Table.Name, func(t *testing.T) {
+			assert.Equal(t, tt.expectSQL, createListSQL(tt.tableStruct))
+			assert.Equal(t, tt.expectAfter, createListAfterSQL(tt.tableStruct))
+			assert.Equal(t, tt.expectParams, createListAfterParams(tt.tableStruct))
+			assert.Equal(t, tt.expectOrder, createOrderByPk(tt.tableStruct))
+		})
+	}
+}
+
 func TestMethodGeneration(t *testing.T) {
 	conn, cleanup := testPgSetup(t)
 	defer cleanup()
@@ -264,21 +817,40 @@ func GetT1ByPk(db Queryer, pk0 int64) (*T1, error) {
         return GetT1ByPkContext(context.Background(), db, pk0)
 }
 
+// Validate checks the T1 against the constraints of t1 which can be checked
+// without the database, and returns *ValidationError listing every failing field.
+func (r *T1) Validate() error {
+        var errs []FieldError
+        if r.Str == "" {
+                errs = append(errs, FieldError{Field: "Str", Column: "str", Message: "must not be empty"})
+        }
+        if len(errs) > 0 {
+                return &ValidationError{Table: "t1", Fields: errs}
+        }
+        return nil
+}
+
 // CreateContext inserts the T1 to the database.
 func (r *T1) CreateContext(ctx context.Context, db Queryer) error {
+        if err := beforeCreate(ctx, r); err != nil {
+                return err
+        }
         err := db.QueryRowContext(ctx,
                 ` + "`INSERT INTO t1 (i, str, nullable_str, t_with_tz, t_without_tz, tm) VALUES ($1, $2, $3, $4, $5, $6) RETURNING id`" + `,
                 &r.I, &r.Str, &r.NullableStr, &r.TWithTz, &r.TWithoutTz, &r.Tm).Scan(&r.ID)
         if err != nil {
-                return errors.WithStack(err)
+                return errors.WithStack(translateError("t1", err))
         }
-        return nil
+        return afterCreate(ctx, r)
 }
 
 // CreateOnConflictDoNothing inserts the T1 to the database.
 // If a conflict occurs (e.g., unique constraint violation), the insert is skipped without error.
 // Returns true if the row was inserted, false if it was skipped due to conflict.
 func (r *T1) CreateOnConflictDoNothing(ctx context.Context, db Queryer) (bool, error) {
+        if err := beforeCreate(ctx, r); err != nil {
+                return false, err
+        }
         err := db.QueryRowContext(ctx,
                 ` + "`INSERT INTO t1 (i, str, nullable_str, t_with_tz, t_without_tz, tm) VALUES ($1, $2, $3, $4, $5, $6) ON CONFLICT DO NOTHING RETURNING id`" + `,
                 &r.I, &r.Str, &r.NullableStr, &r.TWithTz, &r.TWithoutTz, &r.Tm).Scan(&r.ID)
@@ -286,28 +858,445 @@ func (r *T1) CreateOnConflictDoNothing(ctx context.Context, db Queryer) (bool, e
                 if err == sql.ErrNoRows {
                         return false, nil
                 }
-                return false, errors.WithStack(err)
+                return false, errors.WithStack(translateError("t1", err))
         }
         // Row was successfully inserted
-        return true, nil
+        return true, afterCreate(ctx, r)
 }
 
-// GetT1ByPkContext select the T1 from the database.
-func GetT1ByPkContext(ctx context.Context, db Queryer, pk0 int64) (*T1, error) {
-        var r T1
-        err := db.QueryRowContext(ctx,
-                ` + "`SELECT id, i, str, nullable_str, t_with_tz, t_without_tz, tm FROM t1 WHERE id = $1`" + `,
-                        pk0).Scan(&r.ID, &r.I, &r.Str, &r.NullableStr, &r.TWithTz, &r.TWithoutTz, &r.Tm)
+// UpdateContext updates the T1 in the database by the primary key.
+// *NotFoundError is returned if the row is not found.
+func (r *T1) UpdateContext(ctx context.Context, db Queryer) error {
+        if err := beforeUpdate(ctx, r); err != nil {
+                return err
+        }
+        result, err := db.ExecContext(ctx,
+                ` + "`UPDATE t1 SET i = $1, str = $2, nullable_str = $3, t_with_tz = $4, t_without_tz = $5, tm = $6 WHERE id = $7`" + `,
+                &r.I, &r.Str, &r.NullableStr, &r.TWithTz, &r.TWithoutTz, &r.Tm, &r.ID)
         if err != nil {
-                return nil, errors.WithStack(err)
+                return errors.WithStack(translateError("t1", err))
         }
-        return &r, nil
+        n, err := result.RowsAffected()
+        if err != nil {
+                return errors.WithStack(translateError("t1", err))
+        }
+        if n == 0 {
+                err = sql.ErrNoRows
+                return errors.WithStack(translateError("t1", err))
+        }
+        return afterUpdate(ctx, r)
 }
 
-`,
-		},
-		{
-			table: tbls[1],
+// DeleteContext deletes the T1 from the database by the primary key.
+// *NotFoundError is returned if the row is not found.
+func (r *T1) DeleteContext(ctx context.Context, db Queryer) error {
+        if err := beforeDelete(ctx, r); err != nil {
+                return err
+        }
+        result, err := db.ExecContext(ctx,
+                ` + "`DELETE FROM t1 WHERE id = $1`" + `,
+                &r.ID)
+        if err != nil {
+                return errors.WithStack(translateError("t1", err))
+        }
+        n, err := result.RowsAffected()
+        if err != nil {
+                return errors.WithStack(translateError("t1", err))
+        }
+        if n == 0 {
+                err = sql.ErrNoRows
+                return errors.WithStack(translateError("t1", err))
+        }
+        return afterDelete(ctx, r)
+}
+
+// ReloadContext selects the T1 again by its primary key, and overwrites the fields with the
+// values in the database, e.g. after a trigger or a stored procedure changed the row.
+// *NotFoundError is returned if the row is not found.
+func (r *T1) ReloadContext(ctx context.Context, db Queryer) error {
+        err := db.QueryRowContext(ctx,
+                ` + "`SELECT id, i, str, nullable_str, t_with_tz, t_without_tz, tm FROM t1 WHERE id = $1`" + `,
+                r.ID).Scan(&r.ID, &r.I, &r.Str, &r.NullableStr, &r.TWithTz, &r.TWithoutTz, &r.Tm)
+        if err != nil {
+                return errors.WithStack(translateError("t1", err))
+        }
+        return nil
+}
+
+// GetT1ByPkContext select the T1 from the database.
+func GetT1ByPkContext(ctx context.Context, db Queryer, pk0 int64) (*T1, error) {
+        var r T1
+        err := db.QueryRowContext(ctx,
+                ` + "`SELECT id, i, str, nullable_str, t_with_tz, t_without_tz, tm FROM t1 WHERE id = $1`" + `,
+                pk0).Scan(&r.ID, &r.I, &r.Str, &r.NullableStr, &r.TWithTz, &r.TWithoutTz, &r.Tm)
+        if err != nil {
+                return nil, errors.WithStack(translateError("t1", err))
+        }
+        return &r, nil
+}
+
+// GetT1ByPkForUpdateContext selects the T1 from the database with FOR UPDATE,
+// which locks the row until the end of the transaction. opt decides what happens if the row is locked
+// by another transaction: LockWait waits for it, LockNoWait fails with SQLSTATE 55P03, and
+// LockSkipLocked returns *NotFoundError.
+func GetT1ByPkForUpdateContext(ctx context.Context, db Queryer, pk0 int64, opt LockOption) (*T1, error) {
+        var r T1
+        err := db.QueryRowContext(ctx,
+                ` + "`SELECT id, i, str, nullable_str, t_with_tz, t_without_tz, tm FROM t1 WHERE id = $1 FOR UPDATE`" + `+opt.sql(),
+                pk0).Scan(&r.ID, &r.I, &r.Str, &r.NullableStr, &r.TWithTz, &r.TWithoutTz, &r.Tm)
+        if err != nil {
+                return nil, errors.WithStack(translateError("t1", err))
+        }
+        return &r, nil
+}
+
+// GetT1ByPkForShareContext selects the T1 from the database with FOR SHARE,
+// which locks the row until the end of the transaction. opt decides what happens if the row is locked
+// by another transaction: LockWait waits for it, LockNoWait fails with SQLSTATE 55P03, and
+// LockSkipLocked returns *NotFoundError.
+func GetT1ByPkForShareContext(ctx context.Context, db Queryer, pk0 int64, opt LockOption) (*T1, error) {
+        var r T1
+        err := db.QueryRowContext(ctx,
+                ` + "`SELECT id, i, str, nullable_str, t_with_tz, t_without_tz, tm FROM t1 WHERE id = $1 FOR SHARE`" + `+opt.sql(),
+                pk0).Scan(&r.ID, &r.I, &r.Str, &r.NullableStr, &r.TWithTz, &r.TWithoutTz, &r.Tm)
+        if err != nil {
+                return nil, errors.WithStack(translateError("t1", err))
+        }
+        return &r, nil
+}
+
+// ExistsT1ByPkContext checks if the T1 exists in the database.
+func ExistsT1ByPkContext(ctx context.Context, db Queryer, pk0 int64) (bool, error) {
+        var exists bool
+        err := db.QueryRowContext(ctx,
+                ` + "`SELECT EXISTS (SELECT 1 FROM t1 WHERE id = $1)`" + `,
+                pk0).Scan(&exists)
+        if err != nil {
+                return false, errors.WithStack(translateError("t1", err))
+        }
+        return exists, nil
+}
+
+// GetT1ByPksContext select the T1s of the given primary keys from the database.
+// The result is ordered by the primary key, and the keys which do not exist are skipped.
+func GetT1ByPksContext(ctx context.Context, db Queryer, pks []int64) ([]*T1, error) {
+        rows, err := db.QueryContext(ctx,
+                ` + "`SELECT id, i, str, nullable_str, t_with_tz, t_without_tz, tm FROM t1 WHERE id = ANY($1) ORDER BY id`" + `,
+                pq.Array(pks))
+        if err != nil {
+                return nil, errors.WithStack(translateError("t1", err))
+        }
+        defer rows.Close()
+        var rs []*T1
+        for rows.Next() {
+                var r T1
+                if err := rows.Scan(&r.ID, &r.I, &r.Str, &r.NullableStr, &r.TWithTz, &r.TWithoutTz, &r.Tm); err != nil {
+                        return nil, errors.WithStack(translateError("t1", err))
+                }
+                rs = append(rs, &r)
+        }
+        if err := rows.Err(); err != nil {
+                return nil, errors.WithStack(translateError("t1", err))
+        }
+        return rs, nil
+}
+
+// T1ListOptions is the options of ListT1.
+type T1ListOptions struct {
+        // Limit is the maximum number of rows to return. Zero means no limit.
+        Limit int
+        // After is the primary key of the last row of the previous page.
+        // Only the rows after it are returned when it is not nil.
+        After *int64
+}
+
+// ListT1 lists the T1s ordered by the primary key.
+// Pass the primary key of the last row as opts.After to get the next page.
+func ListT1(ctx context.Context, db Queryer, opts T1ListOptions) ([]*T1, error) {
+        q := ` + "`SELECT id, i, str, nullable_str, t_with_tz, t_without_tz, tm FROM t1`" + `
+        var args []interface{}
+        if opts.After != nil {
+                q += ` + "` WHERE id > $1`" + `
+                args = append(args, *opts.After)
+        }
+        q += ` + "` ORDER BY id`" + `
+        if opts.Limit > 0 {
+                args = append(args, opts.Limit)
+                q += fmt.Sprintf(` + "` LIMIT $%d`" + `, len(args))
+        }
+        rows, err := db.QueryContext(ctx, q, args...)
+        if err != nil {
+                return nil, errors.WithStack(translateError("t1", err))
+        }
+        defer rows.Close()
+        var rs []*T1
+        for rows.Next() {
+                var r T1
+                if err := rows.Scan(&r.ID, &r.I, &r.Str, &r.NullableStr, &r.TWithTz, &r.TWithoutTz, &r.Tm); err != nil {
+                        return nil, errors.WithStack(translateError("t1", err))
+                }
+                rs = append(rs, &r)
+        }
+        if err := rows.Err(); err != nil {
+                return nil, errors.WithStack(translateError("t1", err))
+        }
+        return rs, nil
+}
+
+// T1Filter builds a parameterized WHERE clause of t1.
+// The predicates are combined with AND.
+type T1Filter struct {
+        conds []string
+        args  []interface{}
+}
+
+// NewT1Filter creates an empty T1Filter, which matches every row.
+func NewT1Filter() *T1Filter {
+        return &T1Filter{}
+}
+
+func (f *T1Filter) add(cond string, v interface{}) *T1Filter {
+        f.args = append(f.args, v)
+        f.conds = append(f.conds, fmt.Sprintf(cond, len(f.args)))
+        return f
+}
+
+// Where returns the WHERE clause and its arguments. It returns an empty clause
+// if the filter is nil or has no predicate.
+func (f *T1Filter) Where() (string, []interface{}) {
+        if f == nil || len(f.conds) == 0 {
+                return "", nil
+        }
+        return " WHERE " + strings.Join(f.conds, " AND "), f.args
+}
+
+// IDEq filters the rows whose id equals to v.
+func (f *T1Filter) IDEq(v int64) *T1Filter {
+        return f.add("id = $%d", v)
+}
+
+// IDIn filters the rows whose id is one of vs.
+func (f *T1Filter) IDIn(vs ...int64) *T1Filter {
+        return f.add("id = ANY($%d)", pq.Array(vs))
+}
+
+// IDLt filters the rows whose id is less than v.
+func (f *T1Filter) IDLt(v int64) *T1Filter {
+        return f.add("id < $%d", v)
+}
+
+// IDGt filters the rows whose id is greater than v.
+func (f *T1Filter) IDGt(v int64) *T1Filter {
+        return f.add("id > $%d", v)
+}
+
+// IEq filters the rows whose i equals to v.
+func (f *T1Filter) IEq(v int) *T1Filter {
+        return f.add("i = $%d", v)
+}
+
+// IIn filters the rows whose i is one of vs.
+func (f *T1Filter) IIn(vs ...int) *T1Filter {
+        return f.add("i = ANY($%d)", pq.Array(vs))
+}
+
+// ILt filters the rows whose i is less than v.
+func (f *T1Filter) ILt(v int) *T1Filter {
+        return f.add("i < $%d", v)
+}
+
+// IGt filters the rows whose i is greater than v.
+func (f *T1Filter) IGt(v int) *T1Filter {
+        return f.add("i > $%d", v)
+}
+
+// StrEq filters the rows whose str equals to v.
+func (f *T1Filter) StrEq(v string) *T1Filter {
+        return f.add("str = $%d", v)
+}
+
+// StrIn filters the rows whose str is one of vs.
+func (f *T1Filter) StrIn(vs ...string) *T1Filter {
+        return f.add("str = ANY($%d)", pq.Array(vs))
+}
+
+// StrLt filters the rows whose str is less than v.
+func (f *T1Filter) StrLt(v string) *T1Filter {
+        return f.add("str < $%d", v)
+}
+
+// StrGt filters the rows whose str is greater than v.
+func (f *T1Filter) StrGt(v string) *T1Filter {
+        return f.add("str > $%d", v)
+}
+
+// NullableStrEq filters the rows whose nullable_str equals to v.
+func (f *T1Filter) NullableStrEq(v sql.NullString) *T1Filter {
+        return f.add("nullable_str = $%d", v)
+}
+
+// NullableStrIn filters the rows whose nullable_str is one of vs.
+func (f *T1Filter) NullableStrIn(vs ...sql.NullString) *T1Filter {
+        return f.add("nullable_str = ANY($%d)", pq.Array(vs))
+}
+
+// NullableStrLt filters the rows whose nullable_str is less than v.
+func (f *T1Filter) NullableStrLt(v sql.NullString) *T1Filter {
+        return f.add("nullable_str < $%d", v)
+}
+
+// NullableStrGt filters the rows whose nullable_str is greater than v.
+func (f *T1Filter) NullableStrGt(v sql.NullString) *T1Filter {
+        return f.add("nullable_str > $%d", v)
+}
+
+// NullableStrIsNull filters the rows whose nullable_str is NULL.
+func (f *T1Filter) NullableStrIsNull() *T1Filter {
+        f.conds = append(f.conds, "nullable_str IS NULL")
+        return f
+}
+
+// NullableStrIsNotNull filters the rows whose nullable_str is not NULL.
+func (f *T1Filter) NullableStrIsNotNull() *T1Filter {
+        f.conds = append(f.conds, "nullable_str IS NOT NULL")
+        return f
+}
+
+// TWithTzEq filters the rows whose t_with_tz equals to v.
+func (f *T1Filter) TWithTzEq(v time.Time) *T1Filter {
+        return f.add("t_with_tz = $%d", v)
+}
+
+// TWithTzIn filters the rows whose t_with_tz is one of vs.
+func (f *T1Filter) TWithTzIn(vs ...time.Time) *T1Filter {
+        return f.add("t_with_tz = ANY($%d)", pq.Array(vs))
+}
+
+// TWithTzLt filters the rows whose t_with_tz is less than v.
+func (f *T1Filter) TWithTzLt(v time.Time) *T1Filter {
+        return f.add("t_with_tz < $%d", v)
+}
+
+// TWithTzGt filters the rows whose t_with_tz is greater than v.
+func (f *T1Filter) TWithTzGt(v time.Time) *T1Filter {
+        return f.add("t_with_tz > $%d", v)
+}
+
+// TWithoutTzEq filters the rows whose t_without_tz equals to v.
+func (f *T1Filter) TWithoutTzEq(v time.Time) *T1Filter {
+        return f.add("t_without_tz = $%d", v)
+}
+
+// TWithoutTzIn filters the rows whose t_without_tz is one of vs.
+func (f *T1Filter) TWithoutTzIn(vs ...time.Time) *T1Filter {
+        return f.add("t_without_tz = ANY($%d)", pq.Array(vs))
+}
+
+// TWithoutTzLt filters the rows whose t_without_tz is less than v.
+func (f *T1Filter) TWithoutTzLt(v time.Time) *T1Filter {
+        return f.add("t_without_tz < $%d", v)
+}
+
+// TWithoutTzGt filters the rows whose t_without_tz is greater than v.
+func (f *T1Filter) TWithoutTzGt(v time.Time) *T1Filter {
+        return f.add("t_without_tz > $%d", v)
+}
+
+// TmEq filters the rows whose tm equals to v.
+func (f *T1Filter) TmEq(v *time.Time) *T1Filter {
+        return f.add("tm = $%d", v)
+}
+
+// TmIn filters the rows whose tm is one of vs.
+func (f *T1Filter) TmIn(vs ...*time.Time) *T1Filter {
+        return f.add("tm = ANY($%d)", pq.Array(vs))
+}
+
+// TmLt filters the rows whose tm is less than v.
+func (f *T1Filter) TmLt(v *time.Time) *T1Filter {
+        return f.add("tm < $%d", v)
+}
+
+// TmGt filters the rows whose tm is greater than v.
+func (f *T1Filter) TmGt(v *time.Time) *T1Filter {
+        return f.add("tm > $%d", v)
+}
+
+// TmIsNull filters the rows whose tm is NULL.
+func (f *T1Filter) TmIsNull() *T1Filter {
+        f.conds = append(f.conds, "tm IS NULL")
+        return f
+}
+
+// TmIsNotNull filters the rows whose tm is not NULL.
+func (f *T1Filter) TmIsNotNull() *T1Filter {
+        f.conds = append(f.conds, "tm IS NOT NULL")
+        return f
+}
+
+// FindT1 selects the T1s matching the filter from the database.
+// The result is ordered by the primary key.
+func FindT1(ctx context.Context, db Queryer, f *T1Filter) ([]*T1, error) {
+        where, args := f.Where()
+        rows, err := db.QueryContext(ctx,
+                ` + "`SELECT id, i, str, nullable_str, t_with_tz, t_without_tz, tm FROM t1`" + `+where+` + "` ORDER BY id`" + `,
+                args...)
+        if err != nil {
+                return nil, errors.WithStack(translateError("t1", err))
+        }
+        defer rows.Close()
+        var rs []*T1
+        for rows.Next() {
+                var r T1
+                if err := rows.Scan(&r.ID, &r.I, &r.Str, &r.NullableStr, &r.TWithTz, &r.TWithoutTz, &r.Tm); err != nil {
+                        return nil, errors.WithStack(translateError("t1", err))
+                }
+                rs = append(rs, &r)
+        }
+        if err := rows.Err(); err != nil {
+                return nil, errors.WithStack(translateError("t1", err))
+        }
+        return rs, nil
+}
+
+// CountT1 counts the T1s matching the filter in the database.
+// Pass nil as the filter to count every row.
+func CountT1(ctx context.Context, db Queryer, f *T1Filter) (int64, error) {
+        where, args := f.Where()
+        var n int64
+        err := db.QueryRowContext(ctx,
+                ` + "`SELECT count(*) FROM t1`" + `+where,
+                args...).Scan(&n)
+        if err != nil {
+                return 0, errors.WithStack(translateError("t1", err))
+        }
+        return n, nil
+}
+
+// DeleteT1Where deletes the T1s matching the filter from the database,
+// and returns the number of the deleted rows. A filter without predicate is rejected
+// to avoid deleting every row by mistake.
+func DeleteT1Where(ctx context.Context, db Queryer, f *T1Filter) (int64, error) {
+        where, args := f.Where()
+        if where == "" {
+                return 0, errors.New("refusing to delete every row of t1 with an empty filter")
+        }
+        result, err := db.ExecContext(ctx,
+                ` + "`DELETE FROM t1`" + `+where,
+                args...)
+        if err != nil {
+                return 0, errors.WithStack(translateError("t1", err))
+        }
+        n, err := result.RowsAffected()
+        if err != nil {
+                return 0, errors.WithStack(translateError("t1", err))
+        }
+        return n, nil
+}
+`,
+		},
+		{
+			table: tbls[1],
 			expect: `// Create inserts the T2 to the database.
 // %EMPTY_COMMENT%
 // Deprecated: Use CreateContext instead.
@@ -322,21 +1311,40 @@ func GetT2ByPk(db Queryer, pk0 int64) (*T2, error) {
         return GetT2ByPkContext(context.Background(), db, pk0)
 }
 
+// Validate checks the T2 against the constraints of t2 which can be checked
+// without the database, and returns *ValidationError listing every failing field.
+func (r *T2) Validate() error {
+        var errs []FieldError
+        if r.Str == "" {
+                errs = append(errs, FieldError{Field: "Str", Column: "str", Message: "must not be empty"})
+        }
+        if len(errs) > 0 {
+                return &ValidationError{Table: "t2", Fields: errs}
+        }
+        return nil
+}
+
 // CreateContext inserts the T2 to the database.
 func (r *T2) CreateContext(ctx context.Context, db Queryer) error {
+        if err := beforeCreate(ctx, r); err != nil {
+                return err
+        }
         err := db.QueryRowContext(ctx,
                 ` + "`INSERT INTO t2 (i, str, t_with_tz, t_without_tz) VALUES ($1, $2, $3, $4) RETURNING id`" + `,
                 &r.I, &r.Str, &r.TWithTz, &r.TWithoutTz).Scan(&r.ID)
         if err != nil {
-                return errors.WithStack(err)
+                return errors.WithStack(translateError("t2", err))
         }
-        return nil
+        return afterCreate(ctx, r)
 }
 
 // CreateOnConflictDoNothing inserts the T2 to the database.
 // If a conflict occurs (e.g., unique constraint violation), the insert is skipped without error.
 // Returns true if the row was inserted, false if it was skipped due to conflict.
 func (r *T2) CreateOnConflictDoNothing(ctx context.Context, db Queryer) (bool, error) {
+        if err := beforeCreate(ctx, r); err != nil {
+                return false, err
+        }
         err := db.QueryRowContext(ctx,
                 ` + "`INSERT INTO t2 (i, str, t_with_tz, t_without_tz) VALUES ($1, $2, $3, $4) ON CONFLICT DO NOTHING RETURNING id`" + `,
                 &r.I, &r.Str, &r.TWithTz, &r.TWithoutTz).Scan(&r.ID)
@@ -344,926 +1352,1199 @@ func (r *T2) CreateOnConflictDoNothing(ctx context.Context, db Queryer) (bool, e
                 if err == sql.ErrNoRows {
                         return false, nil
                 }
-                return false, errors.WithStack(err)
+                return false, errors.WithStack(translateError("t2", err))
         }
         // Row was successfully inserted
-        return true, nil
+        return true, afterCreate(ctx, r)
 }
 
-// GetT2ByPkContext select the T2 from the database.
-func GetT2ByPkContext(ctx context.Context, db Queryer, pk0 int64) (*T2, error) {
-        var r T2
-        err := db.QueryRowContext(ctx,
-                ` + "`SELECT id, i, str, t_with_tz, t_without_tz FROM t2 WHERE id = $1`" + `,
-                pk0).Scan(&r.ID, &r.I, &r.Str, &r.TWithTz, &r.TWithoutTz)
+// UpdateContext updates the T2 in the database by the primary key.
+// *NotFoundError is returned if the row is not found.
+func (r *T2) UpdateContext(ctx context.Context, db Queryer) error {
+        if err := beforeUpdate(ctx, r); err != nil {
+                return err
+        }
+        result, err := db.ExecContext(ctx,
+                ` + "`UPDATE t2 SET i = $1, str = $2, t_with_tz = $3, t_without_tz = $4 WHERE id = $5`" + `,
+                &r.I, &r.Str, &r.TWithTz, &r.TWithoutTz, &r.ID)
         if err != nil {
-                return nil, errors.WithStack(err)
+                return errors.WithStack(translateError("t2", err))
         }
-        return &r, nil
-}
-`,
-		},
-		{
-			table: tbls[2],
-			expect: `// Create inserts the T3 to the database.
-// %EMPTY_COMMENT%
-// Deprecated: Use CreateContext instead.
-func (r *T3) Create(db Queryer) error {
-        return r.CreateContext(context.Background(), db)
+        n, err := result.RowsAffected()
+        if err != nil {
+                return errors.WithStack(translateError("t2", err))
+        }
+        if n == 0 {
+                err = sql.ErrNoRows
+                return errors.WithStack(translateError("t2", err))
+        }
+        return afterUpdate(ctx, r)
 }
 
-// GetT3ByPk select the T3 from the database.
-//
-// Deprecated: Use GetT3ByPkContext instead.
-func GetT3ByPk(db Queryer, pk0 int64, pk1 int) (*T3, error) {
-        return GetT3ByPkContext(context.Background(), db, pk0, pk1)
+// DeleteContext deletes the T2 from the database by the primary key.
+// *NotFoundError is returned if the row is not found.
+func (r *T2) DeleteContext(ctx context.Context, db Queryer) error {
+        if err := beforeDelete(ctx, r); err != nil {
+                return err
+        }
+        result, err := db.ExecContext(ctx,
+                ` + "`DELETE FROM t2 WHERE id = $1`" + `,
+                &r.ID)
+        if err != nil {
+                return errors.WithStack(translateError("t2", err))
+        }
+        n, err := result.RowsAffected()
+        if err != nil {
+                return errors.WithStack(translateError("t2", err))
+        }
+        if n == 0 {
+                err = sql.ErrNoRows
+                return errors.WithStack(translateError("t2", err))
+        }
+        return afterDelete(ctx, r)
 }
 
-// CreateContext inserts the T3 to the database.
-func (r *T3) CreateContext(ctx context.Context, db Queryer) error {
+// ReloadContext selects the T2 again by its primary key, and overwrites the fields with the
+// values in the database, e.g. after a trigger or a stored procedure changed the row.
+// *NotFoundError is returned if the row is not found.
+func (r *T2) ReloadContext(ctx context.Context, db Queryer) error {
         err := db.QueryRowContext(ctx,
-                ` + "`INSERT INTO t3 (str, t_with_tz, t_without_tz) VALUES ($1, $2, $3) RETURNING id, i`" + `,
-                &r.Str, &r.TWithTz, &r.TWithoutTz).Scan(&r.ID, &r.I)
+                ` + "`SELECT id, i, str, t_with_tz, t_without_tz FROM t2 WHERE id = $1`" + `,
+                r.ID).Scan(&r.ID, &r.I, &r.Str, &r.TWithTz, &r.TWithoutTz)
         if err != nil {
-                return errors.WithStack(err)
+                return errors.WithStack(translateError("t2", err))
         }
         return nil
 }
 
-// CreateOnConflictDoNothing inserts the T3 to the database.
-// If a conflict occurs (e.g., unique constraint violation), the insert is skipped without error.
-// Returns true if the row was inserted, false if it was skipped due to conflict.
-func (r *T3) CreateOnConflictDoNothing(ctx context.Context, db Queryer) (bool, error) {
+// GetT2ByPkContext select the T2 from the database.
+func GetT2ByPkContext(ctx context.Context, db Queryer, pk0 int64) (*T2, error) {
+        var r T2
         err := db.QueryRowContext(ctx,
-                ` + "`INSERT INTO t3 (str, t_with_tz, t_without_tz) VALUES ($1, $2, $3) ON CONFLICT DO NOTHING RETURNING id, i`" + `,
-                &r.Str, &r.TWithTz, &r.TWithoutTz).Scan(&r.ID, &r.I)
+                ` + "`SELECT id, i, str, t_with_tz, t_without_tz FROM t2 WHERE id = $1`" + `,
+                pk0).Scan(&r.ID, &r.I, &r.Str, &r.TWithTz, &r.TWithoutTz)
         if err != nil {
-                if err == sql.ErrNoRows {
-                        return false, nil
-                }
-                return false, errors.WithStack(err)
+                return nil, errors.WithStack(translateError("t2", err))
         }
-        // Row was successfully inserted
-        return true, nil
+        return &r, nil
 }
 
-// GetT3ByPkContext select the T3 from the database.
-func GetT3ByPkContext(ctx context.Context, db Queryer, pk0 int64, pk1 int) (*T3, error) {
-        var r T3
+// GetT2ByPkForUpdateContext selects the T2 from the database with FOR UPDATE,
+// which locks the row until the end of the transaction. opt decides what happens if the row is locked
+// by another transaction: LockWait waits for it, LockNoWait fails with SQLSTATE 55P03, and
+// LockSkipLocked returns *NotFoundError.
+func GetT2ByPkForUpdateContext(ctx context.Context, db Queryer, pk0 int64, opt LockOption) (*T2, error) {
+        var r T2
         err := db.QueryRowContext(ctx,
-                ` + "`SELECT id, i, str, t_with_tz, t_without_tz FROM t3 WHERE id = $1 AND i = $2`" + `,
-                pk0, pk1).Scan(&r.ID, &r.I, &r.Str, &r.TWithTz, &r.TWithoutTz)
+                ` + "`SELECT id, i, str, t_with_tz, t_without_tz FROM t2 WHERE id = $1 FOR UPDATE`" + `+opt.sql(),
+                pk0).Scan(&r.ID, &r.I, &r.Str, &r.TWithTz, &r.TWithoutTz)
         if err != nil {
-                return nil, errors.WithStack(err)
+                return nil, errors.WithStack(translateError("t2", err))
         }
         return &r, nil
 }
-`,
-		},
-		{
-			table: tbls[3],
-			expect: `// Create inserts the T4 to the database.
-// %EMPTY_COMMENT%
-// Deprecated: Use CreateContext instead.
-func (r *T4) Create(db Queryer) error {
-        return r.CreateContext(context.Background(), db)
-}
 
-// GetT4ByPk select the T4 from the database.
-//
-// Deprecated: Use GetT4ByPkContext instead.
-func GetT4ByPk(db Queryer, pk0 int, pk1 int) (*T4, error) {
-        return GetT4ByPkContext(context.Background(), db, pk0, pk1)
+// GetT2ByPkForShareContext selects the T2 from the database with FOR SHARE,
+// which locks the row until the end of the transaction. opt decides what happens if the row is locked
+// by another transaction: LockWait waits for it, LockNoWait fails with SQLSTATE 55P03, and
+// LockSkipLocked returns *NotFoundError.
+func GetT2ByPkForShareContext(ctx context.Context, db Queryer, pk0 int64, opt LockOption) (*T2, error) {
+        var r T2
+        err := db.QueryRowContext(ctx,
+                ` + "`SELECT id, i, str, t_with_tz, t_without_tz FROM t2 WHERE id = $1 FOR SHARE`" + `+opt.sql(),
+                pk0).Scan(&r.ID, &r.I, &r.Str, &r.TWithTz, &r.TWithoutTz)
+        if err != nil {
+                return nil, errors.WithStack(translateError("t2", err))
+        }
+        return &r, nil
 }
 
-// CreateContext inserts the T4 to the database.
-func (r *T4) CreateContext(ctx context.Context, db Queryer) error {
-        _, err := db.ExecContext(ctx,
-                ` + "`INSERT INTO t4 (id, i) VALUES ($1, $2)`" + `,
-                &r.ID, &r.I)
+// ExistsT2ByPkContext checks if the T2 exists in the database.
+func ExistsT2ByPkContext(ctx context.Context, db Queryer, pk0 int64) (bool, error) {
+        var exists bool
+        err := db.QueryRowContext(ctx,
+                ` + "`SELECT EXISTS (SELECT 1 FROM t2 WHERE id = $1)`" + `,
+                pk0).Scan(&exists)
         if err != nil {
-                return errors.WithStack(err)
+                return false, errors.WithStack(translateError("t2", err))
         }
-        return nil
+        return exists, nil
 }
 
-// CreateOnConflictDoNothing inserts the T4 to the database.
-// If a conflict occurs (e.g., unique constraint violation), the insert is skipped without error.
-// Returns true if the row was inserted, false if it was skipped due to conflict.
-func (r *T4) CreateOnConflictDoNothing(ctx context.Context, db Queryer) (bool, error) {
-        result, err := db.ExecContext(ctx,
-                ` + "`INSERT INTO t4 (id, i) VALUES ($1, $2) ON CONFLICT DO NOTHING`" + `,
-                &r.ID, &r.I)
+// GetT2ByPksContext select the T2s of the given primary keys from the database.
+// The result is ordered by the primary key, and the keys which do not exist are skipped.
+func GetT2ByPksContext(ctx context.Context, db Queryer, pks []int64) ([]*T2, error) {
+        rows, err := db.QueryContext(ctx,
+                ` + "`SELECT id, i, str, t_with_tz, t_without_tz FROM t2 WHERE id = ANY($1) ORDER BY id`" + `,
+                pq.Array(pks))
         if err != nil {
-                return false, errors.WithStack(err)
+                return nil, errors.WithStack(translateError("t2", err))
         }
-        rowsAffected, err := result.RowsAffected()
+        defer rows.Close()
+        var rs []*T2
+        for rows.Next() {
+                var r T2
+                if err := rows.Scan(&r.ID, &r.I, &r.Str, &r.TWithTz, &r.TWithoutTz); err != nil {
+                        return nil, errors.WithStack(translateError("t2", err))
+                }
+                rs = append(rs, &r)
+        }
+        if err := rows.Err(); err != nil {
+                return nil, errors.WithStack(translateError("t2", err))
+        }
+        return rs, nil
+}
+
+// T2ListOptions is the options of ListT2.
+type T2ListOptions struct {
+        // Limit is the maximum number of rows to return. Zero means no limit.
+        Limit int
+        // After is the primary key of the last row of the previous page.
+        // Only the rows after it are returned when it is not nil.
+        After *int64
+}
+
+// ListT2 lists the T2s ordered by the primary key.
+// Pass the primary key of the last row as opts.After to get the next page.
+func ListT2(ctx context.Context, db Queryer, opts T2ListOptions) ([]*T2, error) {
+        q := ` + "`SELECT id, i, str, t_with_tz, t_without_tz FROM t2`" + `
+        var args []interface{}
+        if opts.After != nil {
+                q += ` + "` WHERE id > $1`" + `
+                args = append(args, *opts.After)
+        }
+        q += ` + "` ORDER BY id`" + `
+        if opts.Limit > 0 {
+                args = append(args, opts.Limit)
+                q += fmt.Sprintf(` + "` LIMIT $%d`" + `, len(args))
+        }
+        rows, err := db.QueryContext(ctx, q, args...)
         if err != nil {
-                return false, errors.WithStack(err)
+                return nil, errors.WithStack(translateError("t2", err))
+        }
+        defer rows.Close()
+        var rs []*T2
+        for rows.Next() {
+                var r T2
+                if err := rows.Scan(&r.ID, &r.I, &r.Str, &r.TWithTz, &r.TWithoutTz); err != nil {
+                        return nil, errors.WithStack(translateError("t2", err))
+                }
+                rs = append(rs, &r)
         }
-        return rowsAffected > 0, nil
+        if err := rows.Err(); err != nil {
+                return nil, errors.WithStack(translateError("t2", err))
+        }
+        return rs, nil
 }
 
-// GetT4ByPkContext select the T4 from the database.
-func GetT4ByPkContext(ctx context.Context, db Queryer, pk0 int, pk1 int) (*T4, error) {
-        var r T4
-        err := db.QueryRowContext(ctx,
-                ` + "`SELECT id, i FROM t4 WHERE id = $1 AND i = $2`" + `,
-                pk0, pk1).Scan(&r.ID, &r.I)
-        if err != nil {
-                return nil, errors.WithStack(err)
+// T2Filter builds a parameterized WHERE clause of t2.
+// The predicates are combined with AND.
+type T2Filter struct {
+        conds []string
+        args  []interface{}
+}
+
+// NewT2Filter creates an empty T2Filter, which matches every row.
+func NewT2Filter() *T2Filter {
+        return &T2Filter{}
+}
+
+func (f *T2Filter) add(cond string, v interface{}) *T2Filter {
+        f.args = append(f.args, v)
+        f.conds = append(f.conds, fmt.Sprintf(cond, len(f.args)))
+        return f
+}
+
+// Where returns the WHERE clause and its arguments. It returns an empty clause
+// if the filter is nil or has no predicate.
+func (f *T2Filter) Where() (string, []interface{}) {
+        if f == nil || len(f.conds) == 0 {
+                return "", nil
         }
-        return &r, nil
-}`,
-		},
-	}
-	for _, tt := range tests {
-		t.Run(tt.table.Name, func(t *testing.T) {
-			st, err := PgTableToStruct(tt.table, &defaultTypeMapCfg, autoGenKeyCfg, []string{}, "", nil)
-			if err != nil {
-				t.Fatal(err)
-			}
-			src, err := PgExecuteDefaultMethodTmpl(&StructTmpl{Struct: st})
-			if err != nil {
-				t.Fatal(err)
-			}
+        return " WHERE " + strings.Join(f.conds, " AND "), f.args
+}
 
-			re1 := regexp.MustCompile(`\s`)
+// IDEq filters the rows whose id equals to v.
+func (f *T2Filter) IDEq(v int64) *T2Filter {
+        return f.add("id = $%d", v)
+}
 
-			srcStr := string(src)
-			if re1.ReplaceAllString(srcStr, "") != re1.ReplaceAllString(tt.expect, "") {
-				t.Errorf("Expected generated code: %s, got: %s", tt.expect, srcStr)
-			}
-		})
-	}
+// IDIn filters the rows whose id is one of vs.
+func (f *T2Filter) IDIn(vs ...int64) *T2Filter {
+        return f.add("id = ANY($%d)", pq.Array(vs))
 }
 
-func TestPgCreateStruct(t *testing.T) {
-	conn, cleanup := testPgSetup(t)
-	defer cleanup()
-	assert := assert.New(t)
+// IDLt filters the rows whose id is less than v.
+func (f *T2Filter) IDLt(v int64) *T2Filter {
+        return f.add("id < $%d", v)
+}
 
-	schema := "public"
-	src, err := PgCreateStruct(conn, schema, "", "mypkg", "", []string{}, []string{}, []string{}, []string{}, "")
-	if err != nil {
-		t.Fatal(err)
-	}
+// IDGt filters the rows whose id is greater than v.
+func (f *T2Filter) IDGt(v int64) *T2Filter {
+        return f.add("id > $%d", v)
+}
 
-	expected := `// Code generated by dgw. DO NOT EDIT.
+// IEq filters the rows whose i equals to v.
+func (f *T2Filter) IEq(v int) *T2Filter {
+        return f.add("i = $%d", v)
+}
 
-package mypkg
+// IIn filters the rows whose i is one of vs.
+func (f *T2Filter) IIn(vs ...int) *T2Filter {
+        return f.add("i = ANY($%d)", pq.Array(vs))
+}
 
-// T1 represents public.t1
-type T1 struct {
-	ID          int64          // id
-	I           int            // i
-	Str         string         // str
-	NullableStr sql.NullString // nullable_str
-	TWithTz     time.Time      // t_with_tz
-	TWithoutTz  time.Time      // t_without_tz
-	Tm          *time.Time     // tm
+// ILt filters the rows whose i is less than v.
+func (f *T2Filter) ILt(v int) *T2Filter {
+        return f.add("i < $%d", v)
 }
-// Create inserts the T1 to the database.
-//
-// Deprecated: Use CreateContext instead.
-func (r *T1) Create(db Queryer) error {
-	return r.CreateContext(context.Background(), db)
+
+// IGt filters the rows whose i is greater than v.
+func (f *T2Filter) IGt(v int) *T2Filter {
+        return f.add("i > $%d", v)
 }
 
-// GetT1ByPk select the T1 from the database.
-//
-// Deprecated: Use GetT1ByPkContext instead.
-func GetT1ByPk(db Queryer, pk0 int64) (*T1, error) {
-	return GetT1ByPkContext(context.Background(), db, pk0)
+// StrEq filters the rows whose str equals to v.
+func (f *T2Filter) StrEq(v string) *T2Filter {
+        return f.add("str = $%d", v)
 }
 
-// CreateContext inserts the T1 to the database.
-func (r *T1) CreateContext(ctx context.Context, db Queryer) error {
-	err := db.QueryRowContext(ctx,
-		` + "`INSERT INTO t1 (i, str, nullable_str, t_with_tz, t_without_tz, tm) VALUES ($1, $2, $3, $4, $5, $6) RETURNING id`" + `,
-		&r.I, &r.Str, &r.NullableStr, &r.TWithTz, &r.TWithoutTz, &r.Tm).Scan(&r.ID)
-	if err != nil {
-		return errors.WithStack(err)
-	}
-	return nil
+// StrIn filters the rows whose str is one of vs.
+func (f *T2Filter) StrIn(vs ...string) *T2Filter {
+        return f.add("str = ANY($%d)", pq.Array(vs))
 }
 
-// CreateOnConflictDoNothing inserts the T1 to the database.
-// If a conflict occurs (e.g., unique constraint violation), the insert is skipped without error.
-// Returns true if the row was inserted, false if it was skipped due to conflict.
-func (r *T1) CreateOnConflictDoNothing(ctx context.Context, db Queryer) (bool, error) {
-	err := db.QueryRowContext(ctx,
-		` + "`INSERT INTO t1 (i, str, nullable_str, t_with_tz, t_without_tz, tm) VALUES ($1, $2, $3, $4, $5, $6) ON CONFLICT DO NOTHING RETURNING id`" + `,
-		&r.I, &r.Str, &r.NullableStr, &r.TWithTz, &r.TWithoutTz, &r.Tm).Scan(&r.ID)
-	if err != nil {
-		if err == sql.ErrNoRows {
-			return false, nil
-		}
-		return false, errors.WithStack(err)
-	}
-	// Row was successfully inserted
-	return true, nil
+// StrLt filters the rows whose str is less than v.
+func (f *T2Filter) StrLt(v string) *T2Filter {
+        return f.add("str < $%d", v)
 }
 
-// GetT1ByPkContext select the T1 from the database.
-func GetT1ByPkContext(ctx context.Context, db Queryer, pk0 int64) (*T1, error) {
-	var r T1
-	err := db.QueryRowContext(ctx,
-		` + "`SELECT id, i, str, nullable_str, t_with_tz, t_without_tz, tm FROM t1 WHERE id = $1`" + `,
-		pk0).Scan(&r.ID, &r.I, &r.Str, &r.NullableStr, &r.TWithTz, &r.TWithoutTz, &r.Tm)
-	if err != nil {
-		return nil, errors.WithStack(err)
-	}
-	return &r, nil
+// StrGt filters the rows whose str is greater than v.
+func (f *T2Filter) StrGt(v string) *T2Filter {
+        return f.add("str > $%d", v)
 }
-// T2 represents public.t2
-type T2 struct {
-	ID         int64     // id
-	I          int       // i
-	Str        string    // str
-	TWithTz    time.Time // t_with_tz
-	TWithoutTz time.Time // t_without_tz
+
+// TWithTzEq filters the rows whose t_with_tz equals to v.
+func (f *T2Filter) TWithTzEq(v time.Time) *T2Filter {
+        return f.add("t_with_tz = $%d", v)
 }
-// Create inserts the T2 to the database.
-//
-// Deprecated: Use CreateContext instead.
-func (r *T2) Create(db Queryer) error {
-	return r.CreateContext(context.Background(), db)
+
+// TWithTzIn filters the rows whose t_with_tz is one of vs.
+func (f *T2Filter) TWithTzIn(vs ...time.Time) *T2Filter {
+        return f.add("t_with_tz = ANY($%d)", pq.Array(vs))
 }
 
-// GetT2ByPk select the T2 from the database.
-//
-// Deprecated: Use GetT2ByPkContext instead.
-func GetT2ByPk(db Queryer, pk0 int64) (*T2, error) {
-	return GetT2ByPkContext(context.Background(), db, pk0)
+// TWithTzLt filters the rows whose t_with_tz is less than v.
+func (f *T2Filter) TWithTzLt(v time.Time) *T2Filter {
+        return f.add("t_with_tz < $%d", v)
 }
 
-// CreateContext inserts the T2 to the database.
-func (r *T2) CreateContext(ctx context.Context, db Queryer) error {
-	err := db.QueryRowContext(ctx,
-		` + "`INSERT INTO t2 (i, str, t_with_tz, t_without_tz) VALUES ($1, $2, $3, $4) RETURNING id`" + `,
-		&r.I, &r.Str, &r.TWithTz, &r.TWithoutTz).Scan(&r.ID)
-	if err != nil {
-		return errors.WithStack(err)
-	}
-	return nil
+// TWithTzGt filters the rows whose t_with_tz is greater than v.
+func (f *T2Filter) TWithTzGt(v time.Time) *T2Filter {
+        return f.add("t_with_tz > $%d", v)
 }
 
-// CreateOnConflictDoNothing inserts the T2 to the database.
-// If a conflict occurs (e.g., unique constraint violation), the insert is skipped without error.
-// Returns true if the row was inserted, false if it was skipped due to conflict.
-func (r *T2) CreateOnConflictDoNothing(ctx context.Context, db Queryer) (bool, error) {
-	err := db.QueryRowContext(ctx,
-		` + "`INSERT INTO t2 (i, str, t_with_tz, t_without_tz) VALUES ($1, $2, $3, $4) ON CONFLICT DO NOTHING RETURNING id`" + `,
-		&r.I, &r.Str, &r.TWithTz, &r.TWithoutTz).Scan(&r.ID)
-	if err != nil {
-		if err == sql.ErrNoRows {
-			return false, nil
-		}
-		return false, errors.WithStack(err)
-	}
-	// Row was successfully inserted
-	return true, nil
+// TWithoutTzEq filters the rows whose t_without_tz equals to v.
+func (f *T2Filter) TWithoutTzEq(v time.Time) *T2Filter {
+        return f.add("t_without_tz = $%d", v)
 }
 
-// GetT2ByPkContext select the T2 from the database.
-func GetT2ByPkContext(ctx context.Context, db Queryer, pk0 int64) (*T2, error) {
-	var r T2
-	err := db.QueryRowContext(ctx,
-		` + "`SELECT id, i, str, t_with_tz, t_without_tz FROM t2 WHERE id = $1`" + `,
-		pk0).Scan(&r.ID, &r.I, &r.Str, &r.TWithTz, &r.TWithoutTz)
-	if err != nil {
-		return nil, errors.WithStack(err)
-	}
-	return &r, nil
+// TWithoutTzIn filters the rows whose t_without_tz is one of vs.
+func (f *T2Filter) TWithoutTzIn(vs ...time.Time) *T2Filter {
+        return f.add("t_without_tz = ANY($%d)", pq.Array(vs))
 }
-// T3 represents public.t3
-type T3 struct {
-	ID         int64     // id
-	I          int       // i
-	Str        string    // str
-	TWithTz    time.Time // t_with_tz
-	TWithoutTz time.Time // t_without_tz
+
+// TWithoutTzLt filters the rows whose t_without_tz is less than v.
+func (f *T2Filter) TWithoutTzLt(v time.Time) *T2Filter {
+        return f.add("t_without_tz < $%d", v)
 }
-// Create inserts the T3 to the database.
-//
+
+// TWithoutTzGt filters the rows whose t_without_tz is greater than v.
+func (f *T2Filter) TWithoutTzGt(v time.Time) *T2Filter {
+        return f.add("t_without_tz > $%d", v)
+}
+
+// FindT2 selects the T2s matching the filter from the database.
+// The result is ordered by the primary key.
+func FindT2(ctx context.Context, db Queryer, f *T2Filter) ([]*T2, error) {
+        where, args := f.Where()
+        rows, err := db.QueryContext(ctx,
+                ` + "`SELECT id, i, str, t_with_tz, t_without_tz FROM t2`" + `+where+` + "` ORDER BY id`" + `,
+                args...)
+        if err != nil {
+                return nil, errors.WithStack(translateError("t2", err))
+        }
+        defer rows.Close()
+        var rs []*T2
+        for rows.Next() {
+                var r T2
+                if err := rows.Scan(&r.ID, &r.I, &r.Str, &r.TWithTz, &r.TWithoutTz); err != nil {
+                        return nil, errors.WithStack(translateError("t2", err))
+                }
+                rs = append(rs, &r)
+        }
+        if err := rows.Err(); err != nil {
+                return nil, errors.WithStack(translateError("t2", err))
+        }
+        return rs, nil
+}
+
+// CountT2 counts the T2s matching the filter in the database.
+// Pass nil as the filter to count every row.
+func CountT2(ctx context.Context, db Queryer, f *T2Filter) (int64, error) {
+        where, args := f.Where()
+        var n int64
+        err := db.QueryRowContext(ctx,
+                ` + "`SELECT count(*) FROM t2`" + `+where,
+                args...).Scan(&n)
+        if err != nil {
+                return 0, errors.WithStack(translateError("t2", err))
+        }
+        return n, nil
+}
+
+// DeleteT2Where deletes the T2s matching the filter from the database,
+// and returns the number of the deleted rows. A filter without predicate is rejected
+// to avoid deleting every row by mistake.
+func DeleteT2Where(ctx context.Context, db Queryer, f *T2Filter) (int64, error) {
+        where, args := f.Where()
+        if where == "" {
+                return 0, errors.New("refusing to delete every row of t2 with an empty filter")
+        }
+        result, err := db.ExecContext(ctx,
+                ` + "`DELETE FROM t2`" + `+where,
+                args...)
+        if err != nil {
+                return 0, errors.WithStack(translateError("t2", err))
+        }
+        n, err := result.RowsAffected()
+        if err != nil {
+                return 0, errors.WithStack(translateError("t2", err))
+        }
+        return n, nil
+}
+`,
+		},
+		{
+			table: tbls[2],
+			expect: `// Create inserts the T3 to the database.
+// %EMPTY_COMMENT%
 // Deprecated: Use CreateContext instead.
 func (r *T3) Create(db Queryer) error {
-	return r.CreateContext(context.Background(), db)
+        return r.CreateContext(context.Background(), db)
 }
 
 // GetT3ByPk select the T3 from the database.
 //
 // Deprecated: Use GetT3ByPkContext instead.
 func GetT3ByPk(db Queryer, pk0 int64, pk1 int) (*T3, error) {
-	return GetT3ByPkContext(context.Background(), db, pk0, pk1)
+        return GetT3ByPkContext(context.Background(), db, pk0, pk1)
+}
+
+// Validate checks the T3 against the constraints of t3 which can be checked
+// without the database, and returns *ValidationError listing every failing field.
+func (r *T3) Validate() error {
+        var errs []FieldError
+        if r.Str == "" {
+                errs = append(errs, FieldError{Field: "Str", Column: "str", Message: "must not be empty"})
+        }
+        if len(errs) > 0 {
+                return &ValidationError{Table: "t3", Fields: errs}
+        }
+        return nil
 }
 
 // CreateContext inserts the T3 to the database.
 func (r *T3) CreateContext(ctx context.Context, db Queryer) error {
-	err := db.QueryRowContext(ctx,
-		` + "`INSERT INTO t3 (str, t_with_tz, t_without_tz) VALUES ($1, $2, $3) RETURNING id, i`" + `,
-		&r.Str, &r.TWithTz, &r.TWithoutTz).Scan(&r.ID, &r.I)
-	if err != nil {
-		return errors.WithStack(err)
-	}
-	return nil
+        if err := beforeCreate(ctx, r); err != nil {
+                return err
+        }
+        err := db.QueryRowContext(ctx,
+                ` + "`INSERT INTO t3 (i, str, t_with_tz, t_without_tz) VALUES ($1, $2, $3, $4) RETURNING id`" + `,
+                &r.I, &r.Str, &r.TWithTz, &r.TWithoutTz).Scan(&r.ID)
+        if err != nil {
+                return errors.WithStack(translateError("t3", err))
+        }
+        return afterCreate(ctx, r)
 }
 
 // CreateOnConflictDoNothing inserts the T3 to the database.
 // If a conflict occurs (e.g., unique constraint violation), the insert is skipped without error.
 // Returns true if the row was inserted, false if it was skipped due to conflict.
 func (r *T3) CreateOnConflictDoNothing(ctx context.Context, db Queryer) (bool, error) {
-	err := db.QueryRowContext(ctx,
-		` + "`INSERT INTO t3 (str, t_with_tz, t_without_tz) VALUES ($1, $2, $3) ON CONFLICT DO NOTHING RETURNING id, i`" + `,
-		&r.Str, &r.TWithTz, &r.TWithoutTz).Scan(&r.ID, &r.I)
-	if err != nil {
-		if err == sql.ErrNoRows {
-			return false, nil
-		}
-		return false, errors.WithStack(err)
-	}
-	// Row was successfully inserted
-	return true, nil
+        if err := beforeCreate(ctx, r); err != nil {
+                return false, err
+        }
+        err := db.QueryRowContext(ctx,
+                ` + "`INSERT INTO t3 (i, str, t_with_tz, t_without_tz) VALUES ($1, $2, $3, $4) ON CONFLICT DO NOTHING RETURNING id`" + `,
+                &r.I, &r.Str, &r.TWithTz, &r.TWithoutTz).Scan(&r.ID)
+        if err != nil {
+                if err == sql.ErrNoRows {
+                        return false, nil
+                }
+                return false, errors.WithStack(translateError("t3", err))
+        }
+        // Row was successfully inserted
+        return true, afterCreate(ctx, r)
 }
 
-// GetT3ByPkContext select the T3 from the database.
-func GetT3ByPkContext(ctx context.Context, db Queryer, pk0 int64, pk1 int) (*T3, error) {
-	var r T3
-	err := db.QueryRowContext(ctx,
-		` + "`SELECT id, i, str, t_with_tz, t_without_tz FROM t3 WHERE id = $1 AND i = $2`" + `,
-		pk0, pk1).Scan(&r.ID, &r.I, &r.Str, &r.TWithTz, &r.TWithoutTz)
-	if err != nil {
-		return nil, errors.WithStack(err)
-	}
-	return &r, nil
-}
-// T4 represents public.t4
-type T4 struct {
-	ID int // id
-	I  int // i
+// UpdateContext updates the T3 in the database by the primary key.
+// *NotFoundError is returned if the row is not found.
+func (r *T3) UpdateContext(ctx context.Context, db Queryer) error {
+        if err := beforeUpdate(ctx, r); err != nil {
+                return err
+        }
+        result, err := db.ExecContext(ctx,
+                ` + "`UPDATE t3 SET str = $1, t_with_tz = $2, t_without_tz = $3 WHERE id = $4 AND i = $5`" + `,
+                &r.Str, &r.TWithTz, &r.TWithoutTz, &r.ID, &r.I)
+        if err != nil {
+                return errors.WithStack(translateError("t3", err))
+        }
+        n, err := result.RowsAffected()
+        if err != nil {
+                return errors.WithStack(translateError("t3", err))
+        }
+        if n == 0 {
+                err = sql.ErrNoRows
+                return errors.WithStack(translateError("t3", err))
+        }
+        return afterUpdate(ctx, r)
 }
-// Create inserts the T4 to the database.
-//
-// Deprecated: Use CreateContext instead.
-func (r *T4) Create(db Queryer) error {
-	return r.CreateContext(context.Background(), db)
+
+// DeleteContext deletes the T3 from the database by the primary key.
+// *NotFoundError is returned if the row is not found.
+func (r *T3) DeleteContext(ctx context.Context, db Queryer) error {
+        if err := beforeDelete(ctx, r); err != nil {
+                return err
+        }
+        result, err := db.ExecContext(ctx,
+                ` + "`DELETE FROM t3 WHERE id = $1 AND i = $2`" + `,
+                &r.ID, &r.I)
+        if err != nil {
+                return errors.WithStack(translateError("t3", err))
+        }
+        n, err := result.RowsAffected()
+        if err != nil {
+                return errors.WithStack(translateError("t3", err))
+        }
+        if n == 0 {
+                err = sql.ErrNoRows
+                return errors.WithStack(translateError("t3", err))
+        }
+        return afterDelete(ctx, r)
 }
 
-// GetT4ByPk select the T4 from the database.
-//
-// Deprecated: Use GetT4ByPkContext instead.
-func GetT4ByPk(db Queryer, pk0 int, pk1 int) (*T4, error) {
-	return GetT4ByPkContext(context.Background(), db, pk0, pk1)
+// ReloadContext selects the T3 again by its primary key, and overwrites the fields with the
+// values in the database, e.g. after a trigger or a stored procedure changed the row.
+// *NotFoundError is returned if the row is not found.
+func (r *T3) ReloadContext(ctx context.Context, db Queryer) error {
+        err := db.QueryRowContext(ctx,
+                ` + "`SELECT id, i, str, t_with_tz, t_without_tz FROM t3 WHERE id = $1 AND i = $2`" + `,
+                r.ID, r.I).Scan(&r.ID, &r.I, &r.Str, &r.TWithTz, &r.TWithoutTz)
+        if err != nil {
+                return errors.WithStack(translateError("t3", err))
+        }
+        return nil
 }
 
-// CreateContext inserts the T4 to the database.
-func (r *T4) CreateContext(ctx context.Context, db Queryer) error {
-	_, err := db.ExecContext(ctx,
-		` + "`INSERT INTO t4 (id, i) VALUES ($1, $2)`" + `,
-		&r.ID, &r.I)
-	if err != nil {
-		return errors.WithStack(err)
-	}
-	return nil
+// GetT3ByPkContext select the T3 from the database.
+func GetT3ByPkContext(ctx context.Context, db Queryer, pk0 int64, pk1 int) (*T3, error) {
+        var r T3
+        err := db.QueryRowContext(ctx,
+                ` + "`SELECT id, i, str, t_with_tz, t_without_tz FROM t3 WHERE id = $1 AND i = $2`" + `,
+                pk0, pk1).Scan(&r.ID, &r.I, &r.Str, &r.TWithTz, &r.TWithoutTz)
+        if err != nil {
+                return nil, errors.WithStack(translateError("t3", err))
+        }
+        return &r, nil
 }
 
-// CreateOnConflictDoNothing inserts the T4 to the database.
-// If a conflict occurs (e.g., unique constraint violation), the insert is skipped without error.
-// Returns true if the row was inserted, false if it was skipped due to conflict.
-func (r *T4) CreateOnConflictDoNothing(ctx context.Context, db Queryer) (bool, error) {
-	result, err := db.ExecContext(ctx,
-		` + "`INSERT INTO t4 (id, i) VALUES ($1, $2) ON CONFLICT DO NOTHING`" + `,
-		&r.ID, &r.I)
-	if err != nil {
-		return false, errors.WithStack(err)
-	}
-	rowsAffected, err := result.RowsAffected()
-	if err != nil {
-		return false, errors.WithStack(err)
-	}
-	return rowsAffected > 0, nil
+// GetT3ByPkForUpdateContext selects the T3 from the database with FOR UPDATE,
+// which locks the row until the end of the transaction. opt decides what happens if the row is locked
+// by another transaction: LockWait waits for it, LockNoWait fails with SQLSTATE 55P03, and
+// LockSkipLocked returns *NotFoundError.
+func GetT3ByPkForUpdateContext(ctx context.Context, db Queryer, pk0 int64, pk1 int, opt LockOption) (*T3, error) {
+        var r T3
+        err := db.QueryRowContext(ctx,
+                ` + "`SELECT id, i, str, t_with_tz, t_without_tz FROM t3 WHERE id = $1 AND i = $2 FOR UPDATE`" + `+opt.sql(),
+                pk0, pk1).Scan(&r.ID, &r.I, &r.Str, &r.TWithTz, &r.TWithoutTz)
+        if err != nil {
+                return nil, errors.WithStack(translateError("t3", err))
+        }
+        return &r, nil
 }
 
-// GetT4ByPkContext select the T4 from the database.
-func GetT4ByPkContext(ctx context.Context, db Queryer, pk0 int, pk1 int) (*T4, error) {
-	var r T4
-	err := db.QueryRowContext(ctx,
-		` + "`SELECT id, i FROM t4 WHERE id = $1 AND i = $2`" + `,
-		pk0, pk1).Scan(&r.ID, &r.I)
-	if err != nil {
-		return nil, errors.WithStack(err)
-	}
-	return &r, nil
+// GetT3ByPkForShareContext selects the T3 from the database with FOR SHARE,
+// which locks the row until the end of the transaction. opt decides what happens if the row is locked
+// by another transaction: LockWait waits for it, LockNoWait fails with SQLSTATE 55P03, and
+// LockSkipLocked returns *NotFoundError.
+func GetT3ByPkForShareContext(ctx context.Context, db Queryer, pk0 int64, pk1 int, opt LockOption) (*T3, error) {
+        var r T3
+        err := db.QueryRowContext(ctx,
+                ` + "`SELECT id, i, str, t_with_tz, t_without_tz FROM t3 WHERE id = $1 AND i = $2 FOR SHARE`" + `+opt.sql(),
+                pk0, pk1).Scan(&r.ID, &r.I, &r.Str, &r.TWithTz, &r.TWithoutTz)
+        if err != nil {
+                return nil, errors.WithStack(translateError("t3", err))
+        }
+        return &r, nil
 }
-// T5 represents public.t5
-type T5 struct {
-	ID int // id
-	I  int // i
+
+// ExistsT3ByPkContext checks if the T3 exists in the database.
+func ExistsT3ByPkContext(ctx context.Context, db Queryer, pk0 int64, pk1 int) (bool, error) {
+        var exists bool
+        err := db.QueryRowContext(ctx,
+                ` + "`SELECT EXISTS (SELECT 1 FROM t3 WHERE id = $1 AND i = $2)`" + `,
+                pk0, pk1).Scan(&exists)
+        if err != nil {
+                return false, errors.WithStack(translateError("t3", err))
+        }
+        return exists, nil
 }
-// Create inserts the T5 to the database.
-//
-// Deprecated: Use CreateContext instead.
-func (r *T5) Create(db Queryer) error {
-	return r.CreateContext(context.Background(), db)
+
+// GetT3ByPksContext select the T3s of the given primary keys from the database.
+// The result is ordered by the primary key, and the keys which do not exist are skipped.
+func GetT3ByPksContext(ctx context.Context, db Queryer, pks []T3Pk) ([]*T3, error) {
+        if len(pks) == 0 {
+                return nil, nil
+        }
+        values := make([]string, 0, len(pks))
+        args := make([]interface{}, 0, len(pks)*2)
+        for _, pk := range pks {
+                args = append(args, pk.ID, pk.I)
+                values = append(values, fmt.Sprintf("($%d::bigint, $%d::integer)", len(args)-1, len(args)))
+        }
+        rows, err := db.QueryContext(ctx,
+                fmt.Sprintf(` + "`SELECT id, i, str, t_with_tz, t_without_tz FROM t3 WHERE (id, i) IN (VALUES %s) ORDER BY id, i`" + `, strings.Join(values, ", ")),
+                args...)
+        if err != nil {
+                return nil, errors.WithStack(translateError("t3", err))
+        }
+        defer rows.Close()
+        var rs []*T3
+        for rows.Next() {
+                var r T3
+                if err := rows.Scan(&r.ID, &r.I, &r.Str, &r.TWithTz, &r.TWithoutTz); err != nil {
+                        return nil, errors.WithStack(translateError("t3", err))
+                }
+                rs = append(rs, &r)
+        }
+        if err := rows.Err(); err != nil {
+                return nil, errors.WithStack(translateError("t3", err))
+        }
+        return rs, nil
+}
+
+// T3ListOptions is the options of ListT3.
+type T3ListOptions struct {
+        // Limit is the maximum number of rows to return. Zero means no limit.
+        Limit int
+        // After is the primary key of the last row of the previous page.
+        // Only the rows after it are returned when it is not nil.
+        After *T3Pk
+}
+
+// ListT3 lists the T3s ordered by the primary key.
+// Pass the primary key of the last row as opts.After to get the next page.
+func ListT3(ctx context.Context, db Queryer, opts T3ListOptions) ([]*T3, error) {
+        q := ` + "`SELECT id, i, str, t_with_tz, t_without_tz FROM t3`" + `
+        var args []interface{}
+        if opts.After != nil {
+                q += ` + "` WHERE (id, i) > ($1, $2)`" + `
+                args = append(args, opts.After.ID, opts.After.I)
+        }
+        q += ` + "` ORDER BY id, i`" + `
+        if opts.Limit > 0 {
+                args = append(args, opts.Limit)
+                q += fmt.Sprintf(` + "` LIMIT $%d`" + `, len(args))
+        }
+        rows, err := db.QueryContext(ctx, q, args...)
+        if err != nil {
+                return nil, errors.WithStack(translateError("t3", err))
+        }
+        defer rows.Close()
+        var rs []*T3
+        for rows.Next() {
+                var r T3
+                if err := rows.Scan(&r.ID, &r.I, &r.Str, &r.TWithTz, &r.TWithoutTz); err != nil {
+                        return nil, errors.WithStack(translateError("t3", err))
+                }
+                rs = append(rs, &r)
+        }
+        if err := rows.Err(); err != nil {
+                return nil, errors.WithStack(translateError("t3", err))
+        }
+        return rs, nil
 }
 
-// GetT5ByPk select the T5 from the database.
-//
-// Deprecated: Use GetT5ByPkContext instead.
-func GetT5ByPk(db Queryer, pk0 int, pk1 int) (*T5, error) {
-	return GetT5ByPkContext(context.Background(), db, pk0, pk1)
+// T3Filter builds a parameterized WHERE clause of t3.
+// The predicates are combined with AND.
+type T3Filter struct {
+        conds []string
+        args  []interface{}
 }
 
-// CreateContext inserts the T5 to the database.
-func (r *T5) CreateContext(ctx context.Context, db Queryer) error {
-	err := db.QueryRowContext(ctx,
-		` + "`INSERT INTO t5 () VALUES () RETURNING id, i`" + `,
-	).Scan(&r.ID, &r.I)
-	if err != nil {
-		return errors.WithStack(err)
-	}
-	return nil
+// NewT3Filter creates an empty T3Filter, which matches every row.
+func NewT3Filter() *T3Filter {
+        return &T3Filter{}
 }
 
-// CreateOnConflictDoNothing inserts the T5 to the database.
-// If a conflict occurs (e.g., unique constraint violation), the insert is skipped without error.
-// Returns true if the row was inserted, false if it was skipped due to conflict.
-func (r *T5) CreateOnConflictDoNothing(ctx context.Context, db Queryer) (bool, error) {
-	err := db.QueryRowContext(ctx,
-		` + "`INSERT INTO t5 () VALUES () ON CONFLICT DO NOTHING RETURNING id, i`" + `,
-	).Scan(&r.ID, &r.I)
-	if err != nil {
-		if err == sql.ErrNoRows {
-			return false, nil
-		}
-		return false, errors.WithStack(err)
-	}
-	// Row was successfully inserted
-	return true, nil
+func (f *T3Filter) add(cond string, v interface{}) *T3Filter {
+        f.args = append(f.args, v)
+        f.conds = append(f.conds, fmt.Sprintf(cond, len(f.args)))
+        return f
 }
 
-// GetT5ByPkContext select the T5 from the database.
-func GetT5ByPkContext(ctx context.Context, db Queryer, pk0 int, pk1 int) (*T5, error) {
-	var r T5
-	err := db.QueryRowContext(ctx,
-		` + "`SELECT id, i FROM t5 WHERE id = $1 AND i = $2`" + `,
-		pk0, pk1).Scan(&r.ID, &r.I)
-	if err != nil {
-		return nil, errors.WithStack(err)
-	}
-	return &r, nil
+// Where returns the WHERE clause and its arguments. It returns an empty clause
+// if the filter is nil or has no predicate.
+func (f *T3Filter) Where() (string, []interface{}) {
+        if f == nil || len(f.conds) == 0 {
+                return "", nil
+        }
+        return " WHERE " + strings.Join(f.conds, " AND "), f.args
 }
-// T6 represents public.t6
-type T6 struct {
-	ID int // id
-	I  int // i
+
+// IDEq filters the rows whose id equals to v.
+func (f *T3Filter) IDEq(v int64) *T3Filter {
+        return f.add("id = $%d", v)
 }
-// Create inserts the T6 to the database.
-//
-// Deprecated: Use CreateContext instead.
-func (r *T6) Create(db Queryer) error {
-	return r.CreateContext(context.Background(), db)
+
+// IDIn filters the rows whose id is one of vs.
+func (f *T3Filter) IDIn(vs ...int64) *T3Filter {
+        return f.add("id = ANY($%d)", pq.Array(vs))
 }
 
-// GetT6ByPk select the T6 from the database.
-//
-// Deprecated: Use GetT6ByPkContext instead.
-func GetT6ByPk(db Queryer, pk0 int, pk1 int) (*T6, error) {
-	return GetT6ByPkContext(context.Background(), db, pk0, pk1)
+// IDLt filters the rows whose id is less than v.
+func (f *T3Filter) IDLt(v int64) *T3Filter {
+        return f.add("id < $%d", v)
 }
 
-// CreateContext inserts the T6 to the database.
-func (r *T6) CreateContext(ctx context.Context, db Queryer) error {
-	err := db.QueryRowContext(ctx,
-		` + "`INSERT INTO t6 () VALUES () RETURNING id, i`" + `,
-	).Scan(&r.ID, &r.I)
-	if err != nil {
-		return errors.WithStack(err)
-	}
-	return nil
+// IDGt filters the rows whose id is greater than v.
+func (f *T3Filter) IDGt(v int64) *T3Filter {
+        return f.add("id > $%d", v)
 }
 
-// CreateOnConflictDoNothing inserts the T6 to the database.
-// If a conflict occurs (e.g., unique constraint violation), the insert is skipped without error.
-// Returns true if the row was inserted, false if it was skipped due to conflict.
-func (r *T6) CreateOnConflictDoNothing(ctx context.Context, db Queryer) (bool, error) {
-	err := db.QueryRowContext(ctx,
-		` + "`INSERT INTO t6 () VALUES () ON CONFLICT DO NOTHING RETURNING id, i`" + `,
-	).Scan(&r.ID, &r.I)
-	if err != nil {
-		if err == sql.ErrNoRows {
-			return false, nil
-		}
-		return false, errors.WithStack(err)
-	}
-	// Row was successfully inserted
-	return true, nil
+// IEq filters the rows whose i equals to v.
+func (f *T3Filter) IEq(v int) *T3Filter {
+        return f.add("i = $%d", v)
 }
 
-// GetT6ByPkContext select the T6 from the database.
-func GetT6ByPkContext(ctx context.Context, db Queryer, pk0 int, pk1 int) (*T6, error) {
-	var r T6
-	err := db.QueryRowContext(ctx,
-		` + "`SELECT id, i FROM t6 WHERE id = $1 AND i = $2`" + `,
-		pk0, pk1).Scan(&r.ID, &r.I)
-	if err != nil {
-		return nil, errors.WithStack(err)
-	}
-	return &r, nil
+// IIn filters the rows whose i is one of vs.
+func (f *T3Filter) IIn(vs ...int) *T3Filter {
+        return f.add("i = ANY($%d)", pq.Array(vs))
 }
-`
 
-	assert.Equal(expected, string(src))
+// ILt filters the rows whose i is less than v.
+func (f *T3Filter) ILt(v int) *T3Filter {
+        return f.add("i < $%d", v)
 }
 
-func TestPgCreateStructWithAutoGenKey(t *testing.T) {
-	conn, cleanup := testPgSetup(t)
-	defer cleanup()
-	assert := assert.New(t)
+// IGt filters the rows whose i is greater than v.
+func (f *T3Filter) IGt(v int) *T3Filter {
+        return f.add("i > $%d", v)
+}
 
-	schema := "public"
-	src, err := PgCreateStruct(conn, schema, "", "mypkg", "", []string{}, []string{}, []string{"smallserial", "serial", "bigserial", "autogenuuid", "integer"}, []string{}, "")
-	if err != nil {
-		t.Fatal(err)
-	}
+// StrEq filters the rows whose str equals to v.
+func (f *T3Filter) StrEq(v string) *T3Filter {
+        return f.add("str = $%d", v)
+}
 
-	expected := `// Code generated by dgw. DO NOT EDIT.
+// StrIn filters the rows whose str is one of vs.
+func (f *T3Filter) StrIn(vs ...string) *T3Filter {
+        return f.add("str = ANY($%d)", pq.Array(vs))
+}
 
-package mypkg
+// StrLt filters the rows whose str is less than v.
+func (f *T3Filter) StrLt(v string) *T3Filter {
+        return f.add("str < $%d", v)
+}
 
-// T1 represents public.t1
-type T1 struct {
-	ID          int64          // id
-	I           int            // i
-	Str         string         // str
-	NullableStr sql.NullString // nullable_str
-	TWithTz     time.Time      // t_with_tz
-	TWithoutTz  time.Time      // t_without_tz
-	Tm          *time.Time     // tm
+// StrGt filters the rows whose str is greater than v.
+func (f *T3Filter) StrGt(v string) *T3Filter {
+        return f.add("str > $%d", v)
 }
-// Create inserts the T1 to the database.
-//
-// Deprecated: Use CreateContext instead.
-func (r *T1) Create(db Queryer) error {
-	return r.CreateContext(context.Background(), db)
+
+// TWithTzEq filters the rows whose t_with_tz equals to v.
+func (f *T3Filter) TWithTzEq(v time.Time) *T3Filter {
+        return f.add("t_with_tz = $%d", v)
 }
 
-// GetT1ByPk select the T1 from the database.
-//
-// Deprecated: Use GetT1ByPkContext instead.
-func GetT1ByPk(db Queryer, pk0 int64) (*T1, error) {
-	return GetT1ByPkContext(context.Background(), db, pk0)
+// TWithTzIn filters the rows whose t_with_tz is one of vs.
+func (f *T3Filter) TWithTzIn(vs ...time.Time) *T3Filter {
+        return f.add("t_with_tz = ANY($%d)", pq.Array(vs))
 }
 
-// CreateContext inserts the T1 to the database.
-func (r *T1) CreateContext(ctx context.Context, db Queryer) error {
-	err := db.QueryRowContext(ctx,
-		` + "`INSERT INTO t1 (i, str, nullable_str, t_with_tz, t_without_tz, tm) VALUES ($1, $2, $3, $4, $5, $6) RETURNING id`" + `,
-		&r.I, &r.Str, &r.NullableStr, &r.TWithTz, &r.TWithoutTz, &r.Tm).Scan(&r.ID)
-	if err != nil {
-		return errors.WithStack(err)
-	}
-	return nil
+// TWithTzLt filters the rows whose t_with_tz is less than v.
+func (f *T3Filter) TWithTzLt(v time.Time) *T3Filter {
+        return f.add("t_with_tz < $%d", v)
 }
 
-// CreateOnConflictDoNothing inserts the T1 to the database.
-// If a conflict occurs (e.g., unique constraint violation), the insert is skipped without error.
-// Returns true if the row was inserted, false if it was skipped due to conflict.
-func (r *T1) CreateOnConflictDoNothing(ctx context.Context, db Queryer) (bool, error) {
-	err := db.QueryRowContext(ctx,
-		` + "`INSERT INTO t1 (i, str, nullable_str, t_with_tz, t_without_tz, tm) VALUES ($1, $2, $3, $4, $5, $6) ON CONFLICT DO NOTHING RETURNING id`" + `,
-		&r.I, &r.Str, &r.NullableStr, &r.TWithTz, &r.TWithoutTz, &r.Tm).Scan(&r.ID)
-	if err != nil {
-		if err == sql.ErrNoRows {
-			return false, nil
-		}
-		return false, errors.WithStack(err)
-	}
-	// Row was successfully inserted
-	return true, nil
+// TWithTzGt filters the rows whose t_with_tz is greater than v.
+func (f *T3Filter) TWithTzGt(v time.Time) *T3Filter {
+        return f.add("t_with_tz > $%d", v)
 }
 
-// GetT1ByPkContext select the T1 from the database.
-func GetT1ByPkContext(ctx context.Context, db Queryer, pk0 int64) (*T1, error) {
-	var r T1
-	err := db.QueryRowContext(ctx,
-		` + "`SELECT id, i, str, nullable_str, t_with_tz, t_without_tz, tm FROM t1 WHERE id = $1`" + `,
-		pk0).Scan(&r.ID, &r.I, &r.Str, &r.NullableStr, &r.TWithTz, &r.TWithoutTz, &r.Tm)
-	if err != nil {
-		return nil, errors.WithStack(err)
-	}
-	return &r, nil
+// TWithoutTzEq filters the rows whose t_without_tz equals to v.
+func (f *T3Filter) TWithoutTzEq(v time.Time) *T3Filter {
+        return f.add("t_without_tz = $%d", v)
 }
-// T2 represents public.t2
-type T2 struct {
-	ID         int64     // id
-	I          int       // i
-	Str        string    // str
-	TWithTz    time.Time // t_with_tz
-	TWithoutTz time.Time // t_without_tz
-}
-// Create inserts the T2 to the database.
-//
-// Deprecated: Use CreateContext instead.
-func (r *T2) Create(db Queryer) error {
-	return r.CreateContext(context.Background(), db)
-}
-
-// GetT2ByPk select the T2 from the database.
-//
-// Deprecated: Use GetT2ByPkContext instead.
-func GetT2ByPk(db Queryer, pk0 int64) (*T2, error) {
-	return GetT2ByPkContext(context.Background(), db, pk0)
-}
-
-// CreateContext inserts the T2 to the database.
-func (r *T2) CreateContext(ctx context.Context, db Queryer) error {
-	err := db.QueryRowContext(ctx,
-		` + "`INSERT INTO t2 (i, str, t_with_tz, t_without_tz) VALUES ($1, $2, $3, $4) RETURNING id`" + `,
-		&r.I, &r.Str, &r.TWithTz, &r.TWithoutTz).Scan(&r.ID)
-	if err != nil {
-		return errors.WithStack(err)
-	}
-	return nil
-}
-
-// CreateOnConflictDoNothing inserts the T2 to the database.
-// If a conflict occurs (e.g., unique constraint violation), the insert is skipped without error.
-// Returns true if the row was inserted, false if it was skipped due to conflict.
-func (r *T2) CreateOnConflictDoNothing(ctx context.Context, db Queryer) (bool, error) {
-	err := db.QueryRowContext(ctx,
-		` + "`INSERT INTO t2 (i, str, t_with_tz, t_without_tz) VALUES ($1, $2, $3, $4) ON CONFLICT DO NOTHING RETURNING id`" + `,
-		&r.I, &r.Str, &r.TWithTz, &r.TWithoutTz).Scan(&r.ID)
-	if err != nil {
-		if err == sql.ErrNoRows {
-			return false, nil
-		}
-		return false, errors.WithStack(err)
-	}
-	// Row was successfully inserted
-	return true, nil
+
+// TWithoutTzIn filters the rows whose t_without_tz is one of vs.
+func (f *T3Filter) TWithoutTzIn(vs ...time.Time) *T3Filter {
+        return f.add("t_without_tz = ANY($%d)", pq.Array(vs))
 }
 
-// GetT2ByPkContext select the T2 from the database.
-func GetT2ByPkContext(ctx context.Context, db Queryer, pk0 int64) (*T2, error) {
-	var r T2
-	err := db.QueryRowContext(ctx,
-		` + "`SELECT id, i, str, t_with_tz, t_without_tz FROM t2 WHERE id = $1`" + `,
-		pk0).Scan(&r.ID, &r.I, &r.Str, &r.TWithTz, &r.TWithoutTz)
-	if err != nil {
-		return nil, errors.WithStack(err)
-	}
-	return &r, nil
-}
-// T3 represents public.t3
-type T3 struct {
-	ID         int64     // id
-	I          int       // i
-	Str        string    // str
-	TWithTz    time.Time // t_with_tz
-	TWithoutTz time.Time // t_without_tz
-}
-// Create inserts the T3 to the database.
-//
-// Deprecated: Use CreateContext instead.
-func (r *T3) Create(db Queryer) error {
-	return r.CreateContext(context.Background(), db)
+// TWithoutTzLt filters the rows whose t_without_tz is less than v.
+func (f *T3Filter) TWithoutTzLt(v time.Time) *T3Filter {
+        return f.add("t_without_tz < $%d", v)
 }
 
-// GetT3ByPk select the T3 from the database.
-//
-// Deprecated: Use GetT3ByPkContext instead.
-func GetT3ByPk(db Queryer, pk0 int64, pk1 int) (*T3, error) {
-	return GetT3ByPkContext(context.Background(), db, pk0, pk1)
+// TWithoutTzGt filters the rows whose t_without_tz is greater than v.
+func (f *T3Filter) TWithoutTzGt(v time.Time) *T3Filter {
+        return f.add("t_without_tz > $%d", v)
 }
 
-// CreateContext inserts the T3 to the database.
-func (r *T3) CreateContext(ctx context.Context, db Queryer) error {
-	err := db.QueryRowContext(ctx,
-		` + "`INSERT INTO t3 (str, t_with_tz, t_without_tz) VALUES ($1, $2, $3) RETURNING id, i`" + `,
-		&r.Str, &r.TWithTz, &r.TWithoutTz).Scan(&r.ID, &r.I)
-	if err != nil {
-		return errors.WithStack(err)
-	}
-	return nil
+// FindT3 selects the T3s matching the filter from the database.
+// The result is ordered by the primary key.
+func FindT3(ctx context.Context, db Queryer, f *T3Filter) ([]*T3, error) {
+        where, args := f.Where()
+        rows, err := db.QueryContext(ctx,
+                ` + "`SELECT id, i, str, t_with_tz, t_without_tz FROM t3`" + `+where+` + "` ORDER BY id, i`" + `,
+                args...)
+        if err != nil {
+                return nil, errors.WithStack(translateError("t3", err))
+        }
+        defer rows.Close()
+        var rs []*T3
+        for rows.Next() {
+                var r T3
+                if err := rows.Scan(&r.ID, &r.I, &r.Str, &r.TWithTz, &r.TWithoutTz); err != nil {
+                        return nil, errors.WithStack(translateError("t3", err))
+                }
+                rs = append(rs, &r)
+        }
+        if err := rows.Err(); err != nil {
+                return nil, errors.WithStack(translateError("t3", err))
+        }
+        return rs, nil
 }
 
-// CreateOnConflictDoNothing inserts the T3 to the database.
-// If a conflict occurs (e.g., unique constraint violation), the insert is skipped without error.
-// Returns true if the row was inserted, false if it was skipped due to conflict.
-func (r *T3) CreateOnConflictDoNothing(ctx context.Context, db Queryer) (bool, error) {
-	err := db.QueryRowContext(ctx,
-		` + "`INSERT INTO t3 (str, t_with_tz, t_without_tz) VALUES ($1, $2, $3) ON CONFLICT DO NOTHING RETURNING id, i`" + `,
-		&r.Str, &r.TWithTz, &r.TWithoutTz).Scan(&r.ID, &r.I)
-	if err != nil {
-		if err == sql.ErrNoRows {
-			return false, nil
-		}
-		return false, errors.WithStack(err)
-	}
-	// Row was successfully inserted
-	return true, nil
+// CountT3 counts the T3s matching the filter in the database.
+// Pass nil as the filter to count every row.
+func CountT3(ctx context.Context, db Queryer, f *T3Filter) (int64, error) {
+        where, args := f.Where()
+        var n int64
+        err := db.QueryRowContext(ctx,
+                ` + "`SELECT count(*) FROM t3`" + `+where,
+                args...).Scan(&n)
+        if err != nil {
+                return 0, errors.WithStack(translateError("t3", err))
+        }
+        return n, nil
 }
 
-// GetT3ByPkContext select the T3 from the database.
-func GetT3ByPkContext(ctx context.Context, db Queryer, pk0 int64, pk1 int) (*T3, error) {
-	var r T3
-	err := db.QueryRowContext(ctx,
-		` + "`SELECT id, i, str, t_with_tz, t_without_tz FROM t3 WHERE id = $1 AND i = $2`" + `,
-		pk0, pk1).Scan(&r.ID, &r.I, &r.Str, &r.TWithTz, &r.TWithoutTz)
-	if err != nil {
-		return nil, errors.WithStack(err)
-	}
-	return &r, nil
-}
-// T4 represents public.t4
-type T4 struct {
-	ID int // id
-	I  int // i
+// DeleteT3Where deletes the T3s matching the filter from the database,
+// and returns the number of the deleted rows. A filter without predicate is rejected
+// to avoid deleting every row by mistake.
+func DeleteT3Where(ctx context.Context, db Queryer, f *T3Filter) (int64, error) {
+        where, args := f.Where()
+        if where == "" {
+                return 0, errors.New("refusing to delete every row of t3 with an empty filter")
+        }
+        result, err := db.ExecContext(ctx,
+                ` + "`DELETE FROM t3`" + `+where,
+                args...)
+        if err != nil {
+                return 0, errors.WithStack(translateError("t3", err))
+        }
+        n, err := result.RowsAffected()
+        if err != nil {
+                return 0, errors.WithStack(translateError("t3", err))
+        }
+        return n, nil
 }
-// Create inserts the T4 to the database.
-//
+`,
+		},
+		{
+			table: tbls[3],
+			expect: `// Create inserts the T4 to the database.
+// %EMPTY_COMMENT%
 // Deprecated: Use CreateContext instead.
 func (r *T4) Create(db Queryer) error {
-	return r.CreateContext(context.Background(), db)
+        return r.CreateContext(context.Background(), db)
 }
 
 // GetT4ByPk select the T4 from the database.
 //
 // Deprecated: Use GetT4ByPkContext instead.
 func GetT4ByPk(db Queryer, pk0 int, pk1 int) (*T4, error) {
-	return GetT4ByPkContext(context.Background(), db, pk0, pk1)
+        return GetT4ByPkContext(context.Background(), db, pk0, pk1)
+}
+
+// Validate checks the T4 against the constraints of t4 which can be checked
+// without the database, and returns *ValidationError listing every failing field.
+func (r *T4) Validate() error {
+        return nil
 }
 
 // CreateContext inserts the T4 to the database.
 func (r *T4) CreateContext(ctx context.Context, db Queryer) error {
-	err := db.QueryRowContext(ctx,
-		` + "`INSERT INTO t4 () VALUES () RETURNING id, i`" + `,
-	).Scan(&r.ID, &r.I)
-	if err != nil {
-		return errors.WithStack(err)
-	}
-	return nil
+        if err := beforeCreate(ctx, r); err != nil {
+                return err
+        }
+        _, err := db.ExecContext(ctx,
+                ` + "`INSERT INTO t4 (id, i) VALUES ($1, $2)`" + `,
+                &r.ID, &r.I)
+        if err != nil {
+                return errors.WithStack(translateError("t4", err))
+        }
+        return afterCreate(ctx, r)
 }
 
 // CreateOnConflictDoNothing inserts the T4 to the database.
 // If a conflict occurs (e.g., unique constraint violation), the insert is skipped without error.
 // Returns true if the row was inserted, false if it was skipped due to conflict.
 func (r *T4) CreateOnConflictDoNothing(ctx context.Context, db Queryer) (bool, error) {
-	err := db.QueryRowContext(ctx,
-		` + "`INSERT INTO t4 () VALUES () ON CONFLICT DO NOTHING RETURNING id, i`" + `,
-	).Scan(&r.ID, &r.I)
-	if err != nil {
-		if err == sql.ErrNoRows {
-			return false, nil
-		}
-		return false, errors.WithStack(err)
-	}
-	// Row was successfully inserted
-	return true, nil
+        if err := beforeCreate(ctx, r); err != nil {
+                return false, err
+        }
+        result, err := db.ExecContext(ctx,
+                ` + "`INSERT INTO t4 (id, i) VALUES ($1, $2) ON CONFLICT DO NOTHING`" + `,
+                &r.ID, &r.I)
+        if err != nil {
+                return false, errors.WithStack(translateError("t4", err))
+        }
+        rowsAffected, err := result.RowsAffected()
+        if err != nil {
+                return false, errors.WithStack(translateError("t4", err))
+        }
+        if rowsAffected == 0 {
+                return false, nil
+        }
+        return true, afterCreate(ctx, r)
+}
+
+// DeleteContext deletes the T4 from the database by the primary key.
+// *NotFoundError is returned if the row is not found.
+func (r *T4) DeleteContext(ctx context.Context, db Queryer) error {
+        if err := beforeDelete(ctx, r); err != nil {
+                return err
+        }
+        result, err := db.ExecContext(ctx,
+                ` + "`DELETE FROM t4 WHERE id = $1 AND i = $2`" + `,
+                &r.ID, &r.I)
+        if err != nil {
+                return errors.WithStack(translateError("t4", err))
+        }
+        n, err := result.RowsAffected()
+        if err != nil {
+                return errors.WithStack(translateError("t4", err))
+        }
+        if n == 0 {
+                err = sql.ErrNoRows
+                return errors.WithStack(translateError("t4", err))
+        }
+        return afterDelete(ctx, r)
+}
+
+// ReloadContext selects the T4 again by its primary key, and overwrites the fields with the
+// values in the database, e.g. after a trigger or a stored procedure changed the row.
+// *NotFoundError is returned if the row is not found.
+func (r *T4) ReloadContext(ctx context.Context, db Queryer) error {
+        err := db.QueryRowContext(ctx,
+                ` + "`SELECT id, i FROM t4 WHERE id = $1 AND i = $2`" + `,
+                r.ID, r.I).Scan(&r.ID, &r.I)
+        if err != nil {
+                return errors.WithStack(translateError("t4", err))
+        }
+        return nil
 }
 
 // GetT4ByPkContext select the T4 from the database.
 func GetT4ByPkContext(ctx context.Context, db Queryer, pk0 int, pk1 int) (*T4, error) {
-	var r T4
-	err := db.QueryRowContext(ctx,
-		` + "`SELECT id, i FROM t4 WHERE id = $1 AND i = $2`" + `,
-		pk0, pk1).Scan(&r.ID, &r.I)
-	if err != nil {
-		return nil, errors.WithStack(err)
-	}
-	return &r, nil
+        var r T4
+        err := db.QueryRowContext(ctx,
+                ` + "`SELECT id, i FROM t4 WHERE id = $1 AND i = $2`" + `,
+                pk0, pk1).Scan(&r.ID, &r.I)
+        if err != nil {
+                return nil, errors.WithStack(translateError("t4", err))
+        }
+        return &r, nil
 }
-// T5 represents public.t5
-type T5 struct {
-	ID int // id
-	I  int // i
+
+// GetT4ByPkForUpdateContext selects the T4 from the database with FOR UPDATE,
+// which locks the row until the end of the transaction. opt decides what happens if the row is locked
+// by another transaction: LockWait waits for it, LockNoWait fails with SQLSTATE 55P03, and
+// LockSkipLocked returns *NotFoundError.
+func GetT4ByPkForUpdateContext(ctx context.Context, db Queryer, pk0 int, pk1 int, opt LockOption) (*T4, error) {
+        var r T4
+        err := db.QueryRowContext(ctx,
+                ` + "`SELECT id, i FROM t4 WHERE id = $1 AND i = $2 FOR UPDATE`" + `+opt.sql(),
+                pk0, pk1).Scan(&r.ID, &r.I)
+        if err != nil {
+                return nil, errors.WithStack(translateError("t4", err))
+        }
+        return &r, nil
 }
-// Create inserts the T5 to the database.
-//
-// Deprecated: Use CreateContext instead.
-func (r *T5) Create(db Queryer) error {
-	return r.CreateContext(context.Background(), db)
+
+// GetT4ByPkForShareContext selects the T4 from the database with FOR SHARE,
+// which locks the row until the end of the transaction. opt decides what happens if the row is locked
+// by another transaction: LockWait waits for it, LockNoWait fails with SQLSTATE 55P03, and
+// LockSkipLocked returns *NotFoundError.
+func GetT4ByPkForShareContext(ctx context.Context, db Queryer, pk0 int, pk1 int, opt LockOption) (*T4, error) {
+        var r T4
+        err := db.QueryRowContext(ctx,
+                ` + "`SELECT id, i FROM t4 WHERE id = $1 AND i = $2 FOR SHARE`" + `+opt.sql(),
+                pk0, pk1).Scan(&r.ID, &r.I)
+        if err != nil {
+                return nil, errors.WithStack(translateError("t4", err))
+        }
+        return &r, nil
 }
 
-// GetT5ByPk select the T5 from the database.
-//
-// Deprecated: Use GetT5ByPkContext instead.
-func GetT5ByPk(db Queryer, pk0 int, pk1 int) (*T5, error) {
-	return GetT5ByPkContext(context.Background(), db, pk0, pk1)
+// ExistsT4ByPkContext checks if the T4 exists in the database.
+func ExistsT4ByPkContext(ctx context.Context, db Queryer, pk0 int, pk1 int) (bool, error) {
+        var exists bool
+        err := db.QueryRowContext(ctx,
+                ` + "`SELECT EXISTS (SELECT 1 FROM t4 WHERE id = $1 AND i = $2)`" + `,
+                pk0, pk1).Scan(&exists)
+        if err != nil {
+                return false, errors.WithStack(translateError("t4", err))
+        }
+        return exists, nil
 }
 
-// CreateContext inserts the T5 to the database.
-func (r *T5) CreateContext(ctx context.Context, db Queryer) error {
-	err := db.QueryRowContext(ctx,
-		` + "`INSERT INTO t5 () VALUES () RETURNING id, i`" + `,
-	).Scan(&r.ID, &r.I)
-	if err != nil {
-		return errors.WithStack(err)
-	}
-	return nil
+// GetT4ByPksContext select the T4s of the given primary keys from the database.
+// The result is ordered by the primary key, and the keys which do not exist are skipped.
+func GetT4ByPksContext(ctx context.Context, db Queryer, pks []T4Pk) ([]*T4, error) {
+        if len(pks) == 0 {
+                return nil, nil
+        }
+        values := make([]string, 0, len(pks))
+        args := make([]interface{}, 0, len(pks)*2)
+        for _, pk := range pks {
+                args = append(args, pk.ID, pk.I)
+                values = append(values, fmt.Sprintf("($%d::integer, $%d::integer)", len(args)-1, len(args)))
+        }
+        rows, err := db.QueryContext(ctx,
+                fmt.Sprintf(` + "`SELECT id, i FROM t4 WHERE (id, i) IN (VALUES %s) ORDER BY id, i`" + `, strings.Join(values, ", ")),
+                args...)
+        if err != nil {
+                return nil, errors.WithStack(translateError("t4", err))
+        }
+        defer rows.Close()
+        var rs []*T4
+        for rows.Next() {
+                var r T4
+                if err := rows.Scan(&r.ID, &r.I); err != nil {
+                        return nil, errors.WithStack(translateError("t4", err))
+                }
+                rs = append(rs, &r)
+        }
+        if err := rows.Err(); err != nil {
+                return nil, errors.WithStack(translateError("t4", err))
+        }
+        return rs, nil
+}
+
+// T4ListOptions is the options of ListT4.
+type T4ListOptions struct {
+        // Limit is the maximum number of rows to return. Zero means no limit.
+        Limit int
+        // After is the primary key of the last row of the previous page.
+        // Only the rows after it are returned when it is not nil.
+        After *T4Pk
+}
+
+// ListT4 lists the T4s ordered by the primary key.
+// Pass the primary key of the last row as opts.After to get the next page.
+func ListT4(ctx context.Context, db Queryer, opts T4ListOptions) ([]*T4, error) {
+        q := ` + "`SELECT id, i FROM t4`" + `
+        var args []interface{}
+        if opts.After != nil {
+                q += ` + "` WHERE (id, i) > ($1, $2)`" + `
+                args = append(args, opts.After.ID, opts.After.I)
+        }
+        q += ` + "` ORDER BY id, i`" + `
+        if opts.Limit > 0 {
+                args = append(args, opts.Limit)
+                q += fmt.Sprintf(` + "` LIMIT $%d`" + `, len(args))
+        }
+        rows, err := db.QueryContext(ctx, q, args...)
+        if err != nil {
+                return nil, errors.WithStack(translateError("t4", err))
+        }
+        defer rows.Close()
+        var rs []*T4
+        for rows.Next() {
+                var r T4
+                if err := rows.Scan(&r.ID, &r.I); err != nil {
+                        return nil, errors.WithStack(translateError("t4", err))
+                }
+                rs = append(rs, &r)
+        }
+        if err := rows.Err(); err != nil {
+                return nil, errors.WithStack(translateError("t4", err))
+        }
+        return rs, nil
 }
 
-// CreateOnConflictDoNothing inserts the T5 to the database.
-// If a conflict occurs (e.g., unique constraint violation), the insert is skipped without error.
-// Returns true if the row was inserted, false if it was skipped due to conflict.
-func (r *T5) CreateOnConflictDoNothing(ctx context.Context, db Queryer) (bool, error) {
-	err := db.QueryRowContext(ctx,
-		` + "`INSERT INTO t5 () VALUES () ON CONFLICT DO NOTHING RETURNING id, i`" + `,
-	).Scan(&r.ID, &r.I)
-	if err != nil {
-		if err == sql.ErrNoRows {
-			return false, nil
-		}
-		return false, errors.WithStack(err)
-	}
-	// Row was successfully inserted
-	return true, nil
+// T4Filter builds a parameterized WHERE clause of t4.
+// The predicates are combined with AND.
+type T4Filter struct {
+        conds []string
+        args  []interface{}
 }
 
-// GetT5ByPkContext select the T5 from the database.
-func GetT5ByPkContext(ctx context.Context, db Queryer, pk0 int, pk1 int) (*T5, error) {
-	var r T5
-	err := db.QueryRowContext(ctx,
-		` + "`SELECT id, i FROM t5 WHERE id = $1 AND i = $2`" + `,
-		pk0, pk1).Scan(&r.ID, &r.I)
-	if err != nil {
-		return nil, errors.WithStack(err)
-	}
-	return &r, nil
+// NewT4Filter creates an empty T4Filter, which matches every row.
+func NewT4Filter() *T4Filter {
+        return &T4Filter{}
 }
-// T6 represents public.t6
-type T6 struct {
-	ID int // id
-	I  int // i
+
+func (f *T4Filter) add(cond string, v interface{}) *T4Filter {
+        f.args = append(f.args, v)
+        f.conds = append(f.conds, fmt.Sprintf(cond, len(f.args)))
+        return f
 }
-// Create inserts the T6 to the database.
-//
-// Deprecated: Use CreateContext instead.
-func (r *T6) Create(db Queryer) error {
-	return r.CreateContext(context.Background(), db)
+
+// Where returns the WHERE clause and its arguments. It returns an empty clause
+// if the filter is nil or has no predicate.
+func (f *T4Filter) Where() (string, []interface{}) {
+        if f == nil || len(f.conds) == 0 {
+                return "", nil
+        }
+        return " WHERE " + strings.Join(f.conds, " AND "), f.args
 }
 
-// GetT6ByPk select the T6 from the database.
-//
-// Deprecated: Use GetT6ByPkContext instead.
-func GetT6ByPk(db Queryer, pk0 int, pk1 int) (*T6, error) {
-	return GetT6ByPkContext(context.Background(), db, pk0, pk1)
+// IDEq filters the rows whose id equals to v.
+func (f *T4Filter) IDEq(v int) *T4Filter {
+        return f.add("id = $%d", v)
 }
 
-// CreateContext inserts the T6 to the database.
-func (r *T6) CreateContext(ctx context.Context, db Queryer) error {
-	err := db.QueryRowContext(ctx,
-		` + "`INSERT INTO t6 () VALUES () RETURNING id, i`" + `,
-	).Scan(&r.ID, &r.I)
-	if err != nil {
-		return errors.WithStack(err)
-	}
-	return nil
+// IDIn filters the rows whose id is one of vs.
+func (f *T4Filter) IDIn(vs ...int) *T4Filter {
+        return f.add("id = ANY($%d)", pq.Array(vs))
 }
 
-// CreateOnConflictDoNothing inserts the T6 to the database.
-// If a conflict occurs (e.g., unique constraint violation), the insert is skipped without error.
-// Returns true if the row was inserted, false if it was skipped due to conflict.
-func (r *T6) CreateOnConflictDoNothing(ctx context.Context, db Queryer) (bool, error) {
-	err := db.QueryRowContext(ctx,
-		` + "`INSERT INTO t6 () VALUES () ON CONFLICT DO NOTHING RETURNING id, i`" + `,
-	).Scan(&r.ID, &r.I)
-	if err != nil {
-		if err == sql.ErrNoRows {
-			return false, nil
-		}
-		return false, errors.WithStack(err)
-	}
-	// Row was successfully inserted
-	return true, nil
+// IDLt filters the rows whose id is less than v.
+func (f *T4Filter) IDLt(v int) *T4Filter {
+        return f.add("id < $%d", v)
 }
 
-// GetT6ByPkContext select the T6 from the database.
-func GetT6ByPkContext(ctx context.Context, db Queryer, pk0 int, pk1 int) (*T6, error) {
-	var r T6
-	err := db.QueryRowContext(ctx,
-		` + "`SELECT id, i FROM t6 WHERE id = $1 AND i = $2`" + `,
-		pk0, pk1).Scan(&r.ID, &r.I)
-	if err != nil {
-		return nil, errors.WithStack(err)
-	}
-	return &r, nil
+// IDGt filters the rows whose id is greater than v.
+func (f *T4Filter) IDGt(v int) *T4Filter {
+        return f.add("id > $%d", v)
 }
-`
 
-	assert.Equal(expected, string(src))
+// IEq filters the rows whose i equals to v.
+func (f *T4Filter) IEq(v int) *T4Filter {
+        return f.add("i = $%d", v)
 }
 
-func TestPgCreateStructWithDeprecated(t *testing.T) {
+// IIn filters the rows whose i is one of vs.
+func (f *T4Filter) IIn(vs ...int) *T4Filter {
+        return f.add("i = ANY($%d)", pq.Array(vs))
+}
+
+// ILt filters the rows whose i is less than v.
+func (f *T4Filter) ILt(v int) *T4Filter {
+        return f.add("i < $%d", v)
+}
+
+// IGt filters the rows whose i is greater than v.
+func (f *T4Filter) IGt(v int) *T4Filter {
+        return f.add("i > $%d", v)
+}
+
+// FindT4 selects the T4s matching the filter from the database.
+// The result is ordered by the primary key.
+func FindT4(ctx context.Context, db Queryer, f *T4Filter) ([]*T4, error) {
+        where, args := f.Where()
+        rows, err := db.QueryContext(ctx,
+                ` + "`SELECT id, i FROM t4`" + `+where+` + "` ORDER BY id, i`" + `,
+                args...)
+        if err != nil {
+                return nil, errors.WithStack(translateError("t4", err))
+        }
+        defer rows.Close()
+        var rs []*T4
+        for rows.Next() {
+                var r T4
+                if err := rows.Scan(&r.ID, &r.I); err != nil {
+                        return nil, errors.WithStack(translateError("t4", err))
+                }
+                rs = append(rs, &r)
+        }
+        if err := rows.Err(); err != nil {
+                return nil, errors.WithStack(translateError("t4", err))
+        }
+        return rs, nil
+}
+
+// CountT4 counts the T4s matching the filter in the database.
+// Pass nil as the filter to count every row.
+func CountT4(ctx context.Context, db Queryer, f *T4Filter) (int64, error) {
+        where, args := f.Where()
+        var n int64
+        err := db.QueryRowContext(ctx,
+                ` + "`SELECT count(*) FROM t4`" + `+where,
+                args...).Scan(&n)
+        if err != nil {
+                return 0, errors.WithStack(translateError("t4", err))
+        }
+        return n, nil
+}
+
+// DeleteT4Where deletes the T4s matching the filter from the database,
+// and returns the number of the deleted rows. A filter without predicate is rejected
+// to avoid deleting every row by mistake.
+func DeleteT4Where(ctx context.Context, db Queryer, f *T4Filter) (int64, error) {
+        where, args := f.Where()
+        if where == "" {
+                return 0, errors.New("refusing to delete every row of t4 with an empty filter")
+        }
+        result, err := db.ExecContext(ctx,
+                ` + "`DELETE FROM t4`" + `+where,
+                args...)
+        if err != nil {
+                return 0, errors.WithStack(translateError("t4", err))
+        }
+        n, err := result.RowsAffected()
+        if err != nil {
+                return 0, errors.WithStack(translateError("t4", err))
+        }
+        return n, nil
+}
+`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.table.Name, func(t *testing.T) {
+			st, err := PgTableToStruct(tt.table, &defaultTypeMapCfg, autoGenKeyCfg, []string{}, "", nil, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			src, err := PgExecuteDefaultMethodTmpl(&StructTmpl{Struct: st})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			re1 := regexp.MustCompile(`\s`)
+
+			srcStr := string(src)
+			if re1.ReplaceAllString(srcStr, "") != re1.ReplaceAllString(tt.expect, "") {
+				t.Errorf("Expected generated code: %s, got: %s", tt.expect, srcStr)
+			}
+		})
+	}
+}
+
+func TestPgCreateStruct(t *testing.T) {
 	conn, cleanup := testPgSetup(t)
 	defer cleanup()
 	assert := assert.New(t)
 
 	schema := "public"
-	deprecated := []string{"t2", "t5"}
-	src, err := PgCreateStruct(conn, schema, "", "mypkg", "", []string{}, []string{}, []string{}, deprecated, "")
+	src, err := PgCreateStruct(conn, schema, "", "mypkg", "", []string{}, []string{}, []string{}, []string{}, "", nil)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -1296,21 +2577,40 @@ func GetT1ByPk(db Queryer, pk0 int64) (*T1, error) {
 	return GetT1ByPkContext(context.Background(), db, pk0)
 }
 
+// Validate checks the T1 against the constraints of t1 which can be checked
+// without the database, and returns *ValidationError listing every failing field.
+func (r *T1) Validate() error {
+	var errs []FieldError
+	if r.Str == "" {
+		errs = append(errs, FieldError{Field: "Str", Column: "str", Message: "must not be empty"})
+	}
+	if len(errs) > 0 {
+		return &ValidationError{Table: "t1", Fields: errs}
+	}
+	return nil
+}
+
 // CreateContext inserts the T1 to the database.
 func (r *T1) CreateContext(ctx context.Context, db Queryer) error {
+	if err := beforeCreate(ctx, r); err != nil {
+		return err
+	}
 	err := db.QueryRowContext(ctx,
 		` + "`INSERT INTO t1 (i, str, nullable_str, t_with_tz, t_without_tz, tm) VALUES ($1, $2, $3, $4, $5, $6) RETURNING id`" + `,
 		&r.I, &r.Str, &r.NullableStr, &r.TWithTz, &r.TWithoutTz, &r.Tm).Scan(&r.ID)
 	if err != nil {
-		return errors.WithStack(err)
+		return errors.WithStack(translateError("t1", err))
 	}
-	return nil
+	return afterCreate(ctx, r)
 }
 
 // CreateOnConflictDoNothing inserts the T1 to the database.
 // If a conflict occurs (e.g., unique constraint violation), the insert is skipped without error.
 // Returns true if the row was inserted, false if it was skipped due to conflict.
 func (r *T1) CreateOnConflictDoNothing(ctx context.Context, db Queryer) (bool, error) {
+	if err := beforeCreate(ctx, r); err != nil {
+		return false, err
+	}
 	err := db.QueryRowContext(ctx,
 		` + "`INSERT INTO t1 (i, str, nullable_str, t_with_tz, t_without_tz, tm) VALUES ($1, $2, $3, $4, $5, $6) ON CONFLICT DO NOTHING RETURNING id`" + `,
 		&r.I, &r.Str, &r.NullableStr, &r.TWithTz, &r.TWithoutTz, &r.Tm).Scan(&r.ID)
@@ -1318,562 +2618,9422 @@ func (r *T1) CreateOnConflictDoNothing(ctx context.Context, db Queryer) (bool, e
 		if err == sql.ErrNoRows {
 			return false, nil
 		}
-		return false, errors.WithStack(err)
+		return false, errors.WithStack(translateError("t1", err))
 	}
 	// Row was successfully inserted
-	return true, nil
+	return true, afterCreate(ctx, r)
 }
 
-// GetT1ByPkContext select the T1 from the database.
-func GetT1ByPkContext(ctx context.Context, db Queryer, pk0 int64) (*T1, error) {
-	var r T1
-	err := db.QueryRowContext(ctx,
-		` + "`SELECT id, i, str, nullable_str, t_with_tz, t_without_tz, tm FROM t1 WHERE id = $1`" + `,
-		pk0).Scan(&r.ID, &r.I, &r.Str, &r.NullableStr, &r.TWithTz, &r.TWithoutTz, &r.Tm)
+// UpdateContext updates the T1 in the database by the primary key.
+// *NotFoundError is returned if the row is not found.
+func (r *T1) UpdateContext(ctx context.Context, db Queryer) error {
+	if err := beforeUpdate(ctx, r); err != nil {
+		return err
+	}
+	result, err := db.ExecContext(ctx,
+		` + "`UPDATE t1 SET i = $1, str = $2, nullable_str = $3, t_with_tz = $4, t_without_tz = $5, tm = $6 WHERE id = $7`" + `,
+		&r.I, &r.Str, &r.NullableStr, &r.TWithTz, &r.TWithoutTz, &r.Tm, &r.ID)
 	if err != nil {
-		return nil, errors.WithStack(err)
+		return errors.WithStack(translateError("t1", err))
 	}
-	return &r, nil
-}
-// T2 represents public.t2
-//
-// Deprecated: T2 is no longer maintained
-type T2 struct {
-	ID         int64     // id
-	I          int       // i
-	Str        string    // str
-	TWithTz    time.Time // t_with_tz
-	TWithoutTz time.Time // t_without_tz
-}
-// Create inserts the T2 to the database.
-//
-// Deprecated: T2 is no longer maintained
-func (r *T2) Create(db Queryer) error {
-	return r.CreateContext(context.Background(), db)
+	n, err := result.RowsAffected()
+	if err != nil {
+		return errors.WithStack(translateError("t1", err))
+	}
+	if n == 0 {
+		err = sql.ErrNoRows
+		return errors.WithStack(translateError("t1", err))
+	}
+	return afterUpdate(ctx, r)
 }
 
-// GetT2ByPk select the T2 from the database.
-//
-// Deprecated: T2 is no longer maintained
-func GetT2ByPk(db Queryer, pk0 int64) (*T2, error) {
-	return GetT2ByPkContext(context.Background(), db, pk0)
+// DeleteContext deletes the T1 from the database by the primary key.
+// *NotFoundError is returned if the row is not found.
+func (r *T1) DeleteContext(ctx context.Context, db Queryer) error {
+	if err := beforeDelete(ctx, r); err != nil {
+		return err
+	}
+	result, err := db.ExecContext(ctx,
+		` + "`DELETE FROM t1 WHERE id = $1`" + `,
+		&r.ID)
+	if err != nil {
+		return errors.WithStack(translateError("t1", err))
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return errors.WithStack(translateError("t1", err))
+	}
+	if n == 0 {
+		err = sql.ErrNoRows
+		return errors.WithStack(translateError("t1", err))
+	}
+	return afterDelete(ctx, r)
 }
 
-// CreateContext inserts the T2 to the database.
-//
-// Deprecated: T2 is no longer maintained
-func (r *T2) CreateContext(ctx context.Context, db Queryer) error {
+// ReloadContext selects the T1 again by its primary key, and overwrites the fields with the
+// values in the database, e.g. after a trigger or a stored procedure changed the row.
+// *NotFoundError is returned if the row is not found.
+func (r *T1) ReloadContext(ctx context.Context, db Queryer) error {
 	err := db.QueryRowContext(ctx,
-		` + "`INSERT INTO t2 (i, str, t_with_tz, t_without_tz) VALUES ($1, $2, $3, $4) RETURNING id`" + `,
-		&r.I, &r.Str, &r.TWithTz, &r.TWithoutTz).Scan(&r.ID)
+		` + "`SELECT id, i, str, nullable_str, t_with_tz, t_without_tz, tm FROM t1 WHERE id = $1`" + `,
+		r.ID).Scan(&r.ID, &r.I, &r.Str, &r.NullableStr, &r.TWithTz, &r.TWithoutTz, &r.Tm)
 	if err != nil {
-		return errors.WithStack(err)
+		return errors.WithStack(translateError("t1", err))
 	}
 	return nil
 }
 
-// CreateOnConflictDoNothing inserts the T2 to the database.
-// If a conflict occurs (e.g., unique constraint violation), the insert is skipped without error.
-// Returns true if the row was inserted, false if it was skipped due to conflict.
-//
-// Deprecated: T2 is no longer maintained
-func (r *T2) CreateOnConflictDoNothing(ctx context.Context, db Queryer) (bool, error) {
+// GetT1ByPkContext select the T1 from the database.
+func GetT1ByPkContext(ctx context.Context, db Queryer, pk0 int64) (*T1, error) {
+	var r T1
 	err := db.QueryRowContext(ctx,
-		` + "`INSERT INTO t2 (i, str, t_with_tz, t_without_tz) VALUES ($1, $2, $3, $4) ON CONFLICT DO NOTHING RETURNING id`" + `,
-		&r.I, &r.Str, &r.TWithTz, &r.TWithoutTz).Scan(&r.ID)
+		` + "`SELECT id, i, str, nullable_str, t_with_tz, t_without_tz, tm FROM t1 WHERE id = $1`" + `,
+		pk0).Scan(&r.ID, &r.I, &r.Str, &r.NullableStr, &r.TWithTz, &r.TWithoutTz, &r.Tm)
 	if err != nil {
-		if err == sql.ErrNoRows {
-			return false, nil
-		}
-		return false, errors.WithStack(err)
+		return nil, errors.WithStack(translateError("t1", err))
 	}
-	// Row was successfully inserted
-	return true, nil
+	return &r, nil
 }
 
-// GetT2ByPkContext select the T2 from the database.
-//
-// Deprecated: T2 is no longer maintained
-func GetT2ByPkContext(ctx context.Context, db Queryer, pk0 int64) (*T2, error) {
-	var r T2
+// GetT1ByPkForUpdateContext selects the T1 from the database with FOR UPDATE,
+// which locks the row until the end of the transaction. opt decides what happens if the row is locked
+// by another transaction: LockWait waits for it, LockNoWait fails with SQLSTATE 55P03, and
+// LockSkipLocked returns *NotFoundError.
+func GetT1ByPkForUpdateContext(ctx context.Context, db Queryer, pk0 int64, opt LockOption) (*T1, error) {
+	var r T1
 	err := db.QueryRowContext(ctx,
-		` + "`SELECT id, i, str, t_with_tz, t_without_tz FROM t2 WHERE id = $1`" + `,
-		pk0).Scan(&r.ID, &r.I, &r.Str, &r.TWithTz, &r.TWithoutTz)
+		` + "`SELECT id, i, str, nullable_str, t_with_tz, t_without_tz, tm FROM t1 WHERE id = $1 FOR UPDATE`" + `+opt.sql(),
+		pk0).Scan(&r.ID, &r.I, &r.Str, &r.NullableStr, &r.TWithTz, &r.TWithoutTz, &r.Tm)
 	if err != nil {
-		return nil, errors.WithStack(err)
+		return nil, errors.WithStack(translateError("t1", err))
 	}
 	return &r, nil
 }
-// T3 represents public.t3
-type T3 struct {
-	ID         int64     // id
-	I          int       // i
-	Str        string    // str
-	TWithTz    time.Time // t_with_tz
-	TWithoutTz time.Time // t_without_tz
-}
-// Create inserts the T3 to the database.
-//
-// Deprecated: Use CreateContext instead.
-func (r *T3) Create(db Queryer) error {
-	return r.CreateContext(context.Background(), db)
-}
-
-// GetT3ByPk select the T3 from the database.
-//
-// Deprecated: Use GetT3ByPkContext instead.
-func GetT3ByPk(db Queryer, pk0 int64, pk1 int) (*T3, error) {
-	return GetT3ByPkContext(context.Background(), db, pk0, pk1)
-}
 
-// CreateContext inserts the T3 to the database.
-func (r *T3) CreateContext(ctx context.Context, db Queryer) error {
+// GetT1ByPkForShareContext selects the T1 from the database with FOR SHARE,
+// which locks the row until the end of the transaction. opt decides what happens if the row is locked
+// by another transaction: LockWait waits for it, LockNoWait fails with SQLSTATE 55P03, and
+// LockSkipLocked returns *NotFoundError.
+func GetT1ByPkForShareContext(ctx context.Context, db Queryer, pk0 int64, opt LockOption) (*T1, error) {
+	var r T1
 	err := db.QueryRowContext(ctx,
-		` + "`INSERT INTO t3 (str, t_with_tz, t_without_tz) VALUES ($1, $2, $3) RETURNING id, i`" + `,
-		&r.Str, &r.TWithTz, &r.TWithoutTz).Scan(&r.ID, &r.I)
+		` + "`SELECT id, i, str, nullable_str, t_with_tz, t_without_tz, tm FROM t1 WHERE id = $1 FOR SHARE`" + `+opt.sql(),
+		pk0).Scan(&r.ID, &r.I, &r.Str, &r.NullableStr, &r.TWithTz, &r.TWithoutTz, &r.Tm)
 	if err != nil {
-		return errors.WithStack(err)
+		return nil, errors.WithStack(translateError("t1", err))
 	}
-	return nil
+	return &r, nil
 }
 
-// CreateOnConflictDoNothing inserts the T3 to the database.
-// If a conflict occurs (e.g., unique constraint violation), the insert is skipped without error.
-// Returns true if the row was inserted, false if it was skipped due to conflict.
-func (r *T3) CreateOnConflictDoNothing(ctx context.Context, db Queryer) (bool, error) {
+// ExistsT1ByPkContext checks if the T1 exists in the database.
+func ExistsT1ByPkContext(ctx context.Context, db Queryer, pk0 int64) (bool, error) {
+	var exists bool
 	err := db.QueryRowContext(ctx,
-		` + "`INSERT INTO t3 (str, t_with_tz, t_without_tz) VALUES ($1, $2, $3) ON CONFLICT DO NOTHING RETURNING id, i`" + `,
-		&r.Str, &r.TWithTz, &r.TWithoutTz).Scan(&r.ID, &r.I)
+		` + "`SELECT EXISTS (SELECT 1 FROM t1 WHERE id = $1)`" + `,
+		pk0).Scan(&exists)
 	if err != nil {
-		if err == sql.ErrNoRows {
-			return false, nil
-		}
-		return false, errors.WithStack(err)
+		return false, errors.WithStack(translateError("t1", err))
 	}
-	// Row was successfully inserted
-	return true, nil
+	return exists, nil
 }
 
-// GetT3ByPkContext select the T3 from the database.
-func GetT3ByPkContext(ctx context.Context, db Queryer, pk0 int64, pk1 int) (*T3, error) {
-	var r T3
-	err := db.QueryRowContext(ctx,
-		` + "`SELECT id, i, str, t_with_tz, t_without_tz FROM t3 WHERE id = $1 AND i = $2`" + `,
-		pk0, pk1).Scan(&r.ID, &r.I, &r.Str, &r.TWithTz, &r.TWithoutTz)
+// GetT1ByPksContext select the T1s of the given primary keys from the database.
+// The result is ordered by the primary key, and the keys which do not exist are skipped.
+func GetT1ByPksContext(ctx context.Context, db Queryer, pks []int64) ([]*T1, error) {
+	rows, err := db.QueryContext(ctx,
+		` + "`SELECT id, i, str, nullable_str, t_with_tz, t_without_tz, tm FROM t1 WHERE id = ANY($1) ORDER BY id`" + `,
+		pq.Array(pks))
 	if err != nil {
-		return nil, errors.WithStack(err)
+		return nil, errors.WithStack(translateError("t1", err))
 	}
-	return &r, nil
+	defer rows.Close()
+	var rs []*T1
+	for rows.Next() {
+		var r T1
+		if err := rows.Scan(&r.ID, &r.I, &r.Str, &r.NullableStr, &r.TWithTz, &r.TWithoutTz, &r.Tm); err != nil {
+			return nil, errors.WithStack(translateError("t1", err))
+		}
+		rs = append(rs, &r)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.WithStack(translateError("t1", err))
+	}
+	return rs, nil
+}
+
+// T1ListOptions is the options of ListT1.
+type T1ListOptions struct {
+	// Limit is the maximum number of rows to return. Zero means no limit.
+	Limit int
+	// After is the primary key of the last row of the previous page.
+	// Only the rows after it are returned when it is not nil.
+	After *int64
+}
+
+// ListT1 lists the T1s ordered by the primary key.
+// Pass the primary key of the last row as opts.After to get the next page.
+func ListT1(ctx context.Context, db Queryer, opts T1ListOptions) ([]*T1, error) {
+	q := ` + "`SELECT id, i, str, nullable_str, t_with_tz, t_without_tz, tm FROM t1`" + `
+	var args []interface{}
+	if opts.After != nil {
+		q += ` + "` WHERE id > $1`" + `
+		args = append(args, *opts.After)
+	}
+	q += ` + "` ORDER BY id`" + `
+	if opts.Limit > 0 {
+		args = append(args, opts.Limit)
+		q += fmt.Sprintf(` + "` LIMIT $%d`" + `, len(args))
+	}
+	rows, err := db.QueryContext(ctx, q, args...)
+	if err != nil {
+		return nil, errors.WithStack(translateError("t1", err))
+	}
+	defer rows.Close()
+	var rs []*T1
+	for rows.Next() {
+		var r T1
+		if err := rows.Scan(&r.ID, &r.I, &r.Str, &r.NullableStr, &r.TWithTz, &r.TWithoutTz, &r.Tm); err != nil {
+			return nil, errors.WithStack(translateError("t1", err))
+		}
+		rs = append(rs, &r)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.WithStack(translateError("t1", err))
+	}
+	return rs, nil
 }
-// T4 represents public.t4
-type T4 struct {
-	ID int // id
-	I  int // i
+
+// T1Filter builds a parameterized WHERE clause of t1.
+// The predicates are combined with AND.
+type T1Filter struct {
+	conds []string
+	args  []interface{}
 }
-// Create inserts the T4 to the database.
-//
-// Deprecated: Use CreateContext instead.
-func (r *T4) Create(db Queryer) error {
-	return r.CreateContext(context.Background(), db)
+
+// NewT1Filter creates an empty T1Filter, which matches every row.
+func NewT1Filter() *T1Filter {
+	return &T1Filter{}
 }
 
-// GetT4ByPk select the T4 from the database.
-//
-// Deprecated: Use GetT4ByPkContext instead.
-func GetT4ByPk(db Queryer, pk0 int, pk1 int) (*T4, error) {
-	return GetT4ByPkContext(context.Background(), db, pk0, pk1)
+func (f *T1Filter) add(cond string, v interface{}) *T1Filter {
+	f.args = append(f.args, v)
+	f.conds = append(f.conds, fmt.Sprintf(cond, len(f.args)))
+	return f
 }
 
-// CreateContext inserts the T4 to the database.
-func (r *T4) CreateContext(ctx context.Context, db Queryer) error {
-	_, err := db.ExecContext(ctx,
-		` + "`INSERT INTO t4 (id, i) VALUES ($1, $2)`" + `,
-		&r.ID, &r.I)
-	if err != nil {
-		return errors.WithStack(err)
+// Where returns the WHERE clause and its arguments. It returns an empty clause
+// if the filter is nil or has no predicate.
+func (f *T1Filter) Where() (string, []interface{}) {
+	if f == nil || len(f.conds) == 0 {
+		return "", nil
 	}
-	return nil
+	return " WHERE " + strings.Join(f.conds, " AND "), f.args
 }
 
-// CreateOnConflictDoNothing inserts the T4 to the database.
-// If a conflict occurs (e.g., unique constraint violation), the insert is skipped without error.
-// Returns true if the row was inserted, false if it was skipped due to conflict.
-func (r *T4) CreateOnConflictDoNothing(ctx context.Context, db Queryer) (bool, error) {
-	result, err := db.ExecContext(ctx,
-		` + "`INSERT INTO t4 (id, i) VALUES ($1, $2) ON CONFLICT DO NOTHING`" + `,
-		&r.ID, &r.I)
+// IDEq filters the rows whose id equals to v.
+func (f *T1Filter) IDEq(v int64) *T1Filter {
+	return f.add("id = $%d", v)
+}
+
+// IDIn filters the rows whose id is one of vs.
+func (f *T1Filter) IDIn(vs ...int64) *T1Filter {
+	return f.add("id = ANY($%d)", pq.Array(vs))
+}
+
+// IDLt filters the rows whose id is less than v.
+func (f *T1Filter) IDLt(v int64) *T1Filter {
+	return f.add("id < $%d", v)
+}
+
+// IDGt filters the rows whose id is greater than v.
+func (f *T1Filter) IDGt(v int64) *T1Filter {
+	return f.add("id > $%d", v)
+}
+
+// IEq filters the rows whose i equals to v.
+func (f *T1Filter) IEq(v int) *T1Filter {
+	return f.add("i = $%d", v)
+}
+
+// IIn filters the rows whose i is one of vs.
+func (f *T1Filter) IIn(vs ...int) *T1Filter {
+	return f.add("i = ANY($%d)", pq.Array(vs))
+}
+
+// ILt filters the rows whose i is less than v.
+func (f *T1Filter) ILt(v int) *T1Filter {
+	return f.add("i < $%d", v)
+}
+
+// IGt filters the rows whose i is greater than v.
+func (f *T1Filter) IGt(v int) *T1Filter {
+	return f.add("i > $%d", v)
+}
+
+// StrEq filters the rows whose str equals to v.
+func (f *T1Filter) StrEq(v string) *T1Filter {
+	return f.add("str = $%d", v)
+}
+
+// StrIn filters the rows whose str is one of vs.
+func (f *T1Filter) StrIn(vs ...string) *T1Filter {
+	return f.add("str = ANY($%d)", pq.Array(vs))
+}
+
+// StrLt filters the rows whose str is less than v.
+func (f *T1Filter) StrLt(v string) *T1Filter {
+	return f.add("str < $%d", v)
+}
+
+// StrGt filters the rows whose str is greater than v.
+func (f *T1Filter) StrGt(v string) *T1Filter {
+	return f.add("str > $%d", v)
+}
+
+// NullableStrEq filters the rows whose nullable_str equals to v.
+func (f *T1Filter) NullableStrEq(v sql.NullString) *T1Filter {
+	return f.add("nullable_str = $%d", v)
+}
+
+// NullableStrIn filters the rows whose nullable_str is one of vs.
+func (f *T1Filter) NullableStrIn(vs ...sql.NullString) *T1Filter {
+	return f.add("nullable_str = ANY($%d)", pq.Array(vs))
+}
+
+// NullableStrLt filters the rows whose nullable_str is less than v.
+func (f *T1Filter) NullableStrLt(v sql.NullString) *T1Filter {
+	return f.add("nullable_str < $%d", v)
+}
+
+// NullableStrGt filters the rows whose nullable_str is greater than v.
+func (f *T1Filter) NullableStrGt(v sql.NullString) *T1Filter {
+	return f.add("nullable_str > $%d", v)
+}
+
+// NullableStrIsNull filters the rows whose nullable_str is NULL.
+func (f *T1Filter) NullableStrIsNull() *T1Filter {
+	f.conds = append(f.conds, "nullable_str IS NULL")
+	return f
+}
+
+// NullableStrIsNotNull filters the rows whose nullable_str is not NULL.
+func (f *T1Filter) NullableStrIsNotNull() *T1Filter {
+	f.conds = append(f.conds, "nullable_str IS NOT NULL")
+	return f
+}
+
+// TWithTzEq filters the rows whose t_with_tz equals to v.
+func (f *T1Filter) TWithTzEq(v time.Time) *T1Filter {
+	return f.add("t_with_tz = $%d", v)
+}
+
+// TWithTzIn filters the rows whose t_with_tz is one of vs.
+func (f *T1Filter) TWithTzIn(vs ...time.Time) *T1Filter {
+	return f.add("t_with_tz = ANY($%d)", pq.Array(vs))
+}
+
+// TWithTzLt filters the rows whose t_with_tz is less than v.
+func (f *T1Filter) TWithTzLt(v time.Time) *T1Filter {
+	return f.add("t_with_tz < $%d", v)
+}
+
+// TWithTzGt filters the rows whose t_with_tz is greater than v.
+func (f *T1Filter) TWithTzGt(v time.Time) *T1Filter {
+	return f.add("t_with_tz > $%d", v)
+}
+
+// TWithoutTzEq filters the rows whose t_without_tz equals to v.
+func (f *T1Filter) TWithoutTzEq(v time.Time) *T1Filter {
+	return f.add("t_without_tz = $%d", v)
+}
+
+// TWithoutTzIn filters the rows whose t_without_tz is one of vs.
+func (f *T1Filter) TWithoutTzIn(vs ...time.Time) *T1Filter {
+	return f.add("t_without_tz = ANY($%d)", pq.Array(vs))
+}
+
+// TWithoutTzLt filters the rows whose t_without_tz is less than v.
+func (f *T1Filter) TWithoutTzLt(v time.Time) *T1Filter {
+	return f.add("t_without_tz < $%d", v)
+}
+
+// TWithoutTzGt filters the rows whose t_without_tz is greater than v.
+func (f *T1Filter) TWithoutTzGt(v time.Time) *T1Filter {
+	return f.add("t_without_tz > $%d", v)
+}
+
+// TmEq filters the rows whose tm equals to v.
+func (f *T1Filter) TmEq(v *time.Time) *T1Filter {
+	return f.add("tm = $%d", v)
+}
+
+// TmIn filters the rows whose tm is one of vs.
+func (f *T1Filter) TmIn(vs ...*time.Time) *T1Filter {
+	return f.add("tm = ANY($%d)", pq.Array(vs))
+}
+
+// TmLt filters the rows whose tm is less than v.
+func (f *T1Filter) TmLt(v *time.Time) *T1Filter {
+	return f.add("tm < $%d", v)
+}
+
+// TmGt filters the rows whose tm is greater than v.
+func (f *T1Filter) TmGt(v *time.Time) *T1Filter {
+	return f.add("tm > $%d", v)
+}
+
+// TmIsNull filters the rows whose tm is NULL.
+func (f *T1Filter) TmIsNull() *T1Filter {
+	f.conds = append(f.conds, "tm IS NULL")
+	return f
+}
+
+// TmIsNotNull filters the rows whose tm is not NULL.
+func (f *T1Filter) TmIsNotNull() *T1Filter {
+	f.conds = append(f.conds, "tm IS NOT NULL")
+	return f
+}
+
+// FindT1 selects the T1s matching the filter from the database.
+// The result is ordered by the primary key.
+func FindT1(ctx context.Context, db Queryer, f *T1Filter) ([]*T1, error) {
+	where, args := f.Where()
+	rows, err := db.QueryContext(ctx,
+		` + "`SELECT id, i, str, nullable_str, t_with_tz, t_without_tz, tm FROM t1`" + `+where+` + "` ORDER BY id`" + `,
+		args...)
 	if err != nil {
-		return false, errors.WithStack(err)
+		return nil, errors.WithStack(translateError("t1", err))
 	}
-	rowsAffected, err := result.RowsAffected()
-	if err != nil {
-		return false, errors.WithStack(err)
+	defer rows.Close()
+	var rs []*T1
+	for rows.Next() {
+		var r T1
+		if err := rows.Scan(&r.ID, &r.I, &r.Str, &r.NullableStr, &r.TWithTz, &r.TWithoutTz, &r.Tm); err != nil {
+			return nil, errors.WithStack(translateError("t1", err))
+		}
+		rs = append(rs, &r)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.WithStack(translateError("t1", err))
 	}
-	return rowsAffected > 0, nil
+	return rs, nil
 }
 
-// GetT4ByPkContext select the T4 from the database.
-func GetT4ByPkContext(ctx context.Context, db Queryer, pk0 int, pk1 int) (*T4, error) {
-	var r T4
+// CountT1 counts the T1s matching the filter in the database.
+// Pass nil as the filter to count every row.
+func CountT1(ctx context.Context, db Queryer, f *T1Filter) (int64, error) {
+	where, args := f.Where()
+	var n int64
 	err := db.QueryRowContext(ctx,
-		` + "`SELECT id, i FROM t4 WHERE id = $1 AND i = $2`" + `,
-		pk0, pk1).Scan(&r.ID, &r.I)
+		` + "`SELECT count(*) FROM t1`" + `+where,
+		args...).Scan(&n)
 	if err != nil {
-		return nil, errors.WithStack(err)
+		return 0, errors.WithStack(translateError("t1", err))
 	}
-	return &r, nil
+	return n, nil
 }
-// T5 represents public.t5
-//
-// Deprecated: T5 is no longer maintained
-type T5 struct {
-	ID int // id
-	I  int // i
+
+// DeleteT1Where deletes the T1s matching the filter from the database,
+// and returns the number of the deleted rows. A filter without predicate is rejected
+// to avoid deleting every row by mistake.
+func DeleteT1Where(ctx context.Context, db Queryer, f *T1Filter) (int64, error) {
+	where, args := f.Where()
+	if where == "" {
+		return 0, errors.New("refusing to delete every row of t1 with an empty filter")
+	}
+	result, err := db.ExecContext(ctx,
+		` + "`DELETE FROM t1`" + `+where,
+		args...)
+	if err != nil {
+		return 0, errors.WithStack(translateError("t1", err))
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.WithStack(translateError("t1", err))
+	}
+	return n, nil
 }
-// Create inserts the T5 to the database.
+// T2 represents public.t2
+type T2 struct {
+	ID         int64     // id
+	I          int       // i
+	Str        string    // str
+	TWithTz    time.Time // t_with_tz
+	TWithoutTz time.Time // t_without_tz
+}
+// Create inserts the T2 to the database.
 //
-// Deprecated: T5 is no longer maintained
-func (r *T5) Create(db Queryer) error {
+// Deprecated: Use CreateContext instead.
+func (r *T2) Create(db Queryer) error {
 	return r.CreateContext(context.Background(), db)
 }
 
-// GetT5ByPk select the T5 from the database.
+// GetT2ByPk select the T2 from the database.
 //
-// Deprecated: T5 is no longer maintained
-func GetT5ByPk(db Queryer, pk0 int, pk1 int) (*T5, error) {
-	return GetT5ByPkContext(context.Background(), db, pk0, pk1)
+// Deprecated: Use GetT2ByPkContext instead.
+func GetT2ByPk(db Queryer, pk0 int64) (*T2, error) {
+	return GetT2ByPkContext(context.Background(), db, pk0)
 }
 
-// CreateContext inserts the T5 to the database.
-//
-// Deprecated: T5 is no longer maintained
-func (r *T5) CreateContext(ctx context.Context, db Queryer) error {
+// Validate checks the T2 against the constraints of t2 which can be checked
+// without the database, and returns *ValidationError listing every failing field.
+func (r *T2) Validate() error {
+	var errs []FieldError
+	if r.Str == "" {
+		errs = append(errs, FieldError{Field: "Str", Column: "str", Message: "must not be empty"})
+	}
+	if len(errs) > 0 {
+		return &ValidationError{Table: "t2", Fields: errs}
+	}
+	return nil
+}
+
+// CreateContext inserts the T2 to the database.
+func (r *T2) CreateContext(ctx context.Context, db Queryer) error {
+	if err := beforeCreate(ctx, r); err != nil {
+		return err
+	}
 	err := db.QueryRowContext(ctx,
-		` + "`INSERT INTO t5 () VALUES () RETURNING id, i`" + `,
-	).Scan(&r.ID, &r.I)
+		` + "`INSERT INTO t2 (i, str, t_with_tz, t_without_tz) VALUES ($1, $2, $3, $4) RETURNING id`" + `,
+		&r.I, &r.Str, &r.TWithTz, &r.TWithoutTz).Scan(&r.ID)
 	if err != nil {
-		return errors.WithStack(err)
+		return errors.WithStack(translateError("t2", err))
 	}
-	return nil
+	return afterCreate(ctx, r)
 }
 
-// CreateOnConflictDoNothing inserts the T5 to the database.
+// CreateOnConflictDoNothing inserts the T2 to the database.
 // If a conflict occurs (e.g., unique constraint violation), the insert is skipped without error.
 // Returns true if the row was inserted, false if it was skipped due to conflict.
-//
-// Deprecated: T5 is no longer maintained
-func (r *T5) CreateOnConflictDoNothing(ctx context.Context, db Queryer) (bool, error) {
+func (r *T2) CreateOnConflictDoNothing(ctx context.Context, db Queryer) (bool, error) {
+	if err := beforeCreate(ctx, r); err != nil {
+		return false, err
+	}
 	err := db.QueryRowContext(ctx,
-		` + "`INSERT INTO t5 () VALUES () ON CONFLICT DO NOTHING RETURNING id, i`" + `,
-	).Scan(&r.ID, &r.I)
+		` + "`INSERT INTO t2 (i, str, t_with_tz, t_without_tz) VALUES ($1, $2, $3, $4) ON CONFLICT DO NOTHING RETURNING id`" + `,
+		&r.I, &r.Str, &r.TWithTz, &r.TWithoutTz).Scan(&r.ID)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return false, nil
 		}
-		return false, errors.WithStack(err)
+		return false, errors.WithStack(translateError("t2", err))
 	}
 	// Row was successfully inserted
-	return true, nil
+	return true, afterCreate(ctx, r)
 }
 
-// GetT5ByPkContext select the T5 from the database.
-//
-// Deprecated: T5 is no longer maintained
-func GetT5ByPkContext(ctx context.Context, db Queryer, pk0 int, pk1 int) (*T5, error) {
-	var r T5
+// UpdateContext updates the T2 in the database by the primary key.
+// *NotFoundError is returned if the row is not found.
+func (r *T2) UpdateContext(ctx context.Context, db Queryer) error {
+	if err := beforeUpdate(ctx, r); err != nil {
+		return err
+	}
+	result, err := db.ExecContext(ctx,
+		` + "`UPDATE t2 SET i = $1, str = $2, t_with_tz = $3, t_without_tz = $4 WHERE id = $5`" + `,
+		&r.I, &r.Str, &r.TWithTz, &r.TWithoutTz, &r.ID)
+	if err != nil {
+		return errors.WithStack(translateError("t2", err))
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return errors.WithStack(translateError("t2", err))
+	}
+	if n == 0 {
+		err = sql.ErrNoRows
+		return errors.WithStack(translateError("t2", err))
+	}
+	return afterUpdate(ctx, r)
+}
+
+// DeleteContext deletes the T2 from the database by the primary key.
+// *NotFoundError is returned if the row is not found.
+func (r *T2) DeleteContext(ctx context.Context, db Queryer) error {
+	if err := beforeDelete(ctx, r); err != nil {
+		return err
+	}
+	result, err := db.ExecContext(ctx,
+		` + "`DELETE FROM t2 WHERE id = $1`" + `,
+		&r.ID)
+	if err != nil {
+		return errors.WithStack(translateError("t2", err))
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return errors.WithStack(translateError("t2", err))
+	}
+	if n == 0 {
+		err = sql.ErrNoRows
+		return errors.WithStack(translateError("t2", err))
+	}
+	return afterDelete(ctx, r)
+}
+
+// ReloadContext selects the T2 again by its primary key, and overwrites the fields with the
+// values in the database, e.g. after a trigger or a stored procedure changed the row.
+// *NotFoundError is returned if the row is not found.
+func (r *T2) ReloadContext(ctx context.Context, db Queryer) error {
 	err := db.QueryRowContext(ctx,
-		` + "`SELECT id, i FROM t5 WHERE id = $1 AND i = $2`" + `,
-		pk0, pk1).Scan(&r.ID, &r.I)
+		` + "`SELECT id, i, str, t_with_tz, t_without_tz FROM t2 WHERE id = $1`" + `,
+		r.ID).Scan(&r.ID, &r.I, &r.Str, &r.TWithTz, &r.TWithoutTz)
 	if err != nil {
-		return nil, errors.WithStack(err)
+		return errors.WithStack(translateError("t2", err))
 	}
-	return &r, nil
+	return nil
 }
-// T6 represents public.t6
-type T6 struct {
-	ID int // id
-	I  int // i
+
+// GetT2ByPkContext select the T2 from the database.
+func GetT2ByPkContext(ctx context.Context, db Queryer, pk0 int64) (*T2, error) {
+	var r T2
+	err := db.QueryRowContext(ctx,
+		` + "`SELECT id, i, str, t_with_tz, t_without_tz FROM t2 WHERE id = $1`" + `,
+		pk0).Scan(&r.ID, &r.I, &r.Str, &r.TWithTz, &r.TWithoutTz)
+	if err != nil {
+		return nil, errors.WithStack(translateError("t2", err))
+	}
+	return &r, nil
 }
-// Create inserts the T6 to the database.
-//
-// Deprecated: Use CreateContext instead.
-func (r *T6) Create(db Queryer) error {
-	return r.CreateContext(context.Background(), db)
+
+// GetT2ByPkForUpdateContext selects the T2 from the database with FOR UPDATE,
+// which locks the row until the end of the transaction. opt decides what happens if the row is locked
+// by another transaction: LockWait waits for it, LockNoWait fails with SQLSTATE 55P03, and
+// LockSkipLocked returns *NotFoundError.
+func GetT2ByPkForUpdateContext(ctx context.Context, db Queryer, pk0 int64, opt LockOption) (*T2, error) {
+	var r T2
+	err := db.QueryRowContext(ctx,
+		` + "`SELECT id, i, str, t_with_tz, t_without_tz FROM t2 WHERE id = $1 FOR UPDATE`" + `+opt.sql(),
+		pk0).Scan(&r.ID, &r.I, &r.Str, &r.TWithTz, &r.TWithoutTz)
+	if err != nil {
+		return nil, errors.WithStack(translateError("t2", err))
+	}
+	return &r, nil
 }
 
-// GetT6ByPk select the T6 from the database.
-//
-// Deprecated: Use GetT6ByPkContext instead.
-func GetT6ByPk(db Queryer, pk0 int, pk1 int) (*T6, error) {
-	return GetT6ByPkContext(context.Background(), db, pk0, pk1)
+// GetT2ByPkForShareContext selects the T2 from the database with FOR SHARE,
+// which locks the row until the end of the transaction. opt decides what happens if the row is locked
+// by another transaction: LockWait waits for it, LockNoWait fails with SQLSTATE 55P03, and
+// LockSkipLocked returns *NotFoundError.
+func GetT2ByPkForShareContext(ctx context.Context, db Queryer, pk0 int64, opt LockOption) (*T2, error) {
+	var r T2
+	err := db.QueryRowContext(ctx,
+		` + "`SELECT id, i, str, t_with_tz, t_without_tz FROM t2 WHERE id = $1 FOR SHARE`" + `+opt.sql(),
+		pk0).Scan(&r.ID, &r.I, &r.Str, &r.TWithTz, &r.TWithoutTz)
+	if err != nil {
+		return nil, errors.WithStack(translateError("t2", err))
+	}
+	return &r, nil
 }
 
-// CreateContext inserts the T6 to the database.
-func (r *T6) CreateContext(ctx context.Context, db Queryer) error {
+// ExistsT2ByPkContext checks if the T2 exists in the database.
+func ExistsT2ByPkContext(ctx context.Context, db Queryer, pk0 int64) (bool, error) {
+	var exists bool
 	err := db.QueryRowContext(ctx,
-		` + "`INSERT INTO t6 () VALUES () RETURNING id, i`" + `,
-	).Scan(&r.ID, &r.I)
+		` + "`SELECT EXISTS (SELECT 1 FROM t2 WHERE id = $1)`" + `,
+		pk0).Scan(&exists)
+	if err != nil {
+		return false, errors.WithStack(translateError("t2", err))
+	}
+	return exists, nil
+}
+
+// GetT2ByPksContext select the T2s of the given primary keys from the database.
+// The result is ordered by the primary key, and the keys which do not exist are skipped.
+func GetT2ByPksContext(ctx context.Context, db Queryer, pks []int64) ([]*T2, error) {
+	rows, err := db.QueryContext(ctx,
+		` + "`SELECT id, i, str, t_with_tz, t_without_tz FROM t2 WHERE id = ANY($1) ORDER BY id`" + `,
+		pq.Array(pks))
+	if err != nil {
+		return nil, errors.WithStack(translateError("t2", err))
+	}
+	defer rows.Close()
+	var rs []*T2
+	for rows.Next() {
+		var r T2
+		if err := rows.Scan(&r.ID, &r.I, &r.Str, &r.TWithTz, &r.TWithoutTz); err != nil {
+			return nil, errors.WithStack(translateError("t2", err))
+		}
+		rs = append(rs, &r)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.WithStack(translateError("t2", err))
+	}
+	return rs, nil
+}
+
+// T2ListOptions is the options of ListT2.
+type T2ListOptions struct {
+	// Limit is the maximum number of rows to return. Zero means no limit.
+	Limit int
+	// After is the primary key of the last row of the previous page.
+	// Only the rows after it are returned when it is not nil.
+	After *int64
+}
+
+// ListT2 lists the T2s ordered by the primary key.
+// Pass the primary key of the last row as opts.After to get the next page.
+func ListT2(ctx context.Context, db Queryer, opts T2ListOptions) ([]*T2, error) {
+	q := ` + "`SELECT id, i, str, t_with_tz, t_without_tz FROM t2`" + `
+	var args []interface{}
+	if opts.After != nil {
+		q += ` + "` WHERE id > $1`" + `
+		args = append(args, *opts.After)
+	}
+	q += ` + "` ORDER BY id`" + `
+	if opts.Limit > 0 {
+		args = append(args, opts.Limit)
+		q += fmt.Sprintf(` + "` LIMIT $%d`" + `, len(args))
+	}
+	rows, err := db.QueryContext(ctx, q, args...)
+	if err != nil {
+		return nil, errors.WithStack(translateError("t2", err))
+	}
+	defer rows.Close()
+	var rs []*T2
+	for rows.Next() {
+		var r T2
+		if err := rows.Scan(&r.ID, &r.I, &r.Str, &r.TWithTz, &r.TWithoutTz); err != nil {
+			return nil, errors.WithStack(translateError("t2", err))
+		}
+		rs = append(rs, &r)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.WithStack(translateError("t2", err))
+	}
+	return rs, nil
+}
+
+// T2Filter builds a parameterized WHERE clause of t2.
+// The predicates are combined with AND.
+type T2Filter struct {
+	conds []string
+	args  []interface{}
+}
+
+// NewT2Filter creates an empty T2Filter, which matches every row.
+func NewT2Filter() *T2Filter {
+	return &T2Filter{}
+}
+
+func (f *T2Filter) add(cond string, v interface{}) *T2Filter {
+	f.args = append(f.args, v)
+	f.conds = append(f.conds, fmt.Sprintf(cond, len(f.args)))
+	return f
+}
+
+// Where returns the WHERE clause and its arguments. It returns an empty clause
+// if the filter is nil or has no predicate.
+func (f *T2Filter) Where() (string, []interface{}) {
+	if f == nil || len(f.conds) == 0 {
+		return "", nil
+	}
+	return " WHERE " + strings.Join(f.conds, " AND "), f.args
+}
+
+// IDEq filters the rows whose id equals to v.
+func (f *T2Filter) IDEq(v int64) *T2Filter {
+	return f.add("id = $%d", v)
+}
+
+// IDIn filters the rows whose id is one of vs.
+func (f *T2Filter) IDIn(vs ...int64) *T2Filter {
+	return f.add("id = ANY($%d)", pq.Array(vs))
+}
+
+// IDLt filters the rows whose id is less than v.
+func (f *T2Filter) IDLt(v int64) *T2Filter {
+	return f.add("id < $%d", v)
+}
+
+// IDGt filters the rows whose id is greater than v.
+func (f *T2Filter) IDGt(v int64) *T2Filter {
+	return f.add("id > $%d", v)
+}
+
+// IEq filters the rows whose i equals to v.
+func (f *T2Filter) IEq(v int) *T2Filter {
+	return f.add("i = $%d", v)
+}
+
+// IIn filters the rows whose i is one of vs.
+func (f *T2Filter) IIn(vs ...int) *T2Filter {
+	return f.add("i = ANY($%d)", pq.Array(vs))
+}
+
+// ILt filters the rows whose i is less than v.
+func (f *T2Filter) ILt(v int) *T2Filter {
+	return f.add("i < $%d", v)
+}
+
+// IGt filters the rows whose i is greater than v.
+func (f *T2Filter) IGt(v int) *T2Filter {
+	return f.add("i > $%d", v)
+}
+
+// StrEq filters the rows whose str equals to v.
+func (f *T2Filter) StrEq(v string) *T2Filter {
+	return f.add("str = $%d", v)
+}
+
+// StrIn filters the rows whose str is one of vs.
+func (f *T2Filter) StrIn(vs ...string) *T2Filter {
+	return f.add("str = ANY($%d)", pq.Array(vs))
+}
+
+// StrLt filters the rows whose str is less than v.
+func (f *T2Filter) StrLt(v string) *T2Filter {
+	return f.add("str < $%d", v)
+}
+
+// StrGt filters the rows whose str is greater than v.
+func (f *T2Filter) StrGt(v string) *T2Filter {
+	return f.add("str > $%d", v)
+}
+
+// TWithTzEq filters the rows whose t_with_tz equals to v.
+func (f *T2Filter) TWithTzEq(v time.Time) *T2Filter {
+	return f.add("t_with_tz = $%d", v)
+}
+
+// TWithTzIn filters the rows whose t_with_tz is one of vs.
+func (f *T2Filter) TWithTzIn(vs ...time.Time) *T2Filter {
+	return f.add("t_with_tz = ANY($%d)", pq.Array(vs))
+}
+
+// TWithTzLt filters the rows whose t_with_tz is less than v.
+func (f *T2Filter) TWithTzLt(v time.Time) *T2Filter {
+	return f.add("t_with_tz < $%d", v)
+}
+
+// TWithTzGt filters the rows whose t_with_tz is greater than v.
+func (f *T2Filter) TWithTzGt(v time.Time) *T2Filter {
+	return f.add("t_with_tz > $%d", v)
+}
+
+// TWithoutTzEq filters the rows whose t_without_tz equals to v.
+func (f *T2Filter) TWithoutTzEq(v time.Time) *T2Filter {
+	return f.add("t_without_tz = $%d", v)
+}
+
+// TWithoutTzIn filters the rows whose t_without_tz is one of vs.
+func (f *T2Filter) TWithoutTzIn(vs ...time.Time) *T2Filter {
+	return f.add("t_without_tz = ANY($%d)", pq.Array(vs))
+}
+
+// TWithoutTzLt filters the rows whose t_without_tz is less than v.
+func (f *T2Filter) TWithoutTzLt(v time.Time) *T2Filter {
+	return f.add("t_without_tz < $%d", v)
+}
+
+// TWithoutTzGt filters the rows whose t_without_tz is greater than v.
+func (f *T2Filter) TWithoutTzGt(v time.Time) *T2Filter {
+	return f.add("t_without_tz > $%d", v)
+}
+
+// FindT2 selects the T2s matching the filter from the database.
+// The result is ordered by the primary key.
+func FindT2(ctx context.Context, db Queryer, f *T2Filter) ([]*T2, error) {
+	where, args := f.Where()
+	rows, err := db.QueryContext(ctx,
+		` + "`SELECT id, i, str, t_with_tz, t_without_tz FROM t2`" + `+where+` + "` ORDER BY id`" + `,
+		args...)
+	if err != nil {
+		return nil, errors.WithStack(translateError("t2", err))
+	}
+	defer rows.Close()
+	var rs []*T2
+	for rows.Next() {
+		var r T2
+		if err := rows.Scan(&r.ID, &r.I, &r.Str, &r.TWithTz, &r.TWithoutTz); err != nil {
+			return nil, errors.WithStack(translateError("t2", err))
+		}
+		rs = append(rs, &r)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.WithStack(translateError("t2", err))
+	}
+	return rs, nil
+}
+
+// CountT2 counts the T2s matching the filter in the database.
+// Pass nil as the filter to count every row.
+func CountT2(ctx context.Context, db Queryer, f *T2Filter) (int64, error) {
+	where, args := f.Where()
+	var n int64
+	err := db.QueryRowContext(ctx,
+		` + "`SELECT count(*) FROM t2`" + `+where,
+		args...).Scan(&n)
+	if err != nil {
+		return 0, errors.WithStack(translateError("t2", err))
+	}
+	return n, nil
+}
+
+// DeleteT2Where deletes the T2s matching the filter from the database,
+// and returns the number of the deleted rows. A filter without predicate is rejected
+// to avoid deleting every row by mistake.
+func DeleteT2Where(ctx context.Context, db Queryer, f *T2Filter) (int64, error) {
+	where, args := f.Where()
+	if where == "" {
+		return 0, errors.New("refusing to delete every row of t2 with an empty filter")
+	}
+	result, err := db.ExecContext(ctx,
+		` + "`DELETE FROM t2`" + `+where,
+		args...)
+	if err != nil {
+		return 0, errors.WithStack(translateError("t2", err))
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.WithStack(translateError("t2", err))
+	}
+	return n, nil
+}
+// T3 represents public.t3
+type T3 struct {
+	ID         int64     // id
+	I          int       // i
+	Str        string    // str
+	TWithTz    time.Time // t_with_tz
+	TWithoutTz time.Time // t_without_tz
+}
+
+// T3Pk represents the primary key of public.t3
+type T3Pk struct {
+	ID int64 // id
+	I  int   // i
+}
+// Create inserts the T3 to the database.
+//
+// Deprecated: Use CreateContext instead.
+func (r *T3) Create(db Queryer) error {
+	return r.CreateContext(context.Background(), db)
+}
+
+// GetT3ByPk select the T3 from the database.
+//
+// Deprecated: Use GetT3ByPkContext instead.
+func GetT3ByPk(db Queryer, pk0 int64, pk1 int) (*T3, error) {
+	return GetT3ByPkContext(context.Background(), db, pk0, pk1)
+}
+
+// Validate checks the T3 against the constraints of t3 which can be checked
+// without the database, and returns *ValidationError listing every failing field.
+func (r *T3) Validate() error {
+	var errs []FieldError
+	if r.Str == "" {
+		errs = append(errs, FieldError{Field: "Str", Column: "str", Message: "must not be empty"})
+	}
+	if len(errs) > 0 {
+		return &ValidationError{Table: "t3", Fields: errs}
+	}
+	return nil
+}
+
+// CreateContext inserts the T3 to the database.
+func (r *T3) CreateContext(ctx context.Context, db Queryer) error {
+	if err := beforeCreate(ctx, r); err != nil {
+		return err
+	}
+	err := db.QueryRowContext(ctx,
+		` + "`INSERT INTO t3 (i, str, t_with_tz, t_without_tz) VALUES ($1, $2, $3, $4) RETURNING id`" + `,
+		&r.I, &r.Str, &r.TWithTz, &r.TWithoutTz).Scan(&r.ID)
+	if err != nil {
+		return errors.WithStack(translateError("t3", err))
+	}
+	return afterCreate(ctx, r)
+}
+
+// CreateOnConflictDoNothing inserts the T3 to the database.
+// If a conflict occurs (e.g., unique constraint violation), the insert is skipped without error.
+// Returns true if the row was inserted, false if it was skipped due to conflict.
+func (r *T3) CreateOnConflictDoNothing(ctx context.Context, db Queryer) (bool, error) {
+	if err := beforeCreate(ctx, r); err != nil {
+		return false, err
+	}
+	err := db.QueryRowContext(ctx,
+		` + "`INSERT INTO t3 (i, str, t_with_tz, t_without_tz) VALUES ($1, $2, $3, $4) ON CONFLICT DO NOTHING RETURNING id`" + `,
+		&r.I, &r.Str, &r.TWithTz, &r.TWithoutTz).Scan(&r.ID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return false, nil
+		}
+		return false, errors.WithStack(translateError("t3", err))
+	}
+	// Row was successfully inserted
+	return true, afterCreate(ctx, r)
+}
+
+// UpdateContext updates the T3 in the database by the primary key.
+// *NotFoundError is returned if the row is not found.
+func (r *T3) UpdateContext(ctx context.Context, db Queryer) error {
+	if err := beforeUpdate(ctx, r); err != nil {
+		return err
+	}
+	result, err := db.ExecContext(ctx,
+		` + "`UPDATE t3 SET str = $1, t_with_tz = $2, t_without_tz = $3 WHERE id = $4 AND i = $5`" + `,
+		&r.Str, &r.TWithTz, &r.TWithoutTz, &r.ID, &r.I)
+	if err != nil {
+		return errors.WithStack(translateError("t3", err))
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return errors.WithStack(translateError("t3", err))
+	}
+	if n == 0 {
+		err = sql.ErrNoRows
+		return errors.WithStack(translateError("t3", err))
+	}
+	return afterUpdate(ctx, r)
+}
+
+// DeleteContext deletes the T3 from the database by the primary key.
+// *NotFoundError is returned if the row is not found.
+func (r *T3) DeleteContext(ctx context.Context, db Queryer) error {
+	if err := beforeDelete(ctx, r); err != nil {
+		return err
+	}
+	result, err := db.ExecContext(ctx,
+		` + "`DELETE FROM t3 WHERE id = $1 AND i = $2`" + `,
+		&r.ID, &r.I)
+	if err != nil {
+		return errors.WithStack(translateError("t3", err))
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return errors.WithStack(translateError("t3", err))
+	}
+	if n == 0 {
+		err = sql.ErrNoRows
+		return errors.WithStack(translateError("t3", err))
+	}
+	return afterDelete(ctx, r)
+}
+
+// ReloadContext selects the T3 again by its primary key, and overwrites the fields with the
+// values in the database, e.g. after a trigger or a stored procedure changed the row.
+// *NotFoundError is returned if the row is not found.
+func (r *T3) ReloadContext(ctx context.Context, db Queryer) error {
+	err := db.QueryRowContext(ctx,
+		` + "`SELECT id, i, str, t_with_tz, t_without_tz FROM t3 WHERE id = $1 AND i = $2`" + `,
+		r.ID, r.I).Scan(&r.ID, &r.I, &r.Str, &r.TWithTz, &r.TWithoutTz)
+	if err != nil {
+		return errors.WithStack(translateError("t3", err))
+	}
+	return nil
+}
+
+// GetT3ByPkContext select the T3 from the database.
+func GetT3ByPkContext(ctx context.Context, db Queryer, pk0 int64, pk1 int) (*T3, error) {
+	var r T3
+	err := db.QueryRowContext(ctx,
+		` + "`SELECT id, i, str, t_with_tz, t_without_tz FROM t3 WHERE id = $1 AND i = $2`" + `,
+		pk0, pk1).Scan(&r.ID, &r.I, &r.Str, &r.TWithTz, &r.TWithoutTz)
+	if err != nil {
+		return nil, errors.WithStack(translateError("t3", err))
+	}
+	return &r, nil
+}
+
+// GetT3ByPkForUpdateContext selects the T3 from the database with FOR UPDATE,
+// which locks the row until the end of the transaction. opt decides what happens if the row is locked
+// by another transaction: LockWait waits for it, LockNoWait fails with SQLSTATE 55P03, and
+// LockSkipLocked returns *NotFoundError.
+func GetT3ByPkForUpdateContext(ctx context.Context, db Queryer, pk0 int64, pk1 int, opt LockOption) (*T3, error) {
+	var r T3
+	err := db.QueryRowContext(ctx,
+		` + "`SELECT id, i, str, t_with_tz, t_without_tz FROM t3 WHERE id = $1 AND i = $2 FOR UPDATE`" + `+opt.sql(),
+		pk0, pk1).Scan(&r.ID, &r.I, &r.Str, &r.TWithTz, &r.TWithoutTz)
+	if err != nil {
+		return nil, errors.WithStack(translateError("t3", err))
+	}
+	return &r, nil
+}
+
+// GetT3ByPkForShareContext selects the T3 from the database with FOR SHARE,
+// which locks the row until the end of the transaction. opt decides what happens if the row is locked
+// by another transaction: LockWait waits for it, LockNoWait fails with SQLSTATE 55P03, and
+// LockSkipLocked returns *NotFoundError.
+func GetT3ByPkForShareContext(ctx context.Context, db Queryer, pk0 int64, pk1 int, opt LockOption) (*T3, error) {
+	var r T3
+	err := db.QueryRowContext(ctx,
+		` + "`SELECT id, i, str, t_with_tz, t_without_tz FROM t3 WHERE id = $1 AND i = $2 FOR SHARE`" + `+opt.sql(),
+		pk0, pk1).Scan(&r.ID, &r.I, &r.Str, &r.TWithTz, &r.TWithoutTz)
+	if err != nil {
+		return nil, errors.WithStack(translateError("t3", err))
+	}
+	return &r, nil
+}
+
+// ExistsT3ByPkContext checks if the T3 exists in the database.
+func ExistsT3ByPkContext(ctx context.Context, db Queryer, pk0 int64, pk1 int) (bool, error) {
+	var exists bool
+	err := db.QueryRowContext(ctx,
+		` + "`SELECT EXISTS (SELECT 1 FROM t3 WHERE id = $1 AND i = $2)`" + `,
+		pk0, pk1).Scan(&exists)
+	if err != nil {
+		return false, errors.WithStack(translateError("t3", err))
+	}
+	return exists, nil
+}
+
+// GetT3ByPksContext select the T3s of the given primary keys from the database.
+// The result is ordered by the primary key, and the keys which do not exist are skipped.
+func GetT3ByPksContext(ctx context.Context, db Queryer, pks []T3Pk) ([]*T3, error) {
+	if len(pks) == 0 {
+		return nil, nil
+	}
+	values := make([]string, 0, len(pks))
+	args := make([]interface{}, 0, len(pks)*2)
+	for _, pk := range pks {
+		args = append(args, pk.ID, pk.I)
+		values = append(values, fmt.Sprintf("($%d::bigint, $%d::integer)", len(args)-1, len(args)))
+	}
+	rows, err := db.QueryContext(ctx,
+		fmt.Sprintf(` + "`SELECT id, i, str, t_with_tz, t_without_tz FROM t3 WHERE (id, i) IN (VALUES %s) ORDER BY id, i`" + `, strings.Join(values, ", ")),
+		args...)
+	if err != nil {
+		return nil, errors.WithStack(translateError("t3", err))
+	}
+	defer rows.Close()
+	var rs []*T3
+	for rows.Next() {
+		var r T3
+		if err := rows.Scan(&r.ID, &r.I, &r.Str, &r.TWithTz, &r.TWithoutTz); err != nil {
+			return nil, errors.WithStack(translateError("t3", err))
+		}
+		rs = append(rs, &r)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.WithStack(translateError("t3", err))
+	}
+	return rs, nil
+}
+
+// T3ListOptions is the options of ListT3.
+type T3ListOptions struct {
+	// Limit is the maximum number of rows to return. Zero means no limit.
+	Limit int
+	// After is the primary key of the last row of the previous page.
+	// Only the rows after it are returned when it is not nil.
+	After *T3Pk
+}
+
+// ListT3 lists the T3s ordered by the primary key.
+// Pass the primary key of the last row as opts.After to get the next page.
+func ListT3(ctx context.Context, db Queryer, opts T3ListOptions) ([]*T3, error) {
+	q := ` + "`SELECT id, i, str, t_with_tz, t_without_tz FROM t3`" + `
+	var args []interface{}
+	if opts.After != nil {
+		q += ` + "` WHERE (id, i) > ($1, $2)`" + `
+		args = append(args, opts.After.ID, opts.After.I)
+	}
+	q += ` + "` ORDER BY id, i`" + `
+	if opts.Limit > 0 {
+		args = append(args, opts.Limit)
+		q += fmt.Sprintf(` + "` LIMIT $%d`" + `, len(args))
+	}
+	rows, err := db.QueryContext(ctx, q, args...)
+	if err != nil {
+		return nil, errors.WithStack(translateError("t3", err))
+	}
+	defer rows.Close()
+	var rs []*T3
+	for rows.Next() {
+		var r T3
+		if err := rows.Scan(&r.ID, &r.I, &r.Str, &r.TWithTz, &r.TWithoutTz); err != nil {
+			return nil, errors.WithStack(translateError("t3", err))
+		}
+		rs = append(rs, &r)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.WithStack(translateError("t3", err))
+	}
+	return rs, nil
+}
+
+// T3Filter builds a parameterized WHERE clause of t3.
+// The predicates are combined with AND.
+type T3Filter struct {
+	conds []string
+	args  []interface{}
+}
+
+// NewT3Filter creates an empty T3Filter, which matches every row.
+func NewT3Filter() *T3Filter {
+	return &T3Filter{}
+}
+
+func (f *T3Filter) add(cond string, v interface{}) *T3Filter {
+	f.args = append(f.args, v)
+	f.conds = append(f.conds, fmt.Sprintf(cond, len(f.args)))
+	return f
+}
+
+// Where returns the WHERE clause and its arguments. It returns an empty clause
+// if the filter is nil or has no predicate.
+func (f *T3Filter) Where() (string, []interface{}) {
+	if f == nil || len(f.conds) == 0 {
+		return "", nil
+	}
+	return " WHERE " + strings.Join(f.conds, " AND "), f.args
+}
+
+// IDEq filters the rows whose id equals to v.
+func (f *T3Filter) IDEq(v int64) *T3Filter {
+	return f.add("id = $%d", v)
+}
+
+// IDIn filters the rows whose id is one of vs.
+func (f *T3Filter) IDIn(vs ...int64) *T3Filter {
+	return f.add("id = ANY($%d)", pq.Array(vs))
+}
+
+// IDLt filters the rows whose id is less than v.
+func (f *T3Filter) IDLt(v int64) *T3Filter {
+	return f.add("id < $%d", v)
+}
+
+// IDGt filters the rows whose id is greater than v.
+func (f *T3Filter) IDGt(v int64) *T3Filter {
+	return f.add("id > $%d", v)
+}
+
+// IEq filters the rows whose i equals to v.
+func (f *T3Filter) IEq(v int) *T3Filter {
+	return f.add("i = $%d", v)
+}
+
+// IIn filters the rows whose i is one of vs.
+func (f *T3Filter) IIn(vs ...int) *T3Filter {
+	return f.add("i = ANY($%d)", pq.Array(vs))
+}
+
+// ILt filters the rows whose i is less than v.
+func (f *T3Filter) ILt(v int) *T3Filter {
+	return f.add("i < $%d", v)
+}
+
+// IGt filters the rows whose i is greater than v.
+func (f *T3Filter) IGt(v int) *T3Filter {
+	return f.add("i > $%d", v)
+}
+
+// StrEq filters the rows whose str equals to v.
+func (f *T3Filter) StrEq(v string) *T3Filter {
+	return f.add("str = $%d", v)
+}
+
+// StrIn filters the rows whose str is one of vs.
+func (f *T3Filter) StrIn(vs ...string) *T3Filter {
+	return f.add("str = ANY($%d)", pq.Array(vs))
+}
+
+// StrLt filters the rows whose str is less than v.
+func (f *T3Filter) StrLt(v string) *T3Filter {
+	return f.add("str < $%d", v)
+}
+
+// StrGt filters the rows whose str is greater than v.
+func (f *T3Filter) StrGt(v string) *T3Filter {
+	return f.add("str > $%d", v)
+}
+
+// TWithTzEq filters the rows whose t_with_tz equals to v.
+func (f *T3Filter) TWithTzEq(v time.Time) *T3Filter {
+	return f.add("t_with_tz = $%d", v)
+}
+
+// TWithTzIn filters the rows whose t_with_tz is one of vs.
+func (f *T3Filter) TWithTzIn(vs ...time.Time) *T3Filter {
+	return f.add("t_with_tz = ANY($%d)", pq.Array(vs))
+}
+
+// TWithTzLt filters the rows whose t_with_tz is less than v.
+func (f *T3Filter) TWithTzLt(v time.Time) *T3Filter {
+	return f.add("t_with_tz < $%d", v)
+}
+
+// TWithTzGt filters the rows whose t_with_tz is greater than v.
+func (f *T3Filter) TWithTzGt(v time.Time) *T3Filter {
+	return f.add("t_with_tz > $%d", v)
+}
+
+// TWithoutTzEq filters the rows whose t_without_tz equals to v.
+func (f *T3Filter) TWithoutTzEq(v time.Time) *T3Filter {
+	return f.add("t_without_tz = $%d", v)
+}
+
+// TWithoutTzIn filters the rows whose t_without_tz is one of vs.
+func (f *T3Filter) TWithoutTzIn(vs ...time.Time) *T3Filter {
+	return f.add("t_without_tz = ANY($%d)", pq.Array(vs))
+}
+
+// TWithoutTzLt filters the rows whose t_without_tz is less than v.
+func (f *T3Filter) TWithoutTzLt(v time.Time) *T3Filter {
+	return f.add("t_without_tz < $%d", v)
+}
+
+// TWithoutTzGt filters the rows whose t_without_tz is greater than v.
+func (f *T3Filter) TWithoutTzGt(v time.Time) *T3Filter {
+	return f.add("t_without_tz > $%d", v)
+}
+
+// FindT3 selects the T3s matching the filter from the database.
+// The result is ordered by the primary key.
+func FindT3(ctx context.Context, db Queryer, f *T3Filter) ([]*T3, error) {
+	where, args := f.Where()
+	rows, err := db.QueryContext(ctx,
+		` + "`SELECT id, i, str, t_with_tz, t_without_tz FROM t3`" + `+where+` + "` ORDER BY id, i`" + `,
+		args...)
+	if err != nil {
+		return nil, errors.WithStack(translateError("t3", err))
+	}
+	defer rows.Close()
+	var rs []*T3
+	for rows.Next() {
+		var r T3
+		if err := rows.Scan(&r.ID, &r.I, &r.Str, &r.TWithTz, &r.TWithoutTz); err != nil {
+			return nil, errors.WithStack(translateError("t3", err))
+		}
+		rs = append(rs, &r)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.WithStack(translateError("t3", err))
+	}
+	return rs, nil
+}
+
+// CountT3 counts the T3s matching the filter in the database.
+// Pass nil as the filter to count every row.
+func CountT3(ctx context.Context, db Queryer, f *T3Filter) (int64, error) {
+	where, args := f.Where()
+	var n int64
+	err := db.QueryRowContext(ctx,
+		` + "`SELECT count(*) FROM t3`" + `+where,
+		args...).Scan(&n)
+	if err != nil {
+		return 0, errors.WithStack(translateError("t3", err))
+	}
+	return n, nil
+}
+
+// DeleteT3Where deletes the T3s matching the filter from the database,
+// and returns the number of the deleted rows. A filter without predicate is rejected
+// to avoid deleting every row by mistake.
+func DeleteT3Where(ctx context.Context, db Queryer, f *T3Filter) (int64, error) {
+	where, args := f.Where()
+	if where == "" {
+		return 0, errors.New("refusing to delete every row of t3 with an empty filter")
+	}
+	result, err := db.ExecContext(ctx,
+		` + "`DELETE FROM t3`" + `+where,
+		args...)
+	if err != nil {
+		return 0, errors.WithStack(translateError("t3", err))
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.WithStack(translateError("t3", err))
+	}
+	return n, nil
+}
+// T4 represents public.t4
+type T4 struct {
+	ID int // id
+	I  int // i
+}
+
+// T4Pk represents the primary key of public.t4
+type T4Pk struct {
+	ID int // id
+	I  int // i
+}
+// Create inserts the T4 to the database.
+//
+// Deprecated: Use CreateContext instead.
+func (r *T4) Create(db Queryer) error {
+	return r.CreateContext(context.Background(), db)
+}
+
+// GetT4ByPk select the T4 from the database.
+//
+// Deprecated: Use GetT4ByPkContext instead.
+func GetT4ByPk(db Queryer, pk0 int, pk1 int) (*T4, error) {
+	return GetT4ByPkContext(context.Background(), db, pk0, pk1)
+}
+
+// Validate checks the T4 against the constraints of t4 which can be checked
+// without the database, and returns *ValidationError listing every failing field.
+func (r *T4) Validate() error {
+	return nil
+}
+
+// CreateContext inserts the T4 to the database.
+func (r *T4) CreateContext(ctx context.Context, db Queryer) error {
+	if err := beforeCreate(ctx, r); err != nil {
+		return err
+	}
+	_, err := db.ExecContext(ctx,
+		` + "`INSERT INTO t4 (id, i) VALUES ($1, $2)`" + `,
+		&r.ID, &r.I)
+	if err != nil {
+		return errors.WithStack(translateError("t4", err))
+	}
+	return afterCreate(ctx, r)
+}
+
+// CreateOnConflictDoNothing inserts the T4 to the database.
+// If a conflict occurs (e.g., unique constraint violation), the insert is skipped without error.
+// Returns true if the row was inserted, false if it was skipped due to conflict.
+func (r *T4) CreateOnConflictDoNothing(ctx context.Context, db Queryer) (bool, error) {
+	if err := beforeCreate(ctx, r); err != nil {
+		return false, err
+	}
+	result, err := db.ExecContext(ctx,
+		` + "`INSERT INTO t4 (id, i) VALUES ($1, $2) ON CONFLICT DO NOTHING`" + `,
+		&r.ID, &r.I)
+	if err != nil {
+		return false, errors.WithStack(translateError("t4", err))
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, errors.WithStack(translateError("t4", err))
+	}
+	if rowsAffected == 0 {
+		return false, nil
+	}
+	return true, afterCreate(ctx, r)
+}
+
+// DeleteContext deletes the T4 from the database by the primary key.
+// *NotFoundError is returned if the row is not found.
+func (r *T4) DeleteContext(ctx context.Context, db Queryer) error {
+	if err := beforeDelete(ctx, r); err != nil {
+		return err
+	}
+	result, err := db.ExecContext(ctx,
+		` + "`DELETE FROM t4 WHERE id = $1 AND i = $2`" + `,
+		&r.ID, &r.I)
+	if err != nil {
+		return errors.WithStack(translateError("t4", err))
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return errors.WithStack(translateError("t4", err))
+	}
+	if n == 0 {
+		err = sql.ErrNoRows
+		return errors.WithStack(translateError("t4", err))
+	}
+	return afterDelete(ctx, r)
+}
+
+// ReloadContext selects the T4 again by its primary key, and overwrites the fields with the
+// values in the database, e.g. after a trigger or a stored procedure changed the row.
+// *NotFoundError is returned if the row is not found.
+func (r *T4) ReloadContext(ctx context.Context, db Queryer) error {
+	err := db.QueryRowContext(ctx,
+		` + "`SELECT id, i FROM t4 WHERE id = $1 AND i = $2`" + `,
+		r.ID, r.I).Scan(&r.ID, &r.I)
+	if err != nil {
+		return errors.WithStack(translateError("t4", err))
+	}
+	return nil
+}
+
+// GetT4ByPkContext select the T4 from the database.
+func GetT4ByPkContext(ctx context.Context, db Queryer, pk0 int, pk1 int) (*T4, error) {
+	var r T4
+	err := db.QueryRowContext(ctx,
+		` + "`SELECT id, i FROM t4 WHERE id = $1 AND i = $2`" + `,
+		pk0, pk1).Scan(&r.ID, &r.I)
+	if err != nil {
+		return nil, errors.WithStack(translateError("t4", err))
+	}
+	return &r, nil
+}
+
+// GetT4ByPkForUpdateContext selects the T4 from the database with FOR UPDATE,
+// which locks the row until the end of the transaction. opt decides what happens if the row is locked
+// by another transaction: LockWait waits for it, LockNoWait fails with SQLSTATE 55P03, and
+// LockSkipLocked returns *NotFoundError.
+func GetT4ByPkForUpdateContext(ctx context.Context, db Queryer, pk0 int, pk1 int, opt LockOption) (*T4, error) {
+	var r T4
+	err := db.QueryRowContext(ctx,
+		` + "`SELECT id, i FROM t4 WHERE id = $1 AND i = $2 FOR UPDATE`" + `+opt.sql(),
+		pk0, pk1).Scan(&r.ID, &r.I)
+	if err != nil {
+		return nil, errors.WithStack(translateError("t4", err))
+	}
+	return &r, nil
+}
+
+// GetT4ByPkForShareContext selects the T4 from the database with FOR SHARE,
+// which locks the row until the end of the transaction. opt decides what happens if the row is locked
+// by another transaction: LockWait waits for it, LockNoWait fails with SQLSTATE 55P03, and
+// LockSkipLocked returns *NotFoundError.
+func GetT4ByPkForShareContext(ctx context.Context, db Queryer, pk0 int, pk1 int, opt LockOption) (*T4, error) {
+	var r T4
+	err := db.QueryRowContext(ctx,
+		` + "`SELECT id, i FROM t4 WHERE id = $1 AND i = $2 FOR SHARE`" + `+opt.sql(),
+		pk0, pk1).Scan(&r.ID, &r.I)
+	if err != nil {
+		return nil, errors.WithStack(translateError("t4", err))
+	}
+	return &r, nil
+}
+
+// ExistsT4ByPkContext checks if the T4 exists in the database.
+func ExistsT4ByPkContext(ctx context.Context, db Queryer, pk0 int, pk1 int) (bool, error) {
+	var exists bool
+	err := db.QueryRowContext(ctx,
+		` + "`SELECT EXISTS (SELECT 1 FROM t4 WHERE id = $1 AND i = $2)`" + `,
+		pk0, pk1).Scan(&exists)
+	if err != nil {
+		return false, errors.WithStack(translateError("t4", err))
+	}
+	return exists, nil
+}
+
+// GetT4ByPksContext select the T4s of the given primary keys from the database.
+// The result is ordered by the primary key, and the keys which do not exist are skipped.
+func GetT4ByPksContext(ctx context.Context, db Queryer, pks []T4Pk) ([]*T4, error) {
+	if len(pks) == 0 {
+		return nil, nil
+	}
+	values := make([]string, 0, len(pks))
+	args := make([]interface{}, 0, len(pks)*2)
+	for _, pk := range pks {
+		args = append(args, pk.ID, pk.I)
+		values = append(values, fmt.Sprintf("($%d::integer, $%d::integer)", len(args)-1, len(args)))
+	}
+	rows, err := db.QueryContext(ctx,
+		fmt.Sprintf(` + "`SELECT id, i FROM t4 WHERE (id, i) IN (VALUES %s) ORDER BY id, i`" + `, strings.Join(values, ", ")),
+		args...)
+	if err != nil {
+		return nil, errors.WithStack(translateError("t4", err))
+	}
+	defer rows.Close()
+	var rs []*T4
+	for rows.Next() {
+		var r T4
+		if err := rows.Scan(&r.ID, &r.I); err != nil {
+			return nil, errors.WithStack(translateError("t4", err))
+		}
+		rs = append(rs, &r)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.WithStack(translateError("t4", err))
+	}
+	return rs, nil
+}
+
+// T4ListOptions is the options of ListT4.
+type T4ListOptions struct {
+	// Limit is the maximum number of rows to return. Zero means no limit.
+	Limit int
+	// After is the primary key of the last row of the previous page.
+	// Only the rows after it are returned when it is not nil.
+	After *T4Pk
+}
+
+// ListT4 lists the T4s ordered by the primary key.
+// Pass the primary key of the last row as opts.After to get the next page.
+func ListT4(ctx context.Context, db Queryer, opts T4ListOptions) ([]*T4, error) {
+	q := ` + "`SELECT id, i FROM t4`" + `
+	var args []interface{}
+	if opts.After != nil {
+		q += ` + "` WHERE (id, i) > ($1, $2)`" + `
+		args = append(args, opts.After.ID, opts.After.I)
+	}
+	q += ` + "` ORDER BY id, i`" + `
+	if opts.Limit > 0 {
+		args = append(args, opts.Limit)
+		q += fmt.Sprintf(` + "` LIMIT $%d`" + `, len(args))
+	}
+	rows, err := db.QueryContext(ctx, q, args...)
+	if err != nil {
+		return nil, errors.WithStack(translateError("t4", err))
+	}
+	defer rows.Close()
+	var rs []*T4
+	for rows.Next() {
+		var r T4
+		if err := rows.Scan(&r.ID, &r.I); err != nil {
+			return nil, errors.WithStack(translateError("t4", err))
+		}
+		rs = append(rs, &r)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.WithStack(translateError("t4", err))
+	}
+	return rs, nil
+}
+
+// T4Filter builds a parameterized WHERE clause of t4.
+// The predicates are combined with AND.
+type T4Filter struct {
+	conds []string
+	args  []interface{}
+}
+
+// NewT4Filter creates an empty T4Filter, which matches every row.
+func NewT4Filter() *T4Filter {
+	return &T4Filter{}
+}
+
+func (f *T4Filter) add(cond string, v interface{}) *T4Filter {
+	f.args = append(f.args, v)
+	f.conds = append(f.conds, fmt.Sprintf(cond, len(f.args)))
+	return f
+}
+
+// Where returns the WHERE clause and its arguments. It returns an empty clause
+// if the filter is nil or has no predicate.
+func (f *T4Filter) Where() (string, []interface{}) {
+	if f == nil || len(f.conds) == 0 {
+		return "", nil
+	}
+	return " WHERE " + strings.Join(f.conds, " AND "), f.args
+}
+
+// IDEq filters the rows whose id equals to v.
+func (f *T4Filter) IDEq(v int) *T4Filter {
+	return f.add("id = $%d", v)
+}
+
+// IDIn filters the rows whose id is one of vs.
+func (f *T4Filter) IDIn(vs ...int) *T4Filter {
+	return f.add("id = ANY($%d)", pq.Array(vs))
+}
+
+// IDLt filters the rows whose id is less than v.
+func (f *T4Filter) IDLt(v int) *T4Filter {
+	return f.add("id < $%d", v)
+}
+
+// IDGt filters the rows whose id is greater than v.
+func (f *T4Filter) IDGt(v int) *T4Filter {
+	return f.add("id > $%d", v)
+}
+
+// IEq filters the rows whose i equals to v.
+func (f *T4Filter) IEq(v int) *T4Filter {
+	return f.add("i = $%d", v)
+}
+
+// IIn filters the rows whose i is one of vs.
+func (f *T4Filter) IIn(vs ...int) *T4Filter {
+	return f.add("i = ANY($%d)", pq.Array(vs))
+}
+
+// ILt filters the rows whose i is less than v.
+func (f *T4Filter) ILt(v int) *T4Filter {
+	return f.add("i < $%d", v)
+}
+
+// IGt filters the rows whose i is greater than v.
+func (f *T4Filter) IGt(v int) *T4Filter {
+	return f.add("i > $%d", v)
+}
+
+// FindT4 selects the T4s matching the filter from the database.
+// The result is ordered by the primary key.
+func FindT4(ctx context.Context, db Queryer, f *T4Filter) ([]*T4, error) {
+	where, args := f.Where()
+	rows, err := db.QueryContext(ctx,
+		` + "`SELECT id, i FROM t4`" + `+where+` + "` ORDER BY id, i`" + `,
+		args...)
+	if err != nil {
+		return nil, errors.WithStack(translateError("t4", err))
+	}
+	defer rows.Close()
+	var rs []*T4
+	for rows.Next() {
+		var r T4
+		if err := rows.Scan(&r.ID, &r.I); err != nil {
+			return nil, errors.WithStack(translateError("t4", err))
+		}
+		rs = append(rs, &r)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.WithStack(translateError("t4", err))
+	}
+	return rs, nil
+}
+
+// CountT4 counts the T4s matching the filter in the database.
+// Pass nil as the filter to count every row.
+func CountT4(ctx context.Context, db Queryer, f *T4Filter) (int64, error) {
+	where, args := f.Where()
+	var n int64
+	err := db.QueryRowContext(ctx,
+		` + "`SELECT count(*) FROM t4`" + `+where,
+		args...).Scan(&n)
+	if err != nil {
+		return 0, errors.WithStack(translateError("t4", err))
+	}
+	return n, nil
+}
+
+// DeleteT4Where deletes the T4s matching the filter from the database,
+// and returns the number of the deleted rows. A filter without predicate is rejected
+// to avoid deleting every row by mistake.
+func DeleteT4Where(ctx context.Context, db Queryer, f *T4Filter) (int64, error) {
+	where, args := f.Where()
+	if where == "" {
+		return 0, errors.New("refusing to delete every row of t4 with an empty filter")
+	}
+	result, err := db.ExecContext(ctx,
+		` + "`DELETE FROM t4`" + `+where,
+		args...)
+	if err != nil {
+		return 0, errors.WithStack(translateError("t4", err))
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.WithStack(translateError("t4", err))
+	}
+	return n, nil
+}
+// T5 represents public.t5
+type T5 struct {
+	ID int // id
+	I  int // i
+}
+
+// T5Pk represents the primary key of public.t5
+type T5Pk struct {
+	ID int // id
+	I  int // i
+}
+// Create inserts the T5 to the database.
+//
+// Deprecated: Use CreateContext instead.
+func (r *T5) Create(db Queryer) error {
+	return r.CreateContext(context.Background(), db)
+}
+
+// GetT5ByPk select the T5 from the database.
+//
+// Deprecated: Use GetT5ByPkContext instead.
+func GetT5ByPk(db Queryer, pk0 int, pk1 int) (*T5, error) {
+	return GetT5ByPkContext(context.Background(), db, pk0, pk1)
+}
+
+// Validate checks the T5 against the constraints of t5 which can be checked
+// without the database, and returns *ValidationError listing every failing field.
+func (r *T5) Validate() error {
+	return nil
+}
+
+// CreateContext inserts the T5 to the database.
+func (r *T5) CreateContext(ctx context.Context, db Queryer) error {
+	if err := beforeCreate(ctx, r); err != nil {
+		return err
+	}
+	err := db.QueryRowContext(ctx,
+		` + "`INSERT INTO t5 (i) VALUES ($1) RETURNING id`" + `,
+		&r.I).Scan(&r.ID)
+	if err != nil {
+		return errors.WithStack(translateError("t5", err))
+	}
+	return afterCreate(ctx, r)
+}
+
+// CreateOnConflictDoNothing inserts the T5 to the database.
+// If a conflict occurs (e.g., unique constraint violation), the insert is skipped without error.
+// Returns true if the row was inserted, false if it was skipped due to conflict.
+func (r *T5) CreateOnConflictDoNothing(ctx context.Context, db Queryer) (bool, error) {
+	if err := beforeCreate(ctx, r); err != nil {
+		return false, err
+	}
+	err := db.QueryRowContext(ctx,
+		` + "`INSERT INTO t5 (i) VALUES ($1) ON CONFLICT DO NOTHING RETURNING id`" + `,
+		&r.I).Scan(&r.ID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return false, nil
+		}
+		return false, errors.WithStack(translateError("t5", err))
+	}
+	// Row was successfully inserted
+	return true, afterCreate(ctx, r)
+}
+
+// DeleteContext deletes the T5 from the database by the primary key.
+// *NotFoundError is returned if the row is not found.
+func (r *T5) DeleteContext(ctx context.Context, db Queryer) error {
+	if err := beforeDelete(ctx, r); err != nil {
+		return err
+	}
+	result, err := db.ExecContext(ctx,
+		` + "`DELETE FROM t5 WHERE id = $1 AND i = $2`" + `,
+		&r.ID, &r.I)
+	if err != nil {
+		return errors.WithStack(translateError("t5", err))
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return errors.WithStack(translateError("t5", err))
+	}
+	if n == 0 {
+		err = sql.ErrNoRows
+		return errors.WithStack(translateError("t5", err))
+	}
+	return afterDelete(ctx, r)
+}
+
+// ReloadContext selects the T5 again by its primary key, and overwrites the fields with the
+// values in the database, e.g. after a trigger or a stored procedure changed the row.
+// *NotFoundError is returned if the row is not found.
+func (r *T5) ReloadContext(ctx context.Context, db Queryer) error {
+	err := db.QueryRowContext(ctx,
+		` + "`SELECT id, i FROM t5 WHERE id = $1 AND i = $2`" + `,
+		r.ID, r.I).Scan(&r.ID, &r.I)
+	if err != nil {
+		return errors.WithStack(translateError("t5", err))
+	}
+	return nil
+}
+
+// GetT5ByPkContext select the T5 from the database.
+func GetT5ByPkContext(ctx context.Context, db Queryer, pk0 int, pk1 int) (*T5, error) {
+	var r T5
+	err := db.QueryRowContext(ctx,
+		` + "`SELECT id, i FROM t5 WHERE id = $1 AND i = $2`" + `,
+		pk0, pk1).Scan(&r.ID, &r.I)
+	if err != nil {
+		return nil, errors.WithStack(translateError("t5", err))
+	}
+	return &r, nil
+}
+
+// GetT5ByPkForUpdateContext selects the T5 from the database with FOR UPDATE,
+// which locks the row until the end of the transaction. opt decides what happens if the row is locked
+// by another transaction: LockWait waits for it, LockNoWait fails with SQLSTATE 55P03, and
+// LockSkipLocked returns *NotFoundError.
+func GetT5ByPkForUpdateContext(ctx context.Context, db Queryer, pk0 int, pk1 int, opt LockOption) (*T5, error) {
+	var r T5
+	err := db.QueryRowContext(ctx,
+		` + "`SELECT id, i FROM t5 WHERE id = $1 AND i = $2 FOR UPDATE`" + `+opt.sql(),
+		pk0, pk1).Scan(&r.ID, &r.I)
+	if err != nil {
+		return nil, errors.WithStack(translateError("t5", err))
+	}
+	return &r, nil
+}
+
+// GetT5ByPkForShareContext selects the T5 from the database with FOR SHARE,
+// which locks the row until the end of the transaction. opt decides what happens if the row is locked
+// by another transaction: LockWait waits for it, LockNoWait fails with SQLSTATE 55P03, and
+// LockSkipLocked returns *NotFoundError.
+func GetT5ByPkForShareContext(ctx context.Context, db Queryer, pk0 int, pk1 int, opt LockOption) (*T5, error) {
+	var r T5
+	err := db.QueryRowContext(ctx,
+		` + "`SELECT id, i FROM t5 WHERE id = $1 AND i = $2 FOR SHARE`" + `+opt.sql(),
+		pk0, pk1).Scan(&r.ID, &r.I)
+	if err != nil {
+		return nil, errors.WithStack(translateError("t5", err))
+	}
+	return &r, nil
+}
+
+// ExistsT5ByPkContext checks if the T5 exists in the database.
+func ExistsT5ByPkContext(ctx context.Context, db Queryer, pk0 int, pk1 int) (bool, error) {
+	var exists bool
+	err := db.QueryRowContext(ctx,
+		` + "`SELECT EXISTS (SELECT 1 FROM t5 WHERE id = $1 AND i = $2)`" + `,
+		pk0, pk1).Scan(&exists)
+	if err != nil {
+		return false, errors.WithStack(translateError("t5", err))
+	}
+	return exists, nil
+}
+
+// GetT5ByPksContext select the T5s of the given primary keys from the database.
+// The result is ordered by the primary key, and the keys which do not exist are skipped.
+func GetT5ByPksContext(ctx context.Context, db Queryer, pks []T5Pk) ([]*T5, error) {
+	if len(pks) == 0 {
+		return nil, nil
+	}
+	values := make([]string, 0, len(pks))
+	args := make([]interface{}, 0, len(pks)*2)
+	for _, pk := range pks {
+		args = append(args, pk.ID, pk.I)
+		values = append(values, fmt.Sprintf("($%d::integer, $%d::integer)", len(args)-1, len(args)))
+	}
+	rows, err := db.QueryContext(ctx,
+		fmt.Sprintf(` + "`SELECT id, i FROM t5 WHERE (id, i) IN (VALUES %s) ORDER BY id, i`" + `, strings.Join(values, ", ")),
+		args...)
+	if err != nil {
+		return nil, errors.WithStack(translateError("t5", err))
+	}
+	defer rows.Close()
+	var rs []*T5
+	for rows.Next() {
+		var r T5
+		if err := rows.Scan(&r.ID, &r.I); err != nil {
+			return nil, errors.WithStack(translateError("t5", err))
+		}
+		rs = append(rs, &r)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.WithStack(translateError("t5", err))
+	}
+	return rs, nil
+}
+
+// T5ListOptions is the options of ListT5.
+type T5ListOptions struct {
+	// Limit is the maximum number of rows to return. Zero means no limit.
+	Limit int
+	// After is the primary key of the last row of the previous page.
+	// Only the rows after it are returned when it is not nil.
+	After *T5Pk
+}
+
+// ListT5 lists the T5s ordered by the primary key.
+// Pass the primary key of the last row as opts.After to get the next page.
+func ListT5(ctx context.Context, db Queryer, opts T5ListOptions) ([]*T5, error) {
+	q := ` + "`SELECT id, i FROM t5`" + `
+	var args []interface{}
+	if opts.After != nil {
+		q += ` + "` WHERE (id, i) > ($1, $2)`" + `
+		args = append(args, opts.After.ID, opts.After.I)
+	}
+	q += ` + "` ORDER BY id, i`" + `
+	if opts.Limit > 0 {
+		args = append(args, opts.Limit)
+		q += fmt.Sprintf(` + "` LIMIT $%d`" + `, len(args))
+	}
+	rows, err := db.QueryContext(ctx, q, args...)
+	if err != nil {
+		return nil, errors.WithStack(translateError("t5", err))
+	}
+	defer rows.Close()
+	var rs []*T5
+	for rows.Next() {
+		var r T5
+		if err := rows.Scan(&r.ID, &r.I); err != nil {
+			return nil, errors.WithStack(translateError("t5", err))
+		}
+		rs = append(rs, &r)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.WithStack(translateError("t5", err))
+	}
+	return rs, nil
+}
+
+// T5Filter builds a parameterized WHERE clause of t5.
+// The predicates are combined with AND.
+type T5Filter struct {
+	conds []string
+	args  []interface{}
+}
+
+// NewT5Filter creates an empty T5Filter, which matches every row.
+func NewT5Filter() *T5Filter {
+	return &T5Filter{}
+}
+
+func (f *T5Filter) add(cond string, v interface{}) *T5Filter {
+	f.args = append(f.args, v)
+	f.conds = append(f.conds, fmt.Sprintf(cond, len(f.args)))
+	return f
+}
+
+// Where returns the WHERE clause and its arguments. It returns an empty clause
+// if the filter is nil or has no predicate.
+func (f *T5Filter) Where() (string, []interface{}) {
+	if f == nil || len(f.conds) == 0 {
+		return "", nil
+	}
+	return " WHERE " + strings.Join(f.conds, " AND "), f.args
+}
+
+// IDEq filters the rows whose id equals to v.
+func (f *T5Filter) IDEq(v int) *T5Filter {
+	return f.add("id = $%d", v)
+}
+
+// IDIn filters the rows whose id is one of vs.
+func (f *T5Filter) IDIn(vs ...int) *T5Filter {
+	return f.add("id = ANY($%d)", pq.Array(vs))
+}
+
+// IDLt filters the rows whose id is less than v.
+func (f *T5Filter) IDLt(v int) *T5Filter {
+	return f.add("id < $%d", v)
+}
+
+// IDGt filters the rows whose id is greater than v.
+func (f *T5Filter) IDGt(v int) *T5Filter {
+	return f.add("id > $%d", v)
+}
+
+// IEq filters the rows whose i equals to v.
+func (f *T5Filter) IEq(v int) *T5Filter {
+	return f.add("i = $%d", v)
+}
+
+// IIn filters the rows whose i is one of vs.
+func (f *T5Filter) IIn(vs ...int) *T5Filter {
+	return f.add("i = ANY($%d)", pq.Array(vs))
+}
+
+// ILt filters the rows whose i is less than v.
+func (f *T5Filter) ILt(v int) *T5Filter {
+	return f.add("i < $%d", v)
+}
+
+// IGt filters the rows whose i is greater than v.
+func (f *T5Filter) IGt(v int) *T5Filter {
+	return f.add("i > $%d", v)
+}
+
+// FindT5 selects the T5s matching the filter from the database.
+// The result is ordered by the primary key.
+func FindT5(ctx context.Context, db Queryer, f *T5Filter) ([]*T5, error) {
+	where, args := f.Where()
+	rows, err := db.QueryContext(ctx,
+		` + "`SELECT id, i FROM t5`" + `+where+` + "` ORDER BY id, i`" + `,
+		args...)
+	if err != nil {
+		return nil, errors.WithStack(translateError("t5", err))
+	}
+	defer rows.Close()
+	var rs []*T5
+	for rows.Next() {
+		var r T5
+		if err := rows.Scan(&r.ID, &r.I); err != nil {
+			return nil, errors.WithStack(translateError("t5", err))
+		}
+		rs = append(rs, &r)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.WithStack(translateError("t5", err))
+	}
+	return rs, nil
+}
+
+// CountT5 counts the T5s matching the filter in the database.
+// Pass nil as the filter to count every row.
+func CountT5(ctx context.Context, db Queryer, f *T5Filter) (int64, error) {
+	where, args := f.Where()
+	var n int64
+	err := db.QueryRowContext(ctx,
+		` + "`SELECT count(*) FROM t5`" + `+where,
+		args...).Scan(&n)
+	if err != nil {
+		return 0, errors.WithStack(translateError("t5", err))
+	}
+	return n, nil
+}
+
+// DeleteT5Where deletes the T5s matching the filter from the database,
+// and returns the number of the deleted rows. A filter without predicate is rejected
+// to avoid deleting every row by mistake.
+func DeleteT5Where(ctx context.Context, db Queryer, f *T5Filter) (int64, error) {
+	where, args := f.Where()
+	if where == "" {
+		return 0, errors.New("refusing to delete every row of t5 with an empty filter")
+	}
+	result, err := db.ExecContext(ctx,
+		` + "`DELETE FROM t5`" + `+where,
+		args...)
+	if err != nil {
+		return 0, errors.WithStack(translateError("t5", err))
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.WithStack(translateError("t5", err))
+	}
+	return n, nil
+}
+// T6 represents public.t6
+type T6 struct {
+	ID int // id
+	I  int // i
+}
+
+// T6Pk represents the primary key of public.t6
+type T6Pk struct {
+	ID int // id
+	I  int // i
+}
+// Create inserts the T6 to the database.
+//
+// Deprecated: Use CreateContext instead.
+func (r *T6) Create(db Queryer) error {
+	return r.CreateContext(context.Background(), db)
+}
+
+// GetT6ByPk select the T6 from the database.
+//
+// Deprecated: Use GetT6ByPkContext instead.
+func GetT6ByPk(db Queryer, pk0 int, pk1 int) (*T6, error) {
+	return GetT6ByPkContext(context.Background(), db, pk0, pk1)
+}
+
+// Validate checks the T6 against the constraints of t6 which can be checked
+// without the database, and returns *ValidationError listing every failing field.
+func (r *T6) Validate() error {
+	return nil
+}
+
+// CreateContext inserts the T6 to the database.
+func (r *T6) CreateContext(ctx context.Context, db Queryer) error {
+	if err := beforeCreate(ctx, r); err != nil {
+		return err
+	}
+	err := db.QueryRowContext(ctx,
+		` + "`INSERT INTO t6 (i) VALUES ($1) RETURNING id`" + `,
+		&r.I).Scan(&r.ID)
+	if err != nil {
+		return errors.WithStack(translateError("t6", err))
+	}
+	return afterCreate(ctx, r)
+}
+
+// CreateOnConflictDoNothing inserts the T6 to the database.
+// If a conflict occurs (e.g., unique constraint violation), the insert is skipped without error.
+// Returns true if the row was inserted, false if it was skipped due to conflict.
+func (r *T6) CreateOnConflictDoNothing(ctx context.Context, db Queryer) (bool, error) {
+	if err := beforeCreate(ctx, r); err != nil {
+		return false, err
+	}
+	err := db.QueryRowContext(ctx,
+		` + "`INSERT INTO t6 (i) VALUES ($1) ON CONFLICT DO NOTHING RETURNING id`" + `,
+		&r.I).Scan(&r.ID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return false, nil
+		}
+		return false, errors.WithStack(translateError("t6", err))
+	}
+	// Row was successfully inserted
+	return true, afterCreate(ctx, r)
+}
+
+// DeleteContext deletes the T6 from the database by the primary key.
+// *NotFoundError is returned if the row is not found.
+func (r *T6) DeleteContext(ctx context.Context, db Queryer) error {
+	if err := beforeDelete(ctx, r); err != nil {
+		return err
+	}
+	result, err := db.ExecContext(ctx,
+		` + "`DELETE FROM t6 WHERE id = $1 AND i = $2`" + `,
+		&r.ID, &r.I)
+	if err != nil {
+		return errors.WithStack(translateError("t6", err))
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return errors.WithStack(translateError("t6", err))
+	}
+	if n == 0 {
+		err = sql.ErrNoRows
+		return errors.WithStack(translateError("t6", err))
+	}
+	return afterDelete(ctx, r)
+}
+
+// ReloadContext selects the T6 again by its primary key, and overwrites the fields with the
+// values in the database, e.g. after a trigger or a stored procedure changed the row.
+// *NotFoundError is returned if the row is not found.
+func (r *T6) ReloadContext(ctx context.Context, db Queryer) error {
+	err := db.QueryRowContext(ctx,
+		` + "`SELECT id, i FROM t6 WHERE id = $1 AND i = $2`" + `,
+		r.ID, r.I).Scan(&r.ID, &r.I)
+	if err != nil {
+		return errors.WithStack(translateError("t6", err))
+	}
+	return nil
+}
+
+// GetT6ByPkContext select the T6 from the database.
+func GetT6ByPkContext(ctx context.Context, db Queryer, pk0 int, pk1 int) (*T6, error) {
+	var r T6
+	err := db.QueryRowContext(ctx,
+		` + "`SELECT id, i FROM t6 WHERE id = $1 AND i = $2`" + `,
+		pk0, pk1).Scan(&r.ID, &r.I)
+	if err != nil {
+		return nil, errors.WithStack(translateError("t6", err))
+	}
+	return &r, nil
+}
+
+// GetT6ByPkForUpdateContext selects the T6 from the database with FOR UPDATE,
+// which locks the row until the end of the transaction. opt decides what happens if the row is locked
+// by another transaction: LockWait waits for it, LockNoWait fails with SQLSTATE 55P03, and
+// LockSkipLocked returns *NotFoundError.
+func GetT6ByPkForUpdateContext(ctx context.Context, db Queryer, pk0 int, pk1 int, opt LockOption) (*T6, error) {
+	var r T6
+	err := db.QueryRowContext(ctx,
+		` + "`SELECT id, i FROM t6 WHERE id = $1 AND i = $2 FOR UPDATE`" + `+opt.sql(),
+		pk0, pk1).Scan(&r.ID, &r.I)
+	if err != nil {
+		return nil, errors.WithStack(translateError("t6", err))
+	}
+	return &r, nil
+}
+
+// GetT6ByPkForShareContext selects the T6 from the database with FOR SHARE,
+// which locks the row until the end of the transaction. opt decides what happens if the row is locked
+// by another transaction: LockWait waits for it, LockNoWait fails with SQLSTATE 55P03, and
+// LockSkipLocked returns *NotFoundError.
+func GetT6ByPkForShareContext(ctx context.Context, db Queryer, pk0 int, pk1 int, opt LockOption) (*T6, error) {
+	var r T6
+	err := db.QueryRowContext(ctx,
+		` + "`SELECT id, i FROM t6 WHERE id = $1 AND i = $2 FOR SHARE`" + `+opt.sql(),
+		pk0, pk1).Scan(&r.ID, &r.I)
+	if err != nil {
+		return nil, errors.WithStack(translateError("t6", err))
+	}
+	return &r, nil
+}
+
+// ExistsT6ByPkContext checks if the T6 exists in the database.
+func ExistsT6ByPkContext(ctx context.Context, db Queryer, pk0 int, pk1 int) (bool, error) {
+	var exists bool
+	err := db.QueryRowContext(ctx,
+		` + "`SELECT EXISTS (SELECT 1 FROM t6 WHERE id = $1 AND i = $2)`" + `,
+		pk0, pk1).Scan(&exists)
+	if err != nil {
+		return false, errors.WithStack(translateError("t6", err))
+	}
+	return exists, nil
+}
+
+// GetT6ByPksContext select the T6s of the given primary keys from the database.
+// The result is ordered by the primary key, and the keys which do not exist are skipped.
+func GetT6ByPksContext(ctx context.Context, db Queryer, pks []T6Pk) ([]*T6, error) {
+	if len(pks) == 0 {
+		return nil, nil
+	}
+	values := make([]string, 0, len(pks))
+	args := make([]interface{}, 0, len(pks)*2)
+	for _, pk := range pks {
+		args = append(args, pk.ID, pk.I)
+		values = append(values, fmt.Sprintf("($%d::integer, $%d::integer)", len(args)-1, len(args)))
+	}
+	rows, err := db.QueryContext(ctx,
+		fmt.Sprintf(` + "`SELECT id, i FROM t6 WHERE (id, i) IN (VALUES %s) ORDER BY id, i`" + `, strings.Join(values, ", ")),
+		args...)
+	if err != nil {
+		return nil, errors.WithStack(translateError("t6", err))
+	}
+	defer rows.Close()
+	var rs []*T6
+	for rows.Next() {
+		var r T6
+		if err := rows.Scan(&r.ID, &r.I); err != nil {
+			return nil, errors.WithStack(translateError("t6", err))
+		}
+		rs = append(rs, &r)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.WithStack(translateError("t6", err))
+	}
+	return rs, nil
+}
+
+// T6ListOptions is the options of ListT6.
+type T6ListOptions struct {
+	// Limit is the maximum number of rows to return. Zero means no limit.
+	Limit int
+	// After is the primary key of the last row of the previous page.
+	// Only the rows after it are returned when it is not nil.
+	After *T6Pk
+}
+
+// ListT6 lists the T6s ordered by the primary key.
+// Pass the primary key of the last row as opts.After to get the next page.
+func ListT6(ctx context.Context, db Queryer, opts T6ListOptions) ([]*T6, error) {
+	q := ` + "`SELECT id, i FROM t6`" + `
+	var args []interface{}
+	if opts.After != nil {
+		q += ` + "` WHERE (id, i) > ($1, $2)`" + `
+		args = append(args, opts.After.ID, opts.After.I)
+	}
+	q += ` + "` ORDER BY id, i`" + `
+	if opts.Limit > 0 {
+		args = append(args, opts.Limit)
+		q += fmt.Sprintf(` + "` LIMIT $%d`" + `, len(args))
+	}
+	rows, err := db.QueryContext(ctx, q, args...)
+	if err != nil {
+		return nil, errors.WithStack(translateError("t6", err))
+	}
+	defer rows.Close()
+	var rs []*T6
+	for rows.Next() {
+		var r T6
+		if err := rows.Scan(&r.ID, &r.I); err != nil {
+			return nil, errors.WithStack(translateError("t6", err))
+		}
+		rs = append(rs, &r)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.WithStack(translateError("t6", err))
+	}
+	return rs, nil
+}
+
+// T6Filter builds a parameterized WHERE clause of t6.
+// The predicates are combined with AND.
+type T6Filter struct {
+	conds []string
+	args  []interface{}
+}
+
+// NewT6Filter creates an empty T6Filter, which matches every row.
+func NewT6Filter() *T6Filter {
+	return &T6Filter{}
+}
+
+func (f *T6Filter) add(cond string, v interface{}) *T6Filter {
+	f.args = append(f.args, v)
+	f.conds = append(f.conds, fmt.Sprintf(cond, len(f.args)))
+	return f
+}
+
+// Where returns the WHERE clause and its arguments. It returns an empty clause
+// if the filter is nil or has no predicate.
+func (f *T6Filter) Where() (string, []interface{}) {
+	if f == nil || len(f.conds) == 0 {
+		return "", nil
+	}
+	return " WHERE " + strings.Join(f.conds, " AND "), f.args
+}
+
+// IDEq filters the rows whose id equals to v.
+func (f *T6Filter) IDEq(v int) *T6Filter {
+	return f.add("id = $%d", v)
+}
+
+// IDIn filters the rows whose id is one of vs.
+func (f *T6Filter) IDIn(vs ...int) *T6Filter {
+	return f.add("id = ANY($%d)", pq.Array(vs))
+}
+
+// IDLt filters the rows whose id is less than v.
+func (f *T6Filter) IDLt(v int) *T6Filter {
+	return f.add("id < $%d", v)
+}
+
+// IDGt filters the rows whose id is greater than v.
+func (f *T6Filter) IDGt(v int) *T6Filter {
+	return f.add("id > $%d", v)
+}
+
+// IEq filters the rows whose i equals to v.
+func (f *T6Filter) IEq(v int) *T6Filter {
+	return f.add("i = $%d", v)
+}
+
+// IIn filters the rows whose i is one of vs.
+func (f *T6Filter) IIn(vs ...int) *T6Filter {
+	return f.add("i = ANY($%d)", pq.Array(vs))
+}
+
+// ILt filters the rows whose i is less than v.
+func (f *T6Filter) ILt(v int) *T6Filter {
+	return f.add("i < $%d", v)
+}
+
+// IGt filters the rows whose i is greater than v.
+func (f *T6Filter) IGt(v int) *T6Filter {
+	return f.add("i > $%d", v)
+}
+
+// FindT6 selects the T6s matching the filter from the database.
+// The result is ordered by the primary key.
+func FindT6(ctx context.Context, db Queryer, f *T6Filter) ([]*T6, error) {
+	where, args := f.Where()
+	rows, err := db.QueryContext(ctx,
+		` + "`SELECT id, i FROM t6`" + `+where+` + "` ORDER BY id, i`" + `,
+		args...)
+	if err != nil {
+		return nil, errors.WithStack(translateError("t6", err))
+	}
+	defer rows.Close()
+	var rs []*T6
+	for rows.Next() {
+		var r T6
+		if err := rows.Scan(&r.ID, &r.I); err != nil {
+			return nil, errors.WithStack(translateError("t6", err))
+		}
+		rs = append(rs, &r)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.WithStack(translateError("t6", err))
+	}
+	return rs, nil
+}
+
+// CountT6 counts the T6s matching the filter in the database.
+// Pass nil as the filter to count every row.
+func CountT6(ctx context.Context, db Queryer, f *T6Filter) (int64, error) {
+	where, args := f.Where()
+	var n int64
+	err := db.QueryRowContext(ctx,
+		` + "`SELECT count(*) FROM t6`" + `+where,
+		args...).Scan(&n)
+	if err != nil {
+		return 0, errors.WithStack(translateError("t6", err))
+	}
+	return n, nil
+}
+
+// DeleteT6Where deletes the T6s matching the filter from the database,
+// and returns the number of the deleted rows. A filter without predicate is rejected
+// to avoid deleting every row by mistake.
+func DeleteT6Where(ctx context.Context, db Queryer, f *T6Filter) (int64, error) {
+	where, args := f.Where()
+	if where == "" {
+		return 0, errors.New("refusing to delete every row of t6 with an empty filter")
+	}
+	result, err := db.ExecContext(ctx,
+		` + "`DELETE FROM t6`" + `+where,
+		args...)
+	if err != nil {
+		return 0, errors.WithStack(translateError("t6", err))
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.WithStack(translateError("t6", err))
+	}
+	return n, nil
+}
+
+var (
+	// ErrNotFound is matched by errors.Is when no row is found.
+	ErrNotFound = errors.New("not found")
+	// ErrUniqueViolation is matched by errors.Is on a unique constraint violation.
+	ErrUniqueViolation = errors.New("unique violation")
+	// ErrForeignKeyViolation is matched by errors.Is on a foreign key constraint violation.
+	ErrForeignKeyViolation = errors.New("foreign key violation")
+	// ErrNotNullViolation is matched by errors.Is on a not-null constraint violation.
+	ErrNotNullViolation = errors.New("not null violation")
+	// ErrCheckViolation is matched by errors.Is on a check constraint violation.
+	ErrCheckViolation = errors.New("check violation")
+	// ErrExclusionViolation is matched by errors.Is on an exclusion constraint violation.
+	ErrExclusionViolation = errors.New("exclusion violation")
+	// ErrValidation is matched by errors.Is when Validate fails.
+	ErrValidation = errors.New("validation failed")
+)
+
+// NotFoundError is returned when no row is found in the table.
+type NotFoundError struct {
+	Table string
+	Err   error
+}
+
+func (e *NotFoundError) Error() string { return e.Err.Error() }
+
+// Is reports whether the target is ErrNotFound.
+func (e *NotFoundError) Is(target error) bool { return target == ErrNotFound }
+
+// Unwrap returns the original error, which is sql.ErrNoRows.
+func (e *NotFoundError) Unwrap() error { return e.Err }
+
+// Cause returns the original error for errors.Cause of github.com/pkg/errors.
+func (e *NotFoundError) Cause() error { return e.Err }
+
+// ConstraintError is returned when a statement violates a constraint.
+// Kind is one of ErrUniqueViolation, ErrForeignKeyViolation, ErrNotNullViolation,
+// ErrCheckViolation and ErrExclusionViolation.
+type ConstraintError struct {
+	Kind       error
+	Table      string
+	Column     string
+	Constraint string
+	Err        error
+}
+
+func (e *ConstraintError) Error() string { return e.Err.Error() }
+
+// Is reports whether the target is the Kind of the violation.
+func (e *ConstraintError) Is(target error) bool { return target == e.Kind }
+
+// Unwrap returns the original error, which is *pq.Error.
+func (e *ConstraintError) Unwrap() error { return e.Err }
+
+// Cause returns the original error for errors.Cause of github.com/pkg/errors.
+func (e *ConstraintError) Cause() error { return e.Err }
+
+// FieldError is a field which fails Validate. Constraint is the name of the
+// check constraint, or empty for NOT NULL and the length of the column.
+type FieldError struct {
+	Field      string
+	Column     string
+	Constraint string
+	Message    string
+}
+
+// ValidationError is returned by Validate, listing every failing field.
+type ValidationError struct {
+	Table  string
+	Fields []FieldError
+}
+
+func (e *ValidationError) Error() string {
+	msgs := make([]string, 0, len(e.Fields))
+	for _, f := range e.Fields {
+		msgs = append(msgs, f.Column+" "+f.Message)
+	}
+	return "invalid " + e.Table + ": " + strings.Join(msgs, ", ")
+}
+
+// Is reports whether the target is ErrValidation.
+func (e *ValidationError) Is(target error) bool { return target == ErrValidation }
+
+// LockOption decides what the row lock of GetXByPkForUpdateContext and
+// GetXByPkForShareContext does when the row is locked by another transaction.
+type LockOption int
+
+const (
+	// LockWait waits for the other transaction to end.
+	LockWait LockOption = iota
+	// LockNoWait fails immediately with SQLSTATE 55P03, lock_not_available.
+	LockNoWait
+	// LockSkipLocked skips the row, which is reported as not found.
+	LockSkipLocked
+)
+
+func (o LockOption) sql() string {
+	switch o {
+	case LockNoWait:
+		return " NOWAIT"
+	case LockSkipLocked:
+		return " SKIP LOCKED"
+	}
+	return ""
+}
+
+// BeforeCreateHook is implemented by the structs which run logic before they are inserted.
+type BeforeCreateHook interface {
+	BeforeCreate(ctx context.Context) error
+}
+
+// AfterCreateHook is implemented by the structs which run logic after they are inserted.
+type AfterCreateHook interface {
+	AfterCreate(ctx context.Context) error
+}
+
+// BeforeUpdateHook is implemented by the structs which run logic before they are updated.
+type BeforeUpdateHook interface {
+	BeforeUpdate(ctx context.Context) error
+}
+
+// AfterUpdateHook is implemented by the structs which run logic after they are updated.
+type AfterUpdateHook interface {
+	AfterUpdate(ctx context.Context) error
+}
+
+// BeforeDeleteHook is implemented by the structs which run logic before they are deleted.
+type BeforeDeleteHook interface {
+	BeforeDelete(ctx context.Context) error
+}
+
+// AfterDeleteHook is implemented by the structs which run logic after they are deleted.
+type AfterDeleteHook interface {
+	AfterDelete(ctx context.Context) error
+}
+
+func beforeCreate(ctx context.Context, v interface{}) error {
+	if h, ok := v.(BeforeCreateHook); ok {
+		return h.BeforeCreate(ctx)
+	}
+	return nil
+}
+
+func afterCreate(ctx context.Context, v interface{}) error {
+	if h, ok := v.(AfterCreateHook); ok {
+		return h.AfterCreate(ctx)
+	}
+	return nil
+}
+
+func beforeUpdate(ctx context.Context, v interface{}) error {
+	if h, ok := v.(BeforeUpdateHook); ok {
+		return h.BeforeUpdate(ctx)
+	}
+	return nil
+}
+
+func afterUpdate(ctx context.Context, v interface{}) error {
+	if h, ok := v.(AfterUpdateHook); ok {
+		return h.AfterUpdate(ctx)
+	}
+	return nil
+}
+
+func beforeDelete(ctx context.Context, v interface{}) error {
+	if h, ok := v.(BeforeDeleteHook); ok {
+		return h.BeforeDelete(ctx)
+	}
+	return nil
+}
+
+func afterDelete(ctx context.Context, v interface{}) error {
+	if h, ok := v.(AfterDeleteHook); ok {
+		return h.AfterDelete(ctx)
+	}
+	return nil
+}
+
+// translateError converts sql.ErrNoRows and the SQLSTATE of the constraint
+// violations into NotFoundError and ConstraintError. The other errors are
+// returned as they are.
+func translateError(table string, err error) error {
+	if err == sql.ErrNoRows {
+		return &NotFoundError{Table: table, Err: err}
+	}
+	pqErr, ok := err.(*pq.Error)
+	if !ok {
+		return err
+	}
+	var kind error
+	switch pqErr.Code {
+	case "23505":
+		kind = ErrUniqueViolation
+	case "23503":
+		kind = ErrForeignKeyViolation
+	case "23502":
+		kind = ErrNotNullViolation
+	case "23514":
+		kind = ErrCheckViolation
+	case "23P01":
+		kind = ErrExclusionViolation
+	default:
+		return err
+	}
+	return &ConstraintError{
+		Kind:       kind,
+		Table:      pqErr.Table,
+		Column:     pqErr.Column,
+		Constraint: pqErr.Constraint,
+		Err:        err,
+	}
+}
+`
+
+	assert.Equal(expected, string(src))
+}
+
+func TestPgCreateStructWithAutoGenKey(t *testing.T) {
+	conn, cleanup := testPgSetup(t)
+	defer cleanup()
+	assert := assert.New(t)
+
+	schema := "public"
+	src, err := PgCreateStruct(conn, schema, "", "mypkg", "", []string{}, []string{}, []string{"smallserial", "serial", "bigserial", "autogenuuid", "integer"}, []string{}, "", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `// Code generated by dgw. DO NOT EDIT.
+
+package mypkg
+
+// T1 represents public.t1
+type T1 struct {
+	ID          int64          // id
+	I           int            // i
+	Str         string         // str
+	NullableStr sql.NullString // nullable_str
+	TWithTz     time.Time      // t_with_tz
+	TWithoutTz  time.Time      // t_without_tz
+	Tm          *time.Time     // tm
+}
+// Create inserts the T1 to the database.
+//
+// Deprecated: Use CreateContext instead.
+func (r *T1) Create(db Queryer) error {
+	return r.CreateContext(context.Background(), db)
+}
+
+// GetT1ByPk select the T1 from the database.
+//
+// Deprecated: Use GetT1ByPkContext instead.
+func GetT1ByPk(db Queryer, pk0 int64) (*T1, error) {
+	return GetT1ByPkContext(context.Background(), db, pk0)
+}
+
+// Validate checks the T1 against the constraints of t1 which can be checked
+// without the database, and returns *ValidationError listing every failing field.
+func (r *T1) Validate() error {
+	var errs []FieldError
+	if r.Str == "" {
+		errs = append(errs, FieldError{Field: "Str", Column: "str", Message: "must not be empty"})
+	}
+	if len(errs) > 0 {
+		return &ValidationError{Table: "t1", Fields: errs}
+	}
+	return nil
+}
+
+// CreateContext inserts the T1 to the database.
+func (r *T1) CreateContext(ctx context.Context, db Queryer) error {
+	if err := beforeCreate(ctx, r); err != nil {
+		return err
+	}
+	err := db.QueryRowContext(ctx,
+		` + "`INSERT INTO t1 (i, str, nullable_str, t_with_tz, t_without_tz, tm) VALUES ($1, $2, $3, $4, $5, $6) RETURNING id`" + `,
+		&r.I, &r.Str, &r.NullableStr, &r.TWithTz, &r.TWithoutTz, &r.Tm).Scan(&r.ID)
+	if err != nil {
+		return errors.WithStack(translateError("t1", err))
+	}
+	return afterCreate(ctx, r)
+}
+
+// CreateOnConflictDoNothing inserts the T1 to the database.
+// If a conflict occurs (e.g., unique constraint violation), the insert is skipped without error.
+// Returns true if the row was inserted, false if it was skipped due to conflict.
+func (r *T1) CreateOnConflictDoNothing(ctx context.Context, db Queryer) (bool, error) {
+	if err := beforeCreate(ctx, r); err != nil {
+		return false, err
+	}
+	err := db.QueryRowContext(ctx,
+		` + "`INSERT INTO t1 (i, str, nullable_str, t_with_tz, t_without_tz, tm) VALUES ($1, $2, $3, $4, $5, $6) ON CONFLICT DO NOTHING RETURNING id`" + `,
+		&r.I, &r.Str, &r.NullableStr, &r.TWithTz, &r.TWithoutTz, &r.Tm).Scan(&r.ID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return false, nil
+		}
+		return false, errors.WithStack(translateError("t1", err))
+	}
+	// Row was successfully inserted
+	return true, afterCreate(ctx, r)
+}
+
+// UpdateContext updates the T1 in the database by the primary key.
+// *NotFoundError is returned if the row is not found.
+func (r *T1) UpdateContext(ctx context.Context, db Queryer) error {
+	if err := beforeUpdate(ctx, r); err != nil {
+		return err
+	}
+	result, err := db.ExecContext(ctx,
+		` + "`UPDATE t1 SET i = $1, str = $2, nullable_str = $3, t_with_tz = $4, t_without_tz = $5, tm = $6 WHERE id = $7`" + `,
+		&r.I, &r.Str, &r.NullableStr, &r.TWithTz, &r.TWithoutTz, &r.Tm, &r.ID)
+	if err != nil {
+		return errors.WithStack(translateError("t1", err))
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return errors.WithStack(translateError("t1", err))
+	}
+	if n == 0 {
+		err = sql.ErrNoRows
+		return errors.WithStack(translateError("t1", err))
+	}
+	return afterUpdate(ctx, r)
+}
+
+// DeleteContext deletes the T1 from the database by the primary key.
+// *NotFoundError is returned if the row is not found.
+func (r *T1) DeleteContext(ctx context.Context, db Queryer) error {
+	if err := beforeDelete(ctx, r); err != nil {
+		return err
+	}
+	result, err := db.ExecContext(ctx,
+		` + "`DELETE FROM t1 WHERE id = $1`" + `,
+		&r.ID)
+	if err != nil {
+		return errors.WithStack(translateError("t1", err))
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return errors.WithStack(translateError("t1", err))
+	}
+	if n == 0 {
+		err = sql.ErrNoRows
+		return errors.WithStack(translateError("t1", err))
+	}
+	return afterDelete(ctx, r)
+}
+
+// ReloadContext selects the T1 again by its primary key, and overwrites the fields with the
+// values in the database, e.g. after a trigger or a stored procedure changed the row.
+// *NotFoundError is returned if the row is not found.
+func (r *T1) ReloadContext(ctx context.Context, db Queryer) error {
+	err := db.QueryRowContext(ctx,
+		` + "`SELECT id, i, str, nullable_str, t_with_tz, t_without_tz, tm FROM t1 WHERE id = $1`" + `,
+		r.ID).Scan(&r.ID, &r.I, &r.Str, &r.NullableStr, &r.TWithTz, &r.TWithoutTz, &r.Tm)
+	if err != nil {
+		return errors.WithStack(translateError("t1", err))
+	}
+	return nil
+}
+
+// GetT1ByPkContext select the T1 from the database.
+func GetT1ByPkContext(ctx context.Context, db Queryer, pk0 int64) (*T1, error) {
+	var r T1
+	err := db.QueryRowContext(ctx,
+		` + "`SELECT id, i, str, nullable_str, t_with_tz, t_without_tz, tm FROM t1 WHERE id = $1`" + `,
+		pk0).Scan(&r.ID, &r.I, &r.Str, &r.NullableStr, &r.TWithTz, &r.TWithoutTz, &r.Tm)
+	if err != nil {
+		return nil, errors.WithStack(translateError("t1", err))
+	}
+	return &r, nil
+}
+
+// GetT1ByPkForUpdateContext selects the T1 from the database with FOR UPDATE,
+// which locks the row until the end of the transaction. opt decides what happens if the row is locked
+// by another transaction: LockWait waits for it, LockNoWait fails with SQLSTATE 55P03, and
+// LockSkipLocked returns *NotFoundError.
+func GetT1ByPkForUpdateContext(ctx context.Context, db Queryer, pk0 int64, opt LockOption) (*T1, error) {
+	var r T1
+	err := db.QueryRowContext(ctx,
+		` + "`SELECT id, i, str, nullable_str, t_with_tz, t_without_tz, tm FROM t1 WHERE id = $1 FOR UPDATE`" + `+opt.sql(),
+		pk0).Scan(&r.ID, &r.I, &r.Str, &r.NullableStr, &r.TWithTz, &r.TWithoutTz, &r.Tm)
+	if err != nil {
+		return nil, errors.WithStack(translateError("t1", err))
+	}
+	return &r, nil
+}
+
+// GetT1ByPkForShareContext selects the T1 from the database with FOR SHARE,
+// which locks the row until the end of the transaction. opt decides what happens if the row is locked
+// by another transaction: LockWait waits for it, LockNoWait fails with SQLSTATE 55P03, and
+// LockSkipLocked returns *NotFoundError.
+func GetT1ByPkForShareContext(ctx context.Context, db Queryer, pk0 int64, opt LockOption) (*T1, error) {
+	var r T1
+	err := db.QueryRowContext(ctx,
+		` + "`SELECT id, i, str, nullable_str, t_with_tz, t_without_tz, tm FROM t1 WHERE id = $1 FOR SHARE`" + `+opt.sql(),
+		pk0).Scan(&r.ID, &r.I, &r.Str, &r.NullableStr, &r.TWithTz, &r.TWithoutTz, &r.Tm)
+	if err != nil {
+		return nil, errors.WithStack(translateError("t1", err))
+	}
+	return &r, nil
+}
+
+// ExistsT1ByPkContext checks if the T1 exists in the database.
+func ExistsT1ByPkContext(ctx context.Context, db Queryer, pk0 int64) (bool, error) {
+	var exists bool
+	err := db.QueryRowContext(ctx,
+		` + "`SELECT EXISTS (SELECT 1 FROM t1 WHERE id = $1)`" + `,
+		pk0).Scan(&exists)
+	if err != nil {
+		return false, errors.WithStack(translateError("t1", err))
+	}
+	return exists, nil
+}
+
+// GetT1ByPksContext select the T1s of the given primary keys from the database.
+// The result is ordered by the primary key, and the keys which do not exist are skipped.
+func GetT1ByPksContext(ctx context.Context, db Queryer, pks []int64) ([]*T1, error) {
+	rows, err := db.QueryContext(ctx,
+		` + "`SELECT id, i, str, nullable_str, t_with_tz, t_without_tz, tm FROM t1 WHERE id = ANY($1) ORDER BY id`" + `,
+		pq.Array(pks))
+	if err != nil {
+		return nil, errors.WithStack(translateError("t1", err))
+	}
+	defer rows.Close()
+	var rs []*T1
+	for rows.Next() {
+		var r T1
+		if err := rows.Scan(&r.ID, &r.I, &r.Str, &r.NullableStr, &r.TWithTz, &r.TWithoutTz, &r.Tm); err != nil {
+			return nil, errors.WithStack(translateError("t1", err))
+		}
+		rs = append(rs, &r)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.WithStack(translateError("t1", err))
+	}
+	return rs, nil
+}
+
+// T1ListOptions is the options of ListT1.
+type T1ListOptions struct {
+	// Limit is the maximum number of rows to return. Zero means no limit.
+	Limit int
+	// After is the primary key of the last row of the previous page.
+	// Only the rows after it are returned when it is not nil.
+	After *int64
+}
+
+// ListT1 lists the T1s ordered by the primary key.
+// Pass the primary key of the last row as opts.After to get the next page.
+func ListT1(ctx context.Context, db Queryer, opts T1ListOptions) ([]*T1, error) {
+	q := ` + "`SELECT id, i, str, nullable_str, t_with_tz, t_without_tz, tm FROM t1`" + `
+	var args []interface{}
+	if opts.After != nil {
+		q += ` + "` WHERE id > $1`" + `
+		args = append(args, *opts.After)
+	}
+	q += ` + "` ORDER BY id`" + `
+	if opts.Limit > 0 {
+		args = append(args, opts.Limit)
+		q += fmt.Sprintf(` + "` LIMIT $%d`" + `, len(args))
+	}
+	rows, err := db.QueryContext(ctx, q, args...)
+	if err != nil {
+		return nil, errors.WithStack(translateError("t1", err))
+	}
+	defer rows.Close()
+	var rs []*T1
+	for rows.Next() {
+		var r T1
+		if err := rows.Scan(&r.ID, &r.I, &r.Str, &r.NullableStr, &r.TWithTz, &r.TWithoutTz, &r.Tm); err != nil {
+			return nil, errors.WithStack(translateError("t1", err))
+		}
+		rs = append(rs, &r)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.WithStack(translateError("t1", err))
+	}
+	return rs, nil
+}
+
+// T1Filter builds a parameterized WHERE clause of t1.
+// The predicates are combined with AND.
+type T1Filter struct {
+	conds []string
+	args  []interface{}
+}
+
+// NewT1Filter creates an empty T1Filter, which matches every row.
+func NewT1Filter() *T1Filter {
+	return &T1Filter{}
+}
+
+func (f *T1Filter) add(cond string, v interface{}) *T1Filter {
+	f.args = append(f.args, v)
+	f.conds = append(f.conds, fmt.Sprintf(cond, len(f.args)))
+	return f
+}
+
+// Where returns the WHERE clause and its arguments. It returns an empty clause
+// if the filter is nil or has no predicate.
+func (f *T1Filter) Where() (string, []interface{}) {
+	if f == nil || len(f.conds) == 0 {
+		return "", nil
+	}
+	return " WHERE " + strings.Join(f.conds, " AND "), f.args
+}
+
+// IDEq filters the rows whose id equals to v.
+func (f *T1Filter) IDEq(v int64) *T1Filter {
+	return f.add("id = $%d", v)
+}
+
+// IDIn filters the rows whose id is one of vs.
+func (f *T1Filter) IDIn(vs ...int64) *T1Filter {
+	return f.add("id = ANY($%d)", pq.Array(vs))
+}
+
+// IDLt filters the rows whose id is less than v.
+func (f *T1Filter) IDLt(v int64) *T1Filter {
+	return f.add("id < $%d", v)
+}
+
+// IDGt filters the rows whose id is greater than v.
+func (f *T1Filter) IDGt(v int64) *T1Filter {
+	return f.add("id > $%d", v)
+}
+
+// IEq filters the rows whose i equals to v.
+func (f *T1Filter) IEq(v int) *T1Filter {
+	return f.add("i = $%d", v)
+}
+
+// IIn filters the rows whose i is one of vs.
+func (f *T1Filter) IIn(vs ...int) *T1Filter {
+	return f.add("i = ANY($%d)", pq.Array(vs))
+}
+
+// ILt filters the rows whose i is less than v.
+func (f *T1Filter) ILt(v int) *T1Filter {
+	return f.add("i < $%d", v)
+}
+
+// IGt filters the rows whose i is greater than v.
+func (f *T1Filter) IGt(v int) *T1Filter {
+	return f.add("i > $%d", v)
+}
+
+// StrEq filters the rows whose str equals to v.
+func (f *T1Filter) StrEq(v string) *T1Filter {
+	return f.add("str = $%d", v)
+}
+
+// StrIn filters the rows whose str is one of vs.
+func (f *T1Filter) StrIn(vs ...string) *T1Filter {
+	return f.add("str = ANY($%d)", pq.Array(vs))
+}
+
+// StrLt filters the rows whose str is less than v.
+func (f *T1Filter) StrLt(v string) *T1Filter {
+	return f.add("str < $%d", v)
+}
+
+// StrGt filters the rows whose str is greater than v.
+func (f *T1Filter) StrGt(v string) *T1Filter {
+	return f.add("str > $%d", v)
+}
+
+// NullableStrEq filters the rows whose nullable_str equals to v.
+func (f *T1Filter) NullableStrEq(v sql.NullString) *T1Filter {
+	return f.add("nullable_str = $%d", v)
+}
+
+// NullableStrIn filters the rows whose nullable_str is one of vs.
+func (f *T1Filter) NullableStrIn(vs ...sql.NullString) *T1Filter {
+	return f.add("nullable_str = ANY($%d)", pq.Array(vs))
+}
+
+// NullableStrLt filters the rows whose nullable_str is less than v.
+func (f *T1Filter) NullableStrLt(v sql.NullString) *T1Filter {
+	return f.add("nullable_str < $%d", v)
+}
+
+// NullableStrGt filters the rows whose nullable_str is greater than v.
+func (f *T1Filter) NullableStrGt(v sql.NullString) *T1Filter {
+	return f.add("nullable_str > $%d", v)
+}
+
+// NullableStrIsNull filters the rows whose nullable_str is NULL.
+func (f *T1Filter) NullableStrIsNull() *T1Filter {
+	f.conds = append(f.conds, "nullable_str IS NULL")
+	return f
+}
+
+// NullableStrIsNotNull filters the rows whose nullable_str is not NULL.
+func (f *T1Filter) NullableStrIsNotNull() *T1Filter {
+	f.conds = append(f.conds, "nullable_str IS NOT NULL")
+	return f
+}
+
+// TWithTzEq filters the rows whose t_with_tz equals to v.
+func (f *T1Filter) TWithTzEq(v time.Time) *T1Filter {
+	return f.add("t_with_tz = $%d", v)
+}
+
+// TWithTzIn filters the rows whose t_with_tz is one of vs.
+func (f *T1Filter) TWithTzIn(vs ...time.Time) *T1Filter {
+	return f.add("t_with_tz = ANY($%d)", pq.Array(vs))
+}
+
+// TWithTzLt filters the rows whose t_with_tz is less than v.
+func (f *T1Filter) TWithTzLt(v time.Time) *T1Filter {
+	return f.add("t_with_tz < $%d", v)
+}
+
+// TWithTzGt filters the rows whose t_with_tz is greater than v.
+func (f *T1Filter) TWithTzGt(v time.Time) *T1Filter {
+	return f.add("t_with_tz > $%d", v)
+}
+
+// TWithoutTzEq filters the rows whose t_without_tz equals to v.
+func (f *T1Filter) TWithoutTzEq(v time.Time) *T1Filter {
+	return f.add("t_without_tz = $%d", v)
+}
+
+// TWithoutTzIn filters the rows whose t_without_tz is one of vs.
+func (f *T1Filter) TWithoutTzIn(vs ...time.Time) *T1Filter {
+	return f.add("t_without_tz = ANY($%d)", pq.Array(vs))
+}
+
+// TWithoutTzLt filters the rows whose t_without_tz is less than v.
+func (f *T1Filter) TWithoutTzLt(v time.Time) *T1Filter {
+	return f.add("t_without_tz < $%d", v)
+}
+
+// TWithoutTzGt filters the rows whose t_without_tz is greater than v.
+func (f *T1Filter) TWithoutTzGt(v time.Time) *T1Filter {
+	return f.add("t_without_tz > $%d", v)
+}
+
+// TmEq filters the rows whose tm equals to v.
+func (f *T1Filter) TmEq(v *time.Time) *T1Filter {
+	return f.add("tm = $%d", v)
+}
+
+// TmIn filters the rows whose tm is one of vs.
+func (f *T1Filter) TmIn(vs ...*time.Time) *T1Filter {
+	return f.add("tm = ANY($%d)", pq.Array(vs))
+}
+
+// TmLt filters the rows whose tm is less than v.
+func (f *T1Filter) TmLt(v *time.Time) *T1Filter {
+	return f.add("tm < $%d", v)
+}
+
+// TmGt filters the rows whose tm is greater than v.
+func (f *T1Filter) TmGt(v *time.Time) *T1Filter {
+	return f.add("tm > $%d", v)
+}
+
+// TmIsNull filters the rows whose tm is NULL.
+func (f *T1Filter) TmIsNull() *T1Filter {
+	f.conds = append(f.conds, "tm IS NULL")
+	return f
+}
+
+// TmIsNotNull filters the rows whose tm is not NULL.
+func (f *T1Filter) TmIsNotNull() *T1Filter {
+	f.conds = append(f.conds, "tm IS NOT NULL")
+	return f
+}
+
+// FindT1 selects the T1s matching the filter from the database.
+// The result is ordered by the primary key.
+func FindT1(ctx context.Context, db Queryer, f *T1Filter) ([]*T1, error) {
+	where, args := f.Where()
+	rows, err := db.QueryContext(ctx,
+		` + "`SELECT id, i, str, nullable_str, t_with_tz, t_without_tz, tm FROM t1`" + `+where+` + "` ORDER BY id`" + `,
+		args...)
+	if err != nil {
+		return nil, errors.WithStack(translateError("t1", err))
+	}
+	defer rows.Close()
+	var rs []*T1
+	for rows.Next() {
+		var r T1
+		if err := rows.Scan(&r.ID, &r.I, &r.Str, &r.NullableStr, &r.TWithTz, &r.TWithoutTz, &r.Tm); err != nil {
+			return nil, errors.WithStack(translateError("t1", err))
+		}
+		rs = append(rs, &r)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.WithStack(translateError("t1", err))
+	}
+	return rs, nil
+}
+
+// CountT1 counts the T1s matching the filter in the database.
+// Pass nil as the filter to count every row.
+func CountT1(ctx context.Context, db Queryer, f *T1Filter) (int64, error) {
+	where, args := f.Where()
+	var n int64
+	err := db.QueryRowContext(ctx,
+		` + "`SELECT count(*) FROM t1`" + `+where,
+		args...).Scan(&n)
+	if err != nil {
+		return 0, errors.WithStack(translateError("t1", err))
+	}
+	return n, nil
+}
+
+// DeleteT1Where deletes the T1s matching the filter from the database,
+// and returns the number of the deleted rows. A filter without predicate is rejected
+// to avoid deleting every row by mistake.
+func DeleteT1Where(ctx context.Context, db Queryer, f *T1Filter) (int64, error) {
+	where, args := f.Where()
+	if where == "" {
+		return 0, errors.New("refusing to delete every row of t1 with an empty filter")
+	}
+	result, err := db.ExecContext(ctx,
+		` + "`DELETE FROM t1`" + `+where,
+		args...)
+	if err != nil {
+		return 0, errors.WithStack(translateError("t1", err))
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.WithStack(translateError("t1", err))
+	}
+	return n, nil
+}
+// T2 represents public.t2
+type T2 struct {
+	ID         int64     // id
+	I          int       // i
+	Str        string    // str
+	TWithTz    time.Time // t_with_tz
+	TWithoutTz time.Time // t_without_tz
+}
+// Create inserts the T2 to the database.
+//
+// Deprecated: Use CreateContext instead.
+func (r *T2) Create(db Queryer) error {
+	return r.CreateContext(context.Background(), db)
+}
+
+// GetT2ByPk select the T2 from the database.
+//
+// Deprecated: Use GetT2ByPkContext instead.
+func GetT2ByPk(db Queryer, pk0 int64) (*T2, error) {
+	return GetT2ByPkContext(context.Background(), db, pk0)
+}
+
+// Validate checks the T2 against the constraints of t2 which can be checked
+// without the database, and returns *ValidationError listing every failing field.
+func (r *T2) Validate() error {
+	var errs []FieldError
+	if r.Str == "" {
+		errs = append(errs, FieldError{Field: "Str", Column: "str", Message: "must not be empty"})
+	}
+	if len(errs) > 0 {
+		return &ValidationError{Table: "t2", Fields: errs}
+	}
+	return nil
+}
+
+// CreateContext inserts the T2 to the database.
+func (r *T2) CreateContext(ctx context.Context, db Queryer) error {
+	if err := beforeCreate(ctx, r); err != nil {
+		return err
+	}
+	err := db.QueryRowContext(ctx,
+		` + "`INSERT INTO t2 (i, str, t_with_tz, t_without_tz) VALUES ($1, $2, $3, $4) RETURNING id`" + `,
+		&r.I, &r.Str, &r.TWithTz, &r.TWithoutTz).Scan(&r.ID)
+	if err != nil {
+		return errors.WithStack(translateError("t2", err))
+	}
+	return afterCreate(ctx, r)
+}
+
+// CreateOnConflictDoNothing inserts the T2 to the database.
+// If a conflict occurs (e.g., unique constraint violation), the insert is skipped without error.
+// Returns true if the row was inserted, false if it was skipped due to conflict.
+func (r *T2) CreateOnConflictDoNothing(ctx context.Context, db Queryer) (bool, error) {
+	if err := beforeCreate(ctx, r); err != nil {
+		return false, err
+	}
+	err := db.QueryRowContext(ctx,
+		` + "`INSERT INTO t2 (i, str, t_with_tz, t_without_tz) VALUES ($1, $2, $3, $4) ON CONFLICT DO NOTHING RETURNING id`" + `,
+		&r.I, &r.Str, &r.TWithTz, &r.TWithoutTz).Scan(&r.ID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return false, nil
+		}
+		return false, errors.WithStack(translateError("t2", err))
+	}
+	// Row was successfully inserted
+	return true, afterCreate(ctx, r)
+}
+
+// UpdateContext updates the T2 in the database by the primary key.
+// *NotFoundError is returned if the row is not found.
+func (r *T2) UpdateContext(ctx context.Context, db Queryer) error {
+	if err := beforeUpdate(ctx, r); err != nil {
+		return err
+	}
+	result, err := db.ExecContext(ctx,
+		` + "`UPDATE t2 SET i = $1, str = $2, t_with_tz = $3, t_without_tz = $4 WHERE id = $5`" + `,
+		&r.I, &r.Str, &r.TWithTz, &r.TWithoutTz, &r.ID)
+	if err != nil {
+		return errors.WithStack(translateError("t2", err))
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return errors.WithStack(translateError("t2", err))
+	}
+	if n == 0 {
+		err = sql.ErrNoRows
+		return errors.WithStack(translateError("t2", err))
+	}
+	return afterUpdate(ctx, r)
+}
+
+// DeleteContext deletes the T2 from the database by the primary key.
+// *NotFoundError is returned if the row is not found.
+func (r *T2) DeleteContext(ctx context.Context, db Queryer) error {
+	if err := beforeDelete(ctx, r); err != nil {
+		return err
+	}
+	result, err := db.ExecContext(ctx,
+		` + "`DELETE FROM t2 WHERE id = $1`" + `,
+		&r.ID)
+	if err != nil {
+		return errors.WithStack(translateError("t2", err))
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return errors.WithStack(translateError("t2", err))
+	}
+	if n == 0 {
+		err = sql.ErrNoRows
+		return errors.WithStack(translateError("t2", err))
+	}
+	return afterDelete(ctx, r)
+}
+
+// ReloadContext selects the T2 again by its primary key, and overwrites the fields with the
+// values in the database, e.g. after a trigger or a stored procedure changed the row.
+// *NotFoundError is returned if the row is not found.
+func (r *T2) ReloadContext(ctx context.Context, db Queryer) error {
+	err := db.QueryRowContext(ctx,
+		` + "`SELECT id, i, str, t_with_tz, t_without_tz FROM t2 WHERE id = $1`" + `,
+		r.ID).Scan(&r.ID, &r.I, &r.Str, &r.TWithTz, &r.TWithoutTz)
+	if err != nil {
+		return errors.WithStack(translateError("t2", err))
+	}
+	return nil
+}
+
+// GetT2ByPkContext select the T2 from the database.
+func GetT2ByPkContext(ctx context.Context, db Queryer, pk0 int64) (*T2, error) {
+	var r T2
+	err := db.QueryRowContext(ctx,
+		` + "`SELECT id, i, str, t_with_tz, t_without_tz FROM t2 WHERE id = $1`" + `,
+		pk0).Scan(&r.ID, &r.I, &r.Str, &r.TWithTz, &r.TWithoutTz)
+	if err != nil {
+		return nil, errors.WithStack(translateError("t2", err))
+	}
+	return &r, nil
+}
+
+// GetT2ByPkForUpdateContext selects the T2 from the database with FOR UPDATE,
+// which locks the row until the end of the transaction. opt decides what happens if the row is locked
+// by another transaction: LockWait waits for it, LockNoWait fails with SQLSTATE 55P03, and
+// LockSkipLocked returns *NotFoundError.
+func GetT2ByPkForUpdateContext(ctx context.Context, db Queryer, pk0 int64, opt LockOption) (*T2, error) {
+	var r T2
+	err := db.QueryRowContext(ctx,
+		` + "`SELECT id, i, str, t_with_tz, t_without_tz FROM t2 WHERE id = $1 FOR UPDATE`" + `+opt.sql(),
+		pk0).Scan(&r.ID, &r.I, &r.Str, &r.TWithTz, &r.TWithoutTz)
+	if err != nil {
+		return nil, errors.WithStack(translateError("t2", err))
+	}
+	return &r, nil
+}
+
+// GetT2ByPkForShareContext selects the T2 from the database with FOR SHARE,
+// which locks the row until the end of the transaction. opt decides what happens if the row is locked
+// by another transaction: LockWait waits for it, LockNoWait fails with SQLSTATE 55P03, and
+// LockSkipLocked returns *NotFoundError.
+func GetT2ByPkForShareContext(ctx context.Context, db Queryer, pk0 int64, opt LockOption) (*T2, error) {
+	var r T2
+	err := db.QueryRowContext(ctx,
+		` + "`SELECT id, i, str, t_with_tz, t_without_tz FROM t2 WHERE id = $1 FOR SHARE`" + `+opt.sql(),
+		pk0).Scan(&r.ID, &r.I, &r.Str, &r.TWithTz, &r.TWithoutTz)
+	if err != nil {
+		return nil, errors.WithStack(translateError("t2", err))
+	}
+	return &r, nil
+}
+
+// ExistsT2ByPkContext checks if the T2 exists in the database.
+func ExistsT2ByPkContext(ctx context.Context, db Queryer, pk0 int64) (bool, error) {
+	var exists bool
+	err := db.QueryRowContext(ctx,
+		` + "`SELECT EXISTS (SELECT 1 FROM t2 WHERE id = $1)`" + `,
+		pk0).Scan(&exists)
+	if err != nil {
+		return false, errors.WithStack(translateError("t2", err))
+	}
+	return exists, nil
+}
+
+// GetT2ByPksContext select the T2s of the given primary keys from the database.
+// The result is ordered by the primary key, and the keys which do not exist are skipped.
+func GetT2ByPksContext(ctx context.Context, db Queryer, pks []int64) ([]*T2, error) {
+	rows, err := db.QueryContext(ctx,
+		` + "`SELECT id, i, str, t_with_tz, t_without_tz FROM t2 WHERE id = ANY($1) ORDER BY id`" + `,
+		pq.Array(pks))
+	if err != nil {
+		return nil, errors.WithStack(translateError("t2", err))
+	}
+	defer rows.Close()
+	var rs []*T2
+	for rows.Next() {
+		var r T2
+		if err := rows.Scan(&r.ID, &r.I, &r.Str, &r.TWithTz, &r.TWithoutTz); err != nil {
+			return nil, errors.WithStack(translateError("t2", err))
+		}
+		rs = append(rs, &r)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.WithStack(translateError("t2", err))
+	}
+	return rs, nil
+}
+
+// T2ListOptions is the options of ListT2.
+type T2ListOptions struct {
+	// Limit is the maximum number of rows to return. Zero means no limit.
+	Limit int
+	// After is the primary key of the last row of the previous page.
+	// Only the rows after it are returned when it is not nil.
+	After *int64
+}
+
+// ListT2 lists the T2s ordered by the primary key.
+// Pass the primary key of the last row as opts.After to get the next page.
+func ListT2(ctx context.Context, db Queryer, opts T2ListOptions) ([]*T2, error) {
+	q := ` + "`SELECT id, i, str, t_with_tz, t_without_tz FROM t2`" + `
+	var args []interface{}
+	if opts.After != nil {
+		q += ` + "` WHERE id > $1`" + `
+		args = append(args, *opts.After)
+	}
+	q += ` + "` ORDER BY id`" + `
+	if opts.Limit > 0 {
+		args = append(args, opts.Limit)
+		q += fmt.Sprintf(` + "` LIMIT $%d`" + `, len(args))
+	}
+	rows, err := db.QueryContext(ctx, q, args...)
+	if err != nil {
+		return nil, errors.WithStack(translateError("t2", err))
+	}
+	defer rows.Close()
+	var rs []*T2
+	for rows.Next() {
+		var r T2
+		if err := rows.Scan(&r.ID, &r.I, &r.Str, &r.TWithTz, &r.TWithoutTz); err != nil {
+			return nil, errors.WithStack(translateError("t2", err))
+		}
+		rs = append(rs, &r)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.WithStack(translateError("t2", err))
+	}
+	return rs, nil
+}
+
+// T2Filter builds a parameterized WHERE clause of t2.
+// The predicates are combined with AND.
+type T2Filter struct {
+	conds []string
+	args  []interface{}
+}
+
+// NewT2Filter creates an empty T2Filter, which matches every row.
+func NewT2Filter() *T2Filter {
+	return &T2Filter{}
+}
+
+func (f *T2Filter) add(cond string, v interface{}) *T2Filter {
+	f.args = append(f.args, v)
+	f.conds = append(f.conds, fmt.Sprintf(cond, len(f.args)))
+	return f
+}
+
+// Where returns the WHERE clause and its arguments. It returns an empty clause
+// if the filter is nil or has no predicate.
+func (f *T2Filter) Where() (string, []interface{}) {
+	if f == nil || len(f.conds) == 0 {
+		return "", nil
+	}
+	return " WHERE " + strings.Join(f.conds, " AND "), f.args
+}
+
+// IDEq filters the rows whose id equals to v.
+func (f *T2Filter) IDEq(v int64) *T2Filter {
+	return f.add("id = $%d", v)
+}
+
+// IDIn filters the rows whose id is one of vs.
+func (f *T2Filter) IDIn(vs ...int64) *T2Filter {
+	return f.add("id = ANY($%d)", pq.Array(vs))
+}
+
+// IDLt filters the rows whose id is less than v.
+func (f *T2Filter) IDLt(v int64) *T2Filter {
+	return f.add("id < $%d", v)
+}
+
+// IDGt filters the rows whose id is greater than v.
+func (f *T2Filter) IDGt(v int64) *T2Filter {
+	return f.add("id > $%d", v)
+}
+
+// IEq filters the rows whose i equals to v.
+func (f *T2Filter) IEq(v int) *T2Filter {
+	return f.add("i = $%d", v)
+}
+
+// IIn filters the rows whose i is one of vs.
+func (f *T2Filter) IIn(vs ...int) *T2Filter {
+	return f.add("i = ANY($%d)", pq.Array(vs))
+}
+
+// ILt filters the rows whose i is less than v.
+func (f *T2Filter) ILt(v int) *T2Filter {
+	return f.add("i < $%d", v)
+}
+
+// IGt filters the rows whose i is greater than v.
+func (f *T2Filter) IGt(v int) *T2Filter {
+	return f.add("i > $%d", v)
+}
+
+// StrEq filters the rows whose str equals to v.
+func (f *T2Filter) StrEq(v string) *T2Filter {
+	return f.add("str = $%d", v)
+}
+
+// StrIn filters the rows whose str is one of vs.
+func (f *T2Filter) StrIn(vs ...string) *T2Filter {
+	return f.add("str = ANY($%d)", pq.Array(vs))
+}
+
+// StrLt filters the rows whose str is less than v.
+func (f *T2Filter) StrLt(v string) *T2Filter {
+	return f.add("str < $%d", v)
+}
+
+// StrGt filters the rows whose str is greater than v.
+func (f *T2Filter) StrGt(v string) *T2Filter {
+	return f.add("str > $%d", v)
+}
+
+// TWithTzEq filters the rows whose t_with_tz equals to v.
+func (f *T2Filter) TWithTzEq(v time.Time) *T2Filter {
+	return f.add("t_with_tz = $%d", v)
+}
+
+// TWithTzIn filters the rows whose t_with_tz is one of vs.
+func (f *T2Filter) TWithTzIn(vs ...time.Time) *T2Filter {
+	return f.add("t_with_tz = ANY($%d)", pq.Array(vs))
+}
+
+// TWithTzLt filters the rows whose t_with_tz is less than v.
+func (f *T2Filter) TWithTzLt(v time.Time) *T2Filter {
+	return f.add("t_with_tz < $%d", v)
+}
+
+// TWithTzGt filters the rows whose t_with_tz is greater than v.
+func (f *T2Filter) TWithTzGt(v time.Time) *T2Filter {
+	return f.add("t_with_tz > $%d", v)
+}
+
+// TWithoutTzEq filters the rows whose t_without_tz equals to v.
+func (f *T2Filter) TWithoutTzEq(v time.Time) *T2Filter {
+	return f.add("t_without_tz = $%d", v)
+}
+
+// TWithoutTzIn filters the rows whose t_without_tz is one of vs.
+func (f *T2Filter) TWithoutTzIn(vs ...time.Time) *T2Filter {
+	return f.add("t_without_tz = ANY($%d)", pq.Array(vs))
+}
+
+// TWithoutTzLt filters the rows whose t_without_tz is less than v.
+func (f *T2Filter) TWithoutTzLt(v time.Time) *T2Filter {
+	return f.add("t_without_tz < $%d", v)
+}
+
+// TWithoutTzGt filters the rows whose t_without_tz is greater than v.
+func (f *T2Filter) TWithoutTzGt(v time.Time) *T2Filter {
+	return f.add("t_without_tz > $%d", v)
+}
+
+// FindT2 selects the T2s matching the filter from the database.
+// The result is ordered by the primary key.
+func FindT2(ctx context.Context, db Queryer, f *T2Filter) ([]*T2, error) {
+	where, args := f.Where()
+	rows, err := db.QueryContext(ctx,
+		` + "`SELECT id, i, str, t_with_tz, t_without_tz FROM t2`" + `+where+` + "` ORDER BY id`" + `,
+		args...)
+	if err != nil {
+		return nil, errors.WithStack(translateError("t2", err))
+	}
+	defer rows.Close()
+	var rs []*T2
+	for rows.Next() {
+		var r T2
+		if err := rows.Scan(&r.ID, &r.I, &r.Str, &r.TWithTz, &r.TWithoutTz); err != nil {
+			return nil, errors.WithStack(translateError("t2", err))
+		}
+		rs = append(rs, &r)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.WithStack(translateError("t2", err))
+	}
+	return rs, nil
+}
+
+// CountT2 counts the T2s matching the filter in the database.
+// Pass nil as the filter to count every row.
+func CountT2(ctx context.Context, db Queryer, f *T2Filter) (int64, error) {
+	where, args := f.Where()
+	var n int64
+	err := db.QueryRowContext(ctx,
+		` + "`SELECT count(*) FROM t2`" + `+where,
+		args...).Scan(&n)
+	if err != nil {
+		return 0, errors.WithStack(translateError("t2", err))
+	}
+	return n, nil
+}
+
+// DeleteT2Where deletes the T2s matching the filter from the database,
+// and returns the number of the deleted rows. A filter without predicate is rejected
+// to avoid deleting every row by mistake.
+func DeleteT2Where(ctx context.Context, db Queryer, f *T2Filter) (int64, error) {
+	where, args := f.Where()
+	if where == "" {
+		return 0, errors.New("refusing to delete every row of t2 with an empty filter")
+	}
+	result, err := db.ExecContext(ctx,
+		` + "`DELETE FROM t2`" + `+where,
+		args...)
+	if err != nil {
+		return 0, errors.WithStack(translateError("t2", err))
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.WithStack(translateError("t2", err))
+	}
+	return n, nil
+}
+// T3 represents public.t3
+type T3 struct {
+	ID         int64     // id
+	I          int       // i
+	Str        string    // str
+	TWithTz    time.Time // t_with_tz
+	TWithoutTz time.Time // t_without_tz
+}
+
+// T3Pk represents the primary key of public.t3
+type T3Pk struct {
+	ID int64 // id
+	I  int   // i
+}
+// Create inserts the T3 to the database.
+//
+// Deprecated: Use CreateContext instead.
+func (r *T3) Create(db Queryer) error {
+	return r.CreateContext(context.Background(), db)
+}
+
+// GetT3ByPk select the T3 from the database.
+//
+// Deprecated: Use GetT3ByPkContext instead.
+func GetT3ByPk(db Queryer, pk0 int64, pk1 int) (*T3, error) {
+	return GetT3ByPkContext(context.Background(), db, pk0, pk1)
+}
+
+// Validate checks the T3 against the constraints of t3 which can be checked
+// without the database, and returns *ValidationError listing every failing field.
+func (r *T3) Validate() error {
+	var errs []FieldError
+	if r.Str == "" {
+		errs = append(errs, FieldError{Field: "Str", Column: "str", Message: "must not be empty"})
+	}
+	if len(errs) > 0 {
+		return &ValidationError{Table: "t3", Fields: errs}
+	}
+	return nil
+}
+
+// CreateContext inserts the T3 to the database.
+func (r *T3) CreateContext(ctx context.Context, db Queryer) error {
+	if err := beforeCreate(ctx, r); err != nil {
+		return err
+	}
+	err := db.QueryRowContext(ctx,
+		` + "`INSERT INTO t3 (str, t_with_tz, t_without_tz) VALUES ($1, $2, $3) RETURNING id, i`" + `,
+		&r.Str, &r.TWithTz, &r.TWithoutTz).Scan(&r.ID, &r.I)
+	if err != nil {
+		return errors.WithStack(translateError("t3", err))
+	}
+	return afterCreate(ctx, r)
+}
+
+// CreateOnConflictDoNothing inserts the T3 to the database.
+// If a conflict occurs (e.g., unique constraint violation), the insert is skipped without error.
+// Returns true if the row was inserted, false if it was skipped due to conflict.
+func (r *T3) CreateOnConflictDoNothing(ctx context.Context, db Queryer) (bool, error) {
+	if err := beforeCreate(ctx, r); err != nil {
+		return false, err
+	}
+	err := db.QueryRowContext(ctx,
+		` + "`INSERT INTO t3 (str, t_with_tz, t_without_tz) VALUES ($1, $2, $3) ON CONFLICT DO NOTHING RETURNING id, i`" + `,
+		&r.Str, &r.TWithTz, &r.TWithoutTz).Scan(&r.ID, &r.I)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return false, nil
+		}
+		return false, errors.WithStack(translateError("t3", err))
+	}
+	// Row was successfully inserted
+	return true, afterCreate(ctx, r)
+}
+
+// UpdateContext updates the T3 in the database by the primary key.
+// *NotFoundError is returned if the row is not found.
+func (r *T3) UpdateContext(ctx context.Context, db Queryer) error {
+	if err := beforeUpdate(ctx, r); err != nil {
+		return err
+	}
+	result, err := db.ExecContext(ctx,
+		` + "`UPDATE t3 SET str = $1, t_with_tz = $2, t_without_tz = $3 WHERE id = $4 AND i = $5`" + `,
+		&r.Str, &r.TWithTz, &r.TWithoutTz, &r.ID, &r.I)
+	if err != nil {
+		return errors.WithStack(translateError("t3", err))
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return errors.WithStack(translateError("t3", err))
+	}
+	if n == 0 {
+		err = sql.ErrNoRows
+		return errors.WithStack(translateError("t3", err))
+	}
+	return afterUpdate(ctx, r)
+}
+
+// DeleteContext deletes the T3 from the database by the primary key.
+// *NotFoundError is returned if the row is not found.
+func (r *T3) DeleteContext(ctx context.Context, db Queryer) error {
+	if err := beforeDelete(ctx, r); err != nil {
+		return err
+	}
+	result, err := db.ExecContext(ctx,
+		` + "`DELETE FROM t3 WHERE id = $1 AND i = $2`" + `,
+		&r.ID, &r.I)
+	if err != nil {
+		return errors.WithStack(translateError("t3", err))
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return errors.WithStack(translateError("t3", err))
+	}
+	if n == 0 {
+		err = sql.ErrNoRows
+		return errors.WithStack(translateError("t3", err))
+	}
+	return afterDelete(ctx, r)
+}
+
+// ReloadContext selects the T3 again by its primary key, and overwrites the fields with the
+// values in the database, e.g. after a trigger or a stored procedure changed the row.
+// *NotFoundError is returned if the row is not found.
+func (r *T3) ReloadContext(ctx context.Context, db Queryer) error {
+	err := db.QueryRowContext(ctx,
+		` + "`SELECT id, i, str, t_with_tz, t_without_tz FROM t3 WHERE id = $1 AND i = $2`" + `,
+		r.ID, r.I).Scan(&r.ID, &r.I, &r.Str, &r.TWithTz, &r.TWithoutTz)
+	if err != nil {
+		return errors.WithStack(translateError("t3", err))
+	}
+	return nil
+}
+
+// GetT3ByPkContext select the T3 from the database.
+func GetT3ByPkContext(ctx context.Context, db Queryer, pk0 int64, pk1 int) (*T3, error) {
+	var r T3
+	err := db.QueryRowContext(ctx,
+		` + "`SELECT id, i, str, t_with_tz, t_without_tz FROM t3 WHERE id = $1 AND i = $2`" + `,
+		pk0, pk1).Scan(&r.ID, &r.I, &r.Str, &r.TWithTz, &r.TWithoutTz)
+	if err != nil {
+		return nil, errors.WithStack(translateError("t3", err))
+	}
+	return &r, nil
+}
+
+// GetT3ByPkForUpdateContext selects the T3 from the database with FOR UPDATE,
+// which locks the row until the end of the transaction. opt decides what happens if the row is locked
+// by another transaction: LockWait waits for it, LockNoWait fails with SQLSTATE 55P03, and
+// LockSkipLocked returns *NotFoundError.
+func GetT3ByPkForUpdateContext(ctx context.Context, db Queryer, pk0 int64, pk1 int, opt LockOption) (*T3, error) {
+	var r T3
+	err := db.QueryRowContext(ctx,
+		` + "`SELECT id, i, str, t_with_tz, t_without_tz FROM t3 WHERE id = $1 AND i = $2 FOR UPDATE`" + `+opt.sql(),
+		pk0, pk1).Scan(&r.ID, &r.I, &r.Str, &r.TWithTz, &r.TWithoutTz)
+	if err != nil {
+		return nil, errors.WithStack(translateError("t3", err))
+	}
+	return &r, nil
+}
+
+// GetT3ByPkForShareContext selects the T3 from the database with FOR SHARE,
+// which locks the row until the end of the transaction. opt decides what happens if the row is locked
+// by another transaction: LockWait waits for it, LockNoWait fails with SQLSTATE 55P03, and
+// LockSkipLocked returns *NotFoundError.
+func GetT3ByPkForShareContext(ctx context.Context, db Queryer, pk0 int64, pk1 int, opt LockOption) (*T3, error) {
+	var r T3
+	err := db.QueryRowContext(ctx,
+		` + "`SELECT id, i, str, t_with_tz, t_without_tz FROM t3 WHERE id = $1 AND i = $2 FOR SHARE`" + `+opt.sql(),
+		pk0, pk1).Scan(&r.ID, &r.I, &r.Str, &r.TWithTz, &r.TWithoutTz)
+	if err != nil {
+		return nil, errors.WithStack(translateError("t3", err))
+	}
+	return &r, nil
+}
+
+// ExistsT3ByPkContext checks if the T3 exists in the database.
+func ExistsT3ByPkContext(ctx context.Context, db Queryer, pk0 int64, pk1 int) (bool, error) {
+	var exists bool
+	err := db.QueryRowContext(ctx,
+		` + "`SELECT EXISTS (SELECT 1 FROM t3 WHERE id = $1 AND i = $2)`" + `,
+		pk0, pk1).Scan(&exists)
+	if err != nil {
+		return false, errors.WithStack(translateError("t3", err))
+	}
+	return exists, nil
+}
+
+// GetT3ByPksContext select the T3s of the given primary keys from the database.
+// The result is ordered by the primary key, and the keys which do not exist are skipped.
+func GetT3ByPksContext(ctx context.Context, db Queryer, pks []T3Pk) ([]*T3, error) {
+	if len(pks) == 0 {
+		return nil, nil
+	}
+	values := make([]string, 0, len(pks))
+	args := make([]interface{}, 0, len(pks)*2)
+	for _, pk := range pks {
+		args = append(args, pk.ID, pk.I)
+		values = append(values, fmt.Sprintf("($%d::bigint, $%d::integer)", len(args)-1, len(args)))
+	}
+	rows, err := db.QueryContext(ctx,
+		fmt.Sprintf(` + "`SELECT id, i, str, t_with_tz, t_without_tz FROM t3 WHERE (id, i) IN (VALUES %s) ORDER BY id, i`" + `, strings.Join(values, ", ")),
+		args...)
+	if err != nil {
+		return nil, errors.WithStack(translateError("t3", err))
+	}
+	defer rows.Close()
+	var rs []*T3
+	for rows.Next() {
+		var r T3
+		if err := rows.Scan(&r.ID, &r.I, &r.Str, &r.TWithTz, &r.TWithoutTz); err != nil {
+			return nil, errors.WithStack(translateError("t3", err))
+		}
+		rs = append(rs, &r)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.WithStack(translateError("t3", err))
+	}
+	return rs, nil
+}
+
+// T3ListOptions is the options of ListT3.
+type T3ListOptions struct {
+	// Limit is the maximum number of rows to return. Zero means no limit.
+	Limit int
+	// After is the primary key of the last row of the previous page.
+	// Only the rows after it are returned when it is not nil.
+	After *T3Pk
+}
+
+// ListT3 lists the T3s ordered by the primary key.
+// Pass the primary key of the last row as opts.After to get the next page.
+func ListT3(ctx context.Context, db Queryer, opts T3ListOptions) ([]*T3, error) {
+	q := ` + "`SELECT id, i, str, t_with_tz, t_without_tz FROM t3`" + `
+	var args []interface{}
+	if opts.After != nil {
+		q += ` + "` WHERE (id, i) > ($1, $2)`" + `
+		args = append(args, opts.After.ID, opts.After.I)
+	}
+	q += ` + "` ORDER BY id, i`" + `
+	if opts.Limit > 0 {
+		args = append(args, opts.Limit)
+		q += fmt.Sprintf(` + "` LIMIT $%d`" + `, len(args))
+	}
+	rows, err := db.QueryContext(ctx, q, args...)
+	if err != nil {
+		return nil, errors.WithStack(translateError("t3", err))
+	}
+	defer rows.Close()
+	var rs []*T3
+	for rows.Next() {
+		var r T3
+		if err := rows.Scan(&r.ID, &r.I, &r.Str, &r.TWithTz, &r.TWithoutTz); err != nil {
+			return nil, errors.WithStack(translateError("t3", err))
+		}
+		rs = append(rs, &r)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.WithStack(translateError("t3", err))
+	}
+	return rs, nil
+}
+
+// T3Filter builds a parameterized WHERE clause of t3.
+// The predicates are combined with AND.
+type T3Filter struct {
+	conds []string
+	args  []interface{}
+}
+
+// NewT3Filter creates an empty T3Filter, which matches every row.
+func NewT3Filter() *T3Filter {
+	return &T3Filter{}
+}
+
+func (f *T3Filter) add(cond string, v interface{}) *T3Filter {
+	f.args = append(f.args, v)
+	f.conds = append(f.conds, fmt.Sprintf(cond, len(f.args)))
+	return f
+}
+
+// Where returns the WHERE clause and its arguments. It returns an empty clause
+// if the filter is nil or has no predicate.
+func (f *T3Filter) Where() (string, []interface{}) {
+	if f == nil || len(f.conds) == 0 {
+		return "", nil
+	}
+	return " WHERE " + strings.Join(f.conds, " AND "), f.args
+}
+
+// IDEq filters the rows whose id equals to v.
+func (f *T3Filter) IDEq(v int64) *T3Filter {
+	return f.add("id = $%d", v)
+}
+
+// IDIn filters the rows whose id is one of vs.
+func (f *T3Filter) IDIn(vs ...int64) *T3Filter {
+	return f.add("id = ANY($%d)", pq.Array(vs))
+}
+
+// IDLt filters the rows whose id is less than v.
+func (f *T3Filter) IDLt(v int64) *T3Filter {
+	return f.add("id < $%d", v)
+}
+
+// IDGt filters the rows whose id is greater than v.
+func (f *T3Filter) IDGt(v int64) *T3Filter {
+	return f.add("id > $%d", v)
+}
+
+// IEq filters the rows whose i equals to v.
+func (f *T3Filter) IEq(v int) *T3Filter {
+	return f.add("i = $%d", v)
+}
+
+// IIn filters the rows whose i is one of vs.
+func (f *T3Filter) IIn(vs ...int) *T3Filter {
+	return f.add("i = ANY($%d)", pq.Array(vs))
+}
+
+// ILt filters the rows whose i is less than v.
+func (f *T3Filter) ILt(v int) *T3Filter {
+	return f.add("i < $%d", v)
+}
+
+// IGt filters the rows whose i is greater than v.
+func (f *T3Filter) IGt(v int) *T3Filter {
+	return f.add("i > $%d", v)
+}
+
+// StrEq filters the rows whose str equals to v.
+func (f *T3Filter) StrEq(v string) *T3Filter {
+	return f.add("str = $%d", v)
+}
+
+// StrIn filters the rows whose str is one of vs.
+func (f *T3Filter) StrIn(vs ...string) *T3Filter {
+	return f.add("str = ANY($%d)", pq.Array(vs))
+}
+
+// StrLt filters the rows whose str is less than v.
+func (f *T3Filter) StrLt(v string) *T3Filter {
+	return f.add("str < $%d", v)
+}
+
+// StrGt filters the rows whose str is greater than v.
+func (f *T3Filter) StrGt(v string) *T3Filter {
+	return f.add("str > $%d", v)
+}
+
+// TWithTzEq filters the rows whose t_with_tz equals to v.
+func (f *T3Filter) TWithTzEq(v time.Time) *T3Filter {
+	return f.add("t_with_tz = $%d", v)
+}
+
+// TWithTzIn filters the rows whose t_with_tz is one of vs.
+func (f *T3Filter) TWithTzIn(vs ...time.Time) *T3Filter {
+	return f.add("t_with_tz = ANY($%d)", pq.Array(vs))
+}
+
+// TWithTzLt filters the rows whose t_with_tz is less than v.
+func (f *T3Filter) TWithTzLt(v time.Time) *T3Filter {
+	return f.add("t_with_tz < $%d", v)
+}
+
+// TWithTzGt filters the rows whose t_with_tz is greater than v.
+func (f *T3Filter) TWithTzGt(v time.Time) *T3Filter {
+	return f.add("t_with_tz > $%d", v)
+}
+
+// TWithoutTzEq filters the rows whose t_without_tz equals to v.
+func (f *T3Filter) TWithoutTzEq(v time.Time) *T3Filter {
+	return f.add("t_without_tz = $%d", v)
+}
+
+// TWithoutTzIn filters the rows whose t_without_tz is one of vs.
+func (f *T3Filter) TWithoutTzIn(vs ...time.Time) *T3Filter {
+	return f.add("t_without_tz = ANY($%d)", pq.Array(vs))
+}
+
+// TWithoutTzLt filters the rows whose t_without_tz is less than v.
+func (f *T3Filter) TWithoutTzLt(v time.Time) *T3Filter {
+	return f.add("t_without_tz < $%d", v)
+}
+
+// TWithoutTzGt filters the rows whose t_without_tz is greater than v.
+func (f *T3Filter) TWithoutTzGt(v time.Time) *T3Filter {
+	return f.add("t_without_tz > $%d", v)
+}
+
+// FindT3 selects the T3s matching the filter from the database.
+// The result is ordered by the primary key.
+func FindT3(ctx context.Context, db Queryer, f *T3Filter) ([]*T3, error) {
+	where, args := f.Where()
+	rows, err := db.QueryContext(ctx,
+		` + "`SELECT id, i, str, t_with_tz, t_without_tz FROM t3`" + `+where+` + "` ORDER BY id, i`" + `,
+		args...)
+	if err != nil {
+		return nil, errors.WithStack(translateError("t3", err))
+	}
+	defer rows.Close()
+	var rs []*T3
+	for rows.Next() {
+		var r T3
+		if err := rows.Scan(&r.ID, &r.I, &r.Str, &r.TWithTz, &r.TWithoutTz); err != nil {
+			return nil, errors.WithStack(translateError("t3", err))
+		}
+		rs = append(rs, &r)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.WithStack(translateError("t3", err))
+	}
+	return rs, nil
+}
+
+// CountT3 counts the T3s matching the filter in the database.
+// Pass nil as the filter to count every row.
+func CountT3(ctx context.Context, db Queryer, f *T3Filter) (int64, error) {
+	where, args := f.Where()
+	var n int64
+	err := db.QueryRowContext(ctx,
+		` + "`SELECT count(*) FROM t3`" + `+where,
+		args...).Scan(&n)
+	if err != nil {
+		return 0, errors.WithStack(translateError("t3", err))
+	}
+	return n, nil
+}
+
+// DeleteT3Where deletes the T3s matching the filter from the database,
+// and returns the number of the deleted rows. A filter without predicate is rejected
+// to avoid deleting every row by mistake.
+func DeleteT3Where(ctx context.Context, db Queryer, f *T3Filter) (int64, error) {
+	where, args := f.Where()
+	if where == "" {
+		return 0, errors.New("refusing to delete every row of t3 with an empty filter")
+	}
+	result, err := db.ExecContext(ctx,
+		` + "`DELETE FROM t3`" + `+where,
+		args...)
+	if err != nil {
+		return 0, errors.WithStack(translateError("t3", err))
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.WithStack(translateError("t3", err))
+	}
+	return n, nil
+}
+// T4 represents public.t4
+type T4 struct {
+	ID int // id
+	I  int // i
+}
+
+// T4Pk represents the primary key of public.t4
+type T4Pk struct {
+	ID int // id
+	I  int // i
+}
+// Create inserts the T4 to the database.
+//
+// Deprecated: Use CreateContext instead.
+func (r *T4) Create(db Queryer) error {
+	return r.CreateContext(context.Background(), db)
+}
+
+// GetT4ByPk select the T4 from the database.
+//
+// Deprecated: Use GetT4ByPkContext instead.
+func GetT4ByPk(db Queryer, pk0 int, pk1 int) (*T4, error) {
+	return GetT4ByPkContext(context.Background(), db, pk0, pk1)
+}
+
+// Validate checks the T4 against the constraints of t4 which can be checked
+// without the database, and returns *ValidationError listing every failing field.
+func (r *T4) Validate() error {
+	return nil
+}
+
+// CreateContext inserts the T4 to the database.
+func (r *T4) CreateContext(ctx context.Context, db Queryer) error {
+	if err := beforeCreate(ctx, r); err != nil {
+		return err
+	}
+	err := db.QueryRowContext(ctx,
+		` + "`INSERT INTO t4 (id) VALUES (DEFAULT) RETURNING id, i`" + `,
+	).Scan(&r.ID, &r.I)
+	if err != nil {
+		return errors.WithStack(translateError("t4", err))
+	}
+	return afterCreate(ctx, r)
+}
+
+// CreateOnConflictDoNothing inserts the T4 to the database.
+// If a conflict occurs (e.g., unique constraint violation), the insert is skipped without error.
+// Returns true if the row was inserted, false if it was skipped due to conflict.
+func (r *T4) CreateOnConflictDoNothing(ctx context.Context, db Queryer) (bool, error) {
+	if err := beforeCreate(ctx, r); err != nil {
+		return false, err
+	}
+	err := db.QueryRowContext(ctx,
+		` + "`INSERT INTO t4 (id) VALUES (DEFAULT) ON CONFLICT DO NOTHING RETURNING id, i`" + `,
+	).Scan(&r.ID, &r.I)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return false, nil
+		}
+		return false, errors.WithStack(translateError("t4", err))
+	}
+	// Row was successfully inserted
+	return true, afterCreate(ctx, r)
+}
+
+// DeleteContext deletes the T4 from the database by the primary key.
+// *NotFoundError is returned if the row is not found.
+func (r *T4) DeleteContext(ctx context.Context, db Queryer) error {
+	if err := beforeDelete(ctx, r); err != nil {
+		return err
+	}
+	result, err := db.ExecContext(ctx,
+		` + "`DELETE FROM t4 WHERE id = $1 AND i = $2`" + `,
+		&r.ID, &r.I)
+	if err != nil {
+		return errors.WithStack(translateError("t4", err))
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return errors.WithStack(translateError("t4", err))
+	}
+	if n == 0 {
+		err = sql.ErrNoRows
+		return errors.WithStack(translateError("t4", err))
+	}
+	return afterDelete(ctx, r)
+}
+
+// ReloadContext selects the T4 again by its primary key, and overwrites the fields with the
+// values in the database, e.g. after a trigger or a stored procedure changed the row.
+// *NotFoundError is returned if the row is not found.
+func (r *T4) ReloadContext(ctx context.Context, db Queryer) error {
+	err := db.QueryRowContext(ctx,
+		` + "`SELECT id, i FROM t4 WHERE id = $1 AND i = $2`" + `,
+		r.ID, r.I).Scan(&r.ID, &r.I)
+	if err != nil {
+		return errors.WithStack(translateError("t4", err))
+	}
+	return nil
+}
+
+// GetT4ByPkContext select the T4 from the database.
+func GetT4ByPkContext(ctx context.Context, db Queryer, pk0 int, pk1 int) (*T4, error) {
+	var r T4
+	err := db.QueryRowContext(ctx,
+		` + "`SELECT id, i FROM t4 WHERE id = $1 AND i = $2`" + `,
+		pk0, pk1).Scan(&r.ID, &r.I)
+	if err != nil {
+		return nil, errors.WithStack(translateError("t4", err))
+	}
+	return &r, nil
+}
+
+// GetT4ByPkForUpdateContext selects the T4 from the database with FOR UPDATE,
+// which locks the row until the end of the transaction. opt decides what happens if the row is locked
+// by another transaction: LockWait waits for it, LockNoWait fails with SQLSTATE 55P03, and
+// LockSkipLocked returns *NotFoundError.
+func GetT4ByPkForUpdateContext(ctx context.Context, db Queryer, pk0 int, pk1 int, opt LockOption) (*T4, error) {
+	var r T4
+	err := db.QueryRowContext(ctx,
+		` + "`SELECT id, i FROM t4 WHERE id = $1 AND i = $2 FOR UPDATE`" + `+opt.sql(),
+		pk0, pk1).Scan(&r.ID, &r.I)
+	if err != nil {
+		return nil, errors.WithStack(translateError("t4", err))
+	}
+	return &r, nil
+}
+
+// GetT4ByPkForShareContext selects the T4 from the database with FOR SHARE,
+// which locks the row until the end of the transaction. opt decides what happens if the row is locked
+// by another transaction: LockWait waits for it, LockNoWait fails with SQLSTATE 55P03, and
+// LockSkipLocked returns *NotFoundError.
+func GetT4ByPkForShareContext(ctx context.Context, db Queryer, pk0 int, pk1 int, opt LockOption) (*T4, error) {
+	var r T4
+	err := db.QueryRowContext(ctx,
+		` + "`SELECT id, i FROM t4 WHERE id = $1 AND i = $2 FOR SHARE`" + `+opt.sql(),
+		pk0, pk1).Scan(&r.ID, &r.I)
+	if err != nil {
+		return nil, errors.WithStack(translateError("t4", err))
+	}
+	return &r, nil
+}
+
+// ExistsT4ByPkContext checks if the T4 exists in the database.
+func ExistsT4ByPkContext(ctx context.Context, db Queryer, pk0 int, pk1 int) (bool, error) {
+	var exists bool
+	err := db.QueryRowContext(ctx,
+		` + "`SELECT EXISTS (SELECT 1 FROM t4 WHERE id = $1 AND i = $2)`" + `,
+		pk0, pk1).Scan(&exists)
+	if err != nil {
+		return false, errors.WithStack(translateError("t4", err))
+	}
+	return exists, nil
+}
+
+// GetT4ByPksContext select the T4s of the given primary keys from the database.
+// The result is ordered by the primary key, and the keys which do not exist are skipped.
+func GetT4ByPksContext(ctx context.Context, db Queryer, pks []T4Pk) ([]*T4, error) {
+	if len(pks) == 0 {
+		return nil, nil
+	}
+	values := make([]string, 0, len(pks))
+	args := make([]interface{}, 0, len(pks)*2)
+	for _, pk := range pks {
+		args = append(args, pk.ID, pk.I)
+		values = append(values, fmt.Sprintf("($%d::integer, $%d::integer)", len(args)-1, len(args)))
+	}
+	rows, err := db.QueryContext(ctx,
+		fmt.Sprintf(` + "`SELECT id, i FROM t4 WHERE (id, i) IN (VALUES %s) ORDER BY id, i`" + `, strings.Join(values, ", ")),
+		args...)
+	if err != nil {
+		return nil, errors.WithStack(translateError("t4", err))
+	}
+	defer rows.Close()
+	var rs []*T4
+	for rows.Next() {
+		var r T4
+		if err := rows.Scan(&r.ID, &r.I); err != nil {
+			return nil, errors.WithStack(translateError("t4", err))
+		}
+		rs = append(rs, &r)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.WithStack(translateError("t4", err))
+	}
+	return rs, nil
+}
+
+// T4ListOptions is the options of ListT4.
+type T4ListOptions struct {
+	// Limit is the maximum number of rows to return. Zero means no limit.
+	Limit int
+	// After is the primary key of the last row of the previous page.
+	// Only the rows after it are returned when it is not nil.
+	After *T4Pk
+}
+
+// ListT4 lists the T4s ordered by the primary key.
+// Pass the primary key of the last row as opts.After to get the next page.
+func ListT4(ctx context.Context, db Queryer, opts T4ListOptions) ([]*T4, error) {
+	q := ` + "`SELECT id, i FROM t4`" + `
+	var args []interface{}
+	if opts.After != nil {
+		q += ` + "` WHERE (id, i) > ($1, $2)`" + `
+		args = append(args, opts.After.ID, opts.After.I)
+	}
+	q += ` + "` ORDER BY id, i`" + `
+	if opts.Limit > 0 {
+		args = append(args, opts.Limit)
+		q += fmt.Sprintf(` + "` LIMIT $%d`" + `, len(args))
+	}
+	rows, err := db.QueryContext(ctx, q, args...)
+	if err != nil {
+		return nil, errors.WithStack(translateError("t4", err))
+	}
+	defer rows.Close()
+	var rs []*T4
+	for rows.Next() {
+		var r T4
+		if err := rows.Scan(&r.ID, &r.I); err != nil {
+			return nil, errors.WithStack(translateError("t4", err))
+		}
+		rs = append(rs, &r)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.WithStack(translateError("t4", err))
+	}
+	return rs, nil
+}
+
+// T4Filter builds a parameterized WHERE clause of t4.
+// The predicates are combined with AND.
+type T4Filter struct {
+	conds []string
+	args  []interface{}
+}
+
+// NewT4Filter creates an empty T4Filter, which matches every row.
+func NewT4Filter() *T4Filter {
+	return &T4Filter{}
+}
+
+func (f *T4Filter) add(cond string, v interface{}) *T4Filter {
+	f.args = append(f.args, v)
+	f.conds = append(f.conds, fmt.Sprintf(cond, len(f.args)))
+	return f
+}
+
+// Where returns the WHERE clause and its arguments. It returns an empty clause
+// if the filter is nil or has no predicate.
+func (f *T4Filter) Where() (string, []interface{}) {
+	if f == nil || len(f.conds) == 0 {
+		return "", nil
+	}
+	return " WHERE " + strings.Join(f.conds, " AND "), f.args
+}
+
+// IDEq filters the rows whose id equals to v.
+func (f *T4Filter) IDEq(v int) *T4Filter {
+	return f.add("id = $%d", v)
+}
+
+// IDIn filters the rows whose id is one of vs.
+func (f *T4Filter) IDIn(vs ...int) *T4Filter {
+	return f.add("id = ANY($%d)", pq.Array(vs))
+}
+
+// IDLt filters the rows whose id is less than v.
+func (f *T4Filter) IDLt(v int) *T4Filter {
+	return f.add("id < $%d", v)
+}
+
+// IDGt filters the rows whose id is greater than v.
+func (f *T4Filter) IDGt(v int) *T4Filter {
+	return f.add("id > $%d", v)
+}
+
+// IEq filters the rows whose i equals to v.
+func (f *T4Filter) IEq(v int) *T4Filter {
+	return f.add("i = $%d", v)
+}
+
+// IIn filters the rows whose i is one of vs.
+func (f *T4Filter) IIn(vs ...int) *T4Filter {
+	return f.add("i = ANY($%d)", pq.Array(vs))
+}
+
+// ILt filters the rows whose i is less than v.
+func (f *T4Filter) ILt(v int) *T4Filter {
+	return f.add("i < $%d", v)
+}
+
+// IGt filters the rows whose i is greater than v.
+func (f *T4Filter) IGt(v int) *T4Filter {
+	return f.add("i > $%d", v)
+}
+
+// FindT4 selects the T4s matching the filter from the database.
+// The result is ordered by the primary key.
+func FindT4(ctx context.Context, db Queryer, f *T4Filter) ([]*T4, error) {
+	where, args := f.Where()
+	rows, err := db.QueryContext(ctx,
+		` + "`SELECT id, i FROM t4`" + `+where+` + "` ORDER BY id, i`" + `,
+		args...)
+	if err != nil {
+		return nil, errors.WithStack(translateError("t4", err))
+	}
+	defer rows.Close()
+	var rs []*T4
+	for rows.Next() {
+		var r T4
+		if err := rows.Scan(&r.ID, &r.I); err != nil {
+			return nil, errors.WithStack(translateError("t4", err))
+		}
+		rs = append(rs, &r)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.WithStack(translateError("t4", err))
+	}
+	return rs, nil
+}
+
+// CountT4 counts the T4s matching the filter in the database.
+// Pass nil as the filter to count every row.
+func CountT4(ctx context.Context, db Queryer, f *T4Filter) (int64, error) {
+	where, args := f.Where()
+	var n int64
+	err := db.QueryRowContext(ctx,
+		` + "`SELECT count(*) FROM t4`" + `+where,
+		args...).Scan(&n)
+	if err != nil {
+		return 0, errors.WithStack(translateError("t4", err))
+	}
+	return n, nil
+}
+
+// DeleteT4Where deletes the T4s matching the filter from the database,
+// and returns the number of the deleted rows. A filter without predicate is rejected
+// to avoid deleting every row by mistake.
+func DeleteT4Where(ctx context.Context, db Queryer, f *T4Filter) (int64, error) {
+	where, args := f.Where()
+	if where == "" {
+		return 0, errors.New("refusing to delete every row of t4 with an empty filter")
+	}
+	result, err := db.ExecContext(ctx,
+		` + "`DELETE FROM t4`" + `+where,
+		args...)
+	if err != nil {
+		return 0, errors.WithStack(translateError("t4", err))
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.WithStack(translateError("t4", err))
+	}
+	return n, nil
+}
+// T5 represents public.t5
+type T5 struct {
+	ID int // id
+	I  int // i
+}
+
+// T5Pk represents the primary key of public.t5
+type T5Pk struct {
+	ID int // id
+	I  int // i
+}
+// Create inserts the T5 to the database.
+//
+// Deprecated: Use CreateContext instead.
+func (r *T5) Create(db Queryer) error {
+	return r.CreateContext(context.Background(), db)
+}
+
+// GetT5ByPk select the T5 from the database.
+//
+// Deprecated: Use GetT5ByPkContext instead.
+func GetT5ByPk(db Queryer, pk0 int, pk1 int) (*T5, error) {
+	return GetT5ByPkContext(context.Background(), db, pk0, pk1)
+}
+
+// Validate checks the T5 against the constraints of t5 which can be checked
+// without the database, and returns *ValidationError listing every failing field.
+func (r *T5) Validate() error {
+	return nil
+}
+
+// CreateContext inserts the T5 to the database.
+func (r *T5) CreateContext(ctx context.Context, db Queryer) error {
+	if err := beforeCreate(ctx, r); err != nil {
+		return err
+	}
+	err := db.QueryRowContext(ctx,
+		` + "`INSERT INTO t5 (id) VALUES (DEFAULT) RETURNING id, i`" + `,
+	).Scan(&r.ID, &r.I)
+	if err != nil {
+		return errors.WithStack(translateError("t5", err))
+	}
+	return afterCreate(ctx, r)
+}
+
+// CreateOnConflictDoNothing inserts the T5 to the database.
+// If a conflict occurs (e.g., unique constraint violation), the insert is skipped without error.
+// Returns true if the row was inserted, false if it was skipped due to conflict.
+func (r *T5) CreateOnConflictDoNothing(ctx context.Context, db Queryer) (bool, error) {
+	if err := beforeCreate(ctx, r); err != nil {
+		return false, err
+	}
+	err := db.QueryRowContext(ctx,
+		` + "`INSERT INTO t5 (id) VALUES (DEFAULT) ON CONFLICT DO NOTHING RETURNING id, i`" + `,
+	).Scan(&r.ID, &r.I)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return false, nil
+		}
+		return false, errors.WithStack(translateError("t5", err))
+	}
+	// Row was successfully inserted
+	return true, afterCreate(ctx, r)
+}
+
+// DeleteContext deletes the T5 from the database by the primary key.
+// *NotFoundError is returned if the row is not found.
+func (r *T5) DeleteContext(ctx context.Context, db Queryer) error {
+	if err := beforeDelete(ctx, r); err != nil {
+		return err
+	}
+	result, err := db.ExecContext(ctx,
+		` + "`DELETE FROM t5 WHERE id = $1 AND i = $2`" + `,
+		&r.ID, &r.I)
+	if err != nil {
+		return errors.WithStack(translateError("t5", err))
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return errors.WithStack(translateError("t5", err))
+	}
+	if n == 0 {
+		err = sql.ErrNoRows
+		return errors.WithStack(translateError("t5", err))
+	}
+	return afterDelete(ctx, r)
+}
+
+// ReloadContext selects the T5 again by its primary key, and overwrites the fields with the
+// values in the database, e.g. after a trigger or a stored procedure changed the row.
+// *NotFoundError is returned if the row is not found.
+func (r *T5) ReloadContext(ctx context.Context, db Queryer) error {
+	err := db.QueryRowContext(ctx,
+		` + "`SELECT id, i FROM t5 WHERE id = $1 AND i = $2`" + `,
+		r.ID, r.I).Scan(&r.ID, &r.I)
+	if err != nil {
+		return errors.WithStack(translateError("t5", err))
+	}
+	return nil
+}
+
+// GetT5ByPkContext select the T5 from the database.
+func GetT5ByPkContext(ctx context.Context, db Queryer, pk0 int, pk1 int) (*T5, error) {
+	var r T5
+	err := db.QueryRowContext(ctx,
+		` + "`SELECT id, i FROM t5 WHERE id = $1 AND i = $2`" + `,
+		pk0, pk1).Scan(&r.ID, &r.I)
+	if err != nil {
+		return nil, errors.WithStack(translateError("t5", err))
+	}
+	return &r, nil
+}
+
+// GetT5ByPkForUpdateContext selects the T5 from the database with FOR UPDATE,
+// which locks the row until the end of the transaction. opt decides what happens if the row is locked
+// by another transaction: LockWait waits for it, LockNoWait fails with SQLSTATE 55P03, and
+// LockSkipLocked returns *NotFoundError.
+func GetT5ByPkForUpdateContext(ctx context.Context, db Queryer, pk0 int, pk1 int, opt LockOption) (*T5, error) {
+	var r T5
+	err := db.QueryRowContext(ctx,
+		` + "`SELECT id, i FROM t5 WHERE id = $1 AND i = $2 FOR UPDATE`" + `+opt.sql(),
+		pk0, pk1).Scan(&r.ID, &r.I)
+	if err != nil {
+		return nil, errors.WithStack(translateError("t5", err))
+	}
+	return &r, nil
+}
+
+// GetT5ByPkForShareContext selects the T5 from the database with FOR SHARE,
+// which locks the row until the end of the transaction. opt decides what happens if the row is locked
+// by another transaction: LockWait waits for it, LockNoWait fails with SQLSTATE 55P03, and
+// LockSkipLocked returns *NotFoundError.
+func GetT5ByPkForShareContext(ctx context.Context, db Queryer, pk0 int, pk1 int, opt LockOption) (*T5, error) {
+	var r T5
+	err := db.QueryRowContext(ctx,
+		` + "`SELECT id, i FROM t5 WHERE id = $1 AND i = $2 FOR SHARE`" + `+opt.sql(),
+		pk0, pk1).Scan(&r.ID, &r.I)
+	if err != nil {
+		return nil, errors.WithStack(translateError("t5", err))
+	}
+	return &r, nil
+}
+
+// ExistsT5ByPkContext checks if the T5 exists in the database.
+func ExistsT5ByPkContext(ctx context.Context, db Queryer, pk0 int, pk1 int) (bool, error) {
+	var exists bool
+	err := db.QueryRowContext(ctx,
+		` + "`SELECT EXISTS (SELECT 1 FROM t5 WHERE id = $1 AND i = $2)`" + `,
+		pk0, pk1).Scan(&exists)
+	if err != nil {
+		return false, errors.WithStack(translateError("t5", err))
+	}
+	return exists, nil
+}
+
+// GetT5ByPksContext select the T5s of the given primary keys from the database.
+// The result is ordered by the primary key, and the keys which do not exist are skipped.
+func GetT5ByPksContext(ctx context.Context, db Queryer, pks []T5Pk) ([]*T5, error) {
+	if len(pks) == 0 {
+		return nil, nil
+	}
+	values := make([]string, 0, len(pks))
+	args := make([]interface{}, 0, len(pks)*2)
+	for _, pk := range pks {
+		args = append(args, pk.ID, pk.I)
+		values = append(values, fmt.Sprintf("($%d::integer, $%d::integer)", len(args)-1, len(args)))
+	}
+	rows, err := db.QueryContext(ctx,
+		fmt.Sprintf(` + "`SELECT id, i FROM t5 WHERE (id, i) IN (VALUES %s) ORDER BY id, i`" + `, strings.Join(values, ", ")),
+		args...)
+	if err != nil {
+		return nil, errors.WithStack(translateError("t5", err))
+	}
+	defer rows.Close()
+	var rs []*T5
+	for rows.Next() {
+		var r T5
+		if err := rows.Scan(&r.ID, &r.I); err != nil {
+			return nil, errors.WithStack(translateError("t5", err))
+		}
+		rs = append(rs, &r)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.WithStack(translateError("t5", err))
+	}
+	return rs, nil
+}
+
+// T5ListOptions is the options of ListT5.
+type T5ListOptions struct {
+	// Limit is the maximum number of rows to return. Zero means no limit.
+	Limit int
+	// After is the primary key of the last row of the previous page.
+	// Only the rows after it are returned when it is not nil.
+	After *T5Pk
+}
+
+// ListT5 lists the T5s ordered by the primary key.
+// Pass the primary key of the last row as opts.After to get the next page.
+func ListT5(ctx context.Context, db Queryer, opts T5ListOptions) ([]*T5, error) {
+	q := ` + "`SELECT id, i FROM t5`" + `
+	var args []interface{}
+	if opts.After != nil {
+		q += ` + "` WHERE (id, i) > ($1, $2)`" + `
+		args = append(args, opts.After.ID, opts.After.I)
+	}
+	q += ` + "` ORDER BY id, i`" + `
+	if opts.Limit > 0 {
+		args = append(args, opts.Limit)
+		q += fmt.Sprintf(` + "` LIMIT $%d`" + `, len(args))
+	}
+	rows, err := db.QueryContext(ctx, q, args...)
+	if err != nil {
+		return nil, errors.WithStack(translateError("t5", err))
+	}
+	defer rows.Close()
+	var rs []*T5
+	for rows.Next() {
+		var r T5
+		if err := rows.Scan(&r.ID, &r.I); err != nil {
+			return nil, errors.WithStack(translateError("t5", err))
+		}
+		rs = append(rs, &r)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.WithStack(translateError("t5", err))
+	}
+	return rs, nil
+}
+
+// T5Filter builds a parameterized WHERE clause of t5.
+// The predicates are combined with AND.
+type T5Filter struct {
+	conds []string
+	args  []interface{}
+}
+
+// NewT5Filter creates an empty T5Filter, which matches every row.
+func NewT5Filter() *T5Filter {
+	return &T5Filter{}
+}
+
+func (f *T5Filter) add(cond string, v interface{}) *T5Filter {
+	f.args = append(f.args, v)
+	f.conds = append(f.conds, fmt.Sprintf(cond, len(f.args)))
+	return f
+}
+
+// Where returns the WHERE clause and its arguments. It returns an empty clause
+// if the filter is nil or has no predicate.
+func (f *T5Filter) Where() (string, []interface{}) {
+	if f == nil || len(f.conds) == 0 {
+		return "", nil
+	}
+	return " WHERE " + strings.Join(f.conds, " AND "), f.args
+}
+
+// IDEq filters the rows whose id equals to v.
+func (f *T5Filter) IDEq(v int) *T5Filter {
+	return f.add("id = $%d", v)
+}
+
+// IDIn filters the rows whose id is one of vs.
+func (f *T5Filter) IDIn(vs ...int) *T5Filter {
+	return f.add("id = ANY($%d)", pq.Array(vs))
+}
+
+// IDLt filters the rows whose id is less than v.
+func (f *T5Filter) IDLt(v int) *T5Filter {
+	return f.add("id < $%d", v)
+}
+
+// IDGt filters the rows whose id is greater than v.
+func (f *T5Filter) IDGt(v int) *T5Filter {
+	return f.add("id > $%d", v)
+}
+
+// IEq filters the rows whose i equals to v.
+func (f *T5Filter) IEq(v int) *T5Filter {
+	return f.add("i = $%d", v)
+}
+
+// IIn filters the rows whose i is one of vs.
+func (f *T5Filter) IIn(vs ...int) *T5Filter {
+	return f.add("i = ANY($%d)", pq.Array(vs))
+}
+
+// ILt filters the rows whose i is less than v.
+func (f *T5Filter) ILt(v int) *T5Filter {
+	return f.add("i < $%d", v)
+}
+
+// IGt filters the rows whose i is greater than v.
+func (f *T5Filter) IGt(v int) *T5Filter {
+	return f.add("i > $%d", v)
+}
+
+// FindT5 selects the T5s matching the filter from the database.
+// The result is ordered by the primary key.
+func FindT5(ctx context.Context, db Queryer, f *T5Filter) ([]*T5, error) {
+	where, args := f.Where()
+	rows, err := db.QueryContext(ctx,
+		` + "`SELECT id, i FROM t5`" + `+where+` + "` ORDER BY id, i`" + `,
+		args...)
+	if err != nil {
+		return nil, errors.WithStack(translateError("t5", err))
+	}
+	defer rows.Close()
+	var rs []*T5
+	for rows.Next() {
+		var r T5
+		if err := rows.Scan(&r.ID, &r.I); err != nil {
+			return nil, errors.WithStack(translateError("t5", err))
+		}
+		rs = append(rs, &r)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.WithStack(translateError("t5", err))
+	}
+	return rs, nil
+}
+
+// CountT5 counts the T5s matching the filter in the database.
+// Pass nil as the filter to count every row.
+func CountT5(ctx context.Context, db Queryer, f *T5Filter) (int64, error) {
+	where, args := f.Where()
+	var n int64
+	err := db.QueryRowContext(ctx,
+		` + "`SELECT count(*) FROM t5`" + `+where,
+		args...).Scan(&n)
+	if err != nil {
+		return 0, errors.WithStack(translateError("t5", err))
+	}
+	return n, nil
+}
+
+// DeleteT5Where deletes the T5s matching the filter from the database,
+// and returns the number of the deleted rows. A filter without predicate is rejected
+// to avoid deleting every row by mistake.
+func DeleteT5Where(ctx context.Context, db Queryer, f *T5Filter) (int64, error) {
+	where, args := f.Where()
+	if where == "" {
+		return 0, errors.New("refusing to delete every row of t5 with an empty filter")
+	}
+	result, err := db.ExecContext(ctx,
+		` + "`DELETE FROM t5`" + `+where,
+		args...)
+	if err != nil {
+		return 0, errors.WithStack(translateError("t5", err))
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.WithStack(translateError("t5", err))
+	}
+	return n, nil
+}
+// T6 represents public.t6
+type T6 struct {
+	ID int // id
+	I  int // i
+}
+
+// T6Pk represents the primary key of public.t6
+type T6Pk struct {
+	ID int // id
+	I  int // i
+}
+// Create inserts the T6 to the database.
+//
+// Deprecated: Use CreateContext instead.
+func (r *T6) Create(db Queryer) error {
+	return r.CreateContext(context.Background(), db)
+}
+
+// GetT6ByPk select the T6 from the database.
+//
+// Deprecated: Use GetT6ByPkContext instead.
+func GetT6ByPk(db Queryer, pk0 int, pk1 int) (*T6, error) {
+	return GetT6ByPkContext(context.Background(), db, pk0, pk1)
+}
+
+// Validate checks the T6 against the constraints of t6 which can be checked
+// without the database, and returns *ValidationError listing every failing field.
+func (r *T6) Validate() error {
+	return nil
+}
+
+// CreateContext inserts the T6 to the database.
+func (r *T6) CreateContext(ctx context.Context, db Queryer) error {
+	if err := beforeCreate(ctx, r); err != nil {
+		return err
+	}
+	err := db.QueryRowContext(ctx,
+		` + "`INSERT INTO t6 (id) VALUES (DEFAULT) RETURNING id, i`" + `,
+	).Scan(&r.ID, &r.I)
+	if err != nil {
+		return errors.WithStack(translateError("t6", err))
+	}
+	return afterCreate(ctx, r)
+}
+
+// CreateOnConflictDoNothing inserts the T6 to the database.
+// If a conflict occurs (e.g., unique constraint violation), the insert is skipped without error.
+// Returns true if the row was inserted, false if it was skipped due to conflict.
+func (r *T6) CreateOnConflictDoNothing(ctx context.Context, db Queryer) (bool, error) {
+	if err := beforeCreate(ctx, r); err != nil {
+		return false, err
+	}
+	err := db.QueryRowContext(ctx,
+		` + "`INSERT INTO t6 (id) VALUES (DEFAULT) ON CONFLICT DO NOTHING RETURNING id, i`" + `,
+	).Scan(&r.ID, &r.I)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return false, nil
+		}
+		return false, errors.WithStack(translateError("t6", err))
+	}
+	// Row was successfully inserted
+	return true, afterCreate(ctx, r)
+}
+
+// DeleteContext deletes the T6 from the database by the primary key.
+// *NotFoundError is returned if the row is not found.
+func (r *T6) DeleteContext(ctx context.Context, db Queryer) error {
+	if err := beforeDelete(ctx, r); err != nil {
+		return err
+	}
+	result, err := db.ExecContext(ctx,
+		` + "`DELETE FROM t6 WHERE id = $1 AND i = $2`" + `,
+		&r.ID, &r.I)
+	if err != nil {
+		return errors.WithStack(translateError("t6", err))
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return errors.WithStack(translateError("t6", err))
+	}
+	if n == 0 {
+		err = sql.ErrNoRows
+		return errors.WithStack(translateError("t6", err))
+	}
+	return afterDelete(ctx, r)
+}
+
+// ReloadContext selects the T6 again by its primary key, and overwrites the fields with the
+// values in the database, e.g. after a trigger or a stored procedure changed the row.
+// *NotFoundError is returned if the row is not found.
+func (r *T6) ReloadContext(ctx context.Context, db Queryer) error {
+	err := db.QueryRowContext(ctx,
+		` + "`SELECT id, i FROM t6 WHERE id = $1 AND i = $2`" + `,
+		r.ID, r.I).Scan(&r.ID, &r.I)
+	if err != nil {
+		return errors.WithStack(translateError("t6", err))
+	}
+	return nil
+}
+
+// GetT6ByPkContext select the T6 from the database.
+func GetT6ByPkContext(ctx context.Context, db Queryer, pk0 int, pk1 int) (*T6, error) {
+	var r T6
+	err := db.QueryRowContext(ctx,
+		` + "`SELECT id, i FROM t6 WHERE id = $1 AND i = $2`" + `,
+		pk0, pk1).Scan(&r.ID, &r.I)
+	if err != nil {
+		return nil, errors.WithStack(translateError("t6", err))
+	}
+	return &r, nil
+}
+
+// GetT6ByPkForUpdateContext selects the T6 from the database with FOR UPDATE,
+// which locks the row until the end of the transaction. opt decides what happens if the row is locked
+// by another transaction: LockWait waits for it, LockNoWait fails with SQLSTATE 55P03, and
+// LockSkipLocked returns *NotFoundError.
+func GetT6ByPkForUpdateContext(ctx context.Context, db Queryer, pk0 int, pk1 int, opt LockOption) (*T6, error) {
+	var r T6
+	err := db.QueryRowContext(ctx,
+		` + "`SELECT id, i FROM t6 WHERE id = $1 AND i = $2 FOR UPDATE`" + `+opt.sql(),
+		pk0, pk1).Scan(&r.ID, &r.I)
+	if err != nil {
+		return nil, errors.WithStack(translateError("t6", err))
+	}
+	return &r, nil
+}
+
+// GetT6ByPkForShareContext selects the T6 from the database with FOR SHARE,
+// which locks the row until the end of the transaction. opt decides what happens if the row is locked
+// by another transaction: LockWait waits for it, LockNoWait fails with SQLSTATE 55P03, and
+// LockSkipLocked returns *NotFoundError.
+func GetT6ByPkForShareContext(ctx context.Context, db Queryer, pk0 int, pk1 int, opt LockOption) (*T6, error) {
+	var r T6
+	err := db.QueryRowContext(ctx,
+		` + "`SELECT id, i FROM t6 WHERE id = $1 AND i = $2 FOR SHARE`" + `+opt.sql(),
+		pk0, pk1).Scan(&r.ID, &r.I)
+	if err != nil {
+		return nil, errors.WithStack(translateError("t6", err))
+	}
+	return &r, nil
+}
+
+// ExistsT6ByPkContext checks if the T6 exists in the database.
+func ExistsT6ByPkContext(ctx context.Context, db Queryer, pk0 int, pk1 int) (bool, error) {
+	var exists bool
+	err := db.QueryRowContext(ctx,
+		` + "`SELECT EXISTS (SELECT 1 FROM t6 WHERE id = $1 AND i = $2)`" + `,
+		pk0, pk1).Scan(&exists)
+	if err != nil {
+		return false, errors.WithStack(translateError("t6", err))
+	}
+	return exists, nil
+}
+
+// GetT6ByPksContext select the T6s of the given primary keys from the database.
+// The result is ordered by the primary key, and the keys which do not exist are skipped.
+func GetT6ByPksContext(ctx context.Context, db Queryer, pks []T6Pk) ([]*T6, error) {
+	if len(pks) == 0 {
+		return nil, nil
+	}
+	values := make([]string, 0, len(pks))
+	args := make([]interface{}, 0, len(pks)*2)
+	for _, pk := range pks {
+		args = append(args, pk.ID, pk.I)
+		values = append(values, fmt.Sprintf("($%d::integer, $%d::integer)", len(args)-1, len(args)))
+	}
+	rows, err := db.QueryContext(ctx,
+		fmt.Sprintf(` + "`SELECT id, i FROM t6 WHERE (id, i) IN (VALUES %s) ORDER BY id, i`" + `, strings.Join(values, ", ")),
+		args...)
+	if err != nil {
+		return nil, errors.WithStack(translateError("t6", err))
+	}
+	defer rows.Close()
+	var rs []*T6
+	for rows.Next() {
+		var r T6
+		if err := rows.Scan(&r.ID, &r.I); err != nil {
+			return nil, errors.WithStack(translateError("t6", err))
+		}
+		rs = append(rs, &r)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.WithStack(translateError("t6", err))
+	}
+	return rs, nil
+}
+
+// T6ListOptions is the options of ListT6.
+type T6ListOptions struct {
+	// Limit is the maximum number of rows to return. Zero means no limit.
+	Limit int
+	// After is the primary key of the last row of the previous page.
+	// Only the rows after it are returned when it is not nil.
+	After *T6Pk
+}
+
+// ListT6 lists the T6s ordered by the primary key.
+// Pass the primary key of the last row as opts.After to get the next page.
+func ListT6(ctx context.Context, db Queryer, opts T6ListOptions) ([]*T6, error) {
+	q := ` + "`SELECT id, i FROM t6`" + `
+	var args []interface{}
+	if opts.After != nil {
+		q += ` + "` WHERE (id, i) > ($1, $2)`" + `
+		args = append(args, opts.After.ID, opts.After.I)
+	}
+	q += ` + "` ORDER BY id, i`" + `
+	if opts.Limit > 0 {
+		args = append(args, opts.Limit)
+		q += fmt.Sprintf(` + "` LIMIT $%d`" + `, len(args))
+	}
+	rows, err := db.QueryContext(ctx, q, args...)
+	if err != nil {
+		return nil, errors.WithStack(translateError("t6", err))
+	}
+	defer rows.Close()
+	var rs []*T6
+	for rows.Next() {
+		var r T6
+		if err := rows.Scan(&r.ID, &r.I); err != nil {
+			return nil, errors.WithStack(translateError("t6", err))
+		}
+		rs = append(rs, &r)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.WithStack(translateError("t6", err))
+	}
+	return rs, nil
+}
+
+// T6Filter builds a parameterized WHERE clause of t6.
+// The predicates are combined with AND.
+type T6Filter struct {
+	conds []string
+	args  []interface{}
+}
+
+// NewT6Filter creates an empty T6Filter, which matches every row.
+func NewT6Filter() *T6Filter {
+	return &T6Filter{}
+}
+
+func (f *T6Filter) add(cond string, v interface{}) *T6Filter {
+	f.args = append(f.args, v)
+	f.conds = append(f.conds, fmt.Sprintf(cond, len(f.args)))
+	return f
+}
+
+// Where returns the WHERE clause and its arguments. It returns an empty clause
+// if the filter is nil or has no predicate.
+func (f *T6Filter) Where() (string, []interface{}) {
+	if f == nil || len(f.conds) == 0 {
+		return "", nil
+	}
+	return " WHERE " + strings.Join(f.conds, " AND "), f.args
+}
+
+// IDEq filters the rows whose id equals to v.
+func (f *T6Filter) IDEq(v int) *T6Filter {
+	return f.add("id = $%d", v)
+}
+
+// IDIn filters the rows whose id is one of vs.
+func (f *T6Filter) IDIn(vs ...int) *T6Filter {
+	return f.add("id = ANY($%d)", pq.Array(vs))
+}
+
+// IDLt filters the rows whose id is less than v.
+func (f *T6Filter) IDLt(v int) *T6Filter {
+	return f.add("id < $%d", v)
+}
+
+// IDGt filters the rows whose id is greater than v.
+func (f *T6Filter) IDGt(v int) *T6Filter {
+	return f.add("id > $%d", v)
+}
+
+// IEq filters the rows whose i equals to v.
+func (f *T6Filter) IEq(v int) *T6Filter {
+	return f.add("i = $%d", v)
+}
+
+// IIn filters the rows whose i is one of vs.
+func (f *T6Filter) IIn(vs ...int) *T6Filter {
+	return f.add("i = ANY($%d)", pq.Array(vs))
+}
+
+// ILt filters the rows whose i is less than v.
+func (f *T6Filter) ILt(v int) *T6Filter {
+	return f.add("i < $%d", v)
+}
+
+// IGt filters the rows whose i is greater than v.
+func (f *T6Filter) IGt(v int) *T6Filter {
+	return f.add("i > $%d", v)
+}
+
+// FindT6 selects the T6s matching the filter from the database.
+// The result is ordered by the primary key.
+func FindT6(ctx context.Context, db Queryer, f *T6Filter) ([]*T6, error) {
+	where, args := f.Where()
+	rows, err := db.QueryContext(ctx,
+		` + "`SELECT id, i FROM t6`" + `+where+` + "` ORDER BY id, i`" + `,
+		args...)
+	if err != nil {
+		return nil, errors.WithStack(translateError("t6", err))
+	}
+	defer rows.Close()
+	var rs []*T6
+	for rows.Next() {
+		var r T6
+		if err := rows.Scan(&r.ID, &r.I); err != nil {
+			return nil, errors.WithStack(translateError("t6", err))
+		}
+		rs = append(rs, &r)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.WithStack(translateError("t6", err))
+	}
+	return rs, nil
+}
+
+// CountT6 counts the T6s matching the filter in the database.
+// Pass nil as the filter to count every row.
+func CountT6(ctx context.Context, db Queryer, f *T6Filter) (int64, error) {
+	where, args := f.Where()
+	var n int64
+	err := db.QueryRowContext(ctx,
+		` + "`SELECT count(*) FROM t6`" + `+where,
+		args...).Scan(&n)
+	if err != nil {
+		return 0, errors.WithStack(translateError("t6", err))
+	}
+	return n, nil
+}
+
+// DeleteT6Where deletes the T6s matching the filter from the database,
+// and returns the number of the deleted rows. A filter without predicate is rejected
+// to avoid deleting every row by mistake.
+func DeleteT6Where(ctx context.Context, db Queryer, f *T6Filter) (int64, error) {
+	where, args := f.Where()
+	if where == "" {
+		return 0, errors.New("refusing to delete every row of t6 with an empty filter")
+	}
+	result, err := db.ExecContext(ctx,
+		` + "`DELETE FROM t6`" + `+where,
+		args...)
+	if err != nil {
+		return 0, errors.WithStack(translateError("t6", err))
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.WithStack(translateError("t6", err))
+	}
+	return n, nil
+}
+
+var (
+	// ErrNotFound is matched by errors.Is when no row is found.
+	ErrNotFound = errors.New("not found")
+	// ErrUniqueViolation is matched by errors.Is on a unique constraint violation.
+	ErrUniqueViolation = errors.New("unique violation")
+	// ErrForeignKeyViolation is matched by errors.Is on a foreign key constraint violation.
+	ErrForeignKeyViolation = errors.New("foreign key violation")
+	// ErrNotNullViolation is matched by errors.Is on a not-null constraint violation.
+	ErrNotNullViolation = errors.New("not null violation")
+	// ErrCheckViolation is matched by errors.Is on a check constraint violation.
+	ErrCheckViolation = errors.New("check violation")
+	// ErrExclusionViolation is matched by errors.Is on an exclusion constraint violation.
+	ErrExclusionViolation = errors.New("exclusion violation")
+	// ErrValidation is matched by errors.Is when Validate fails.
+	ErrValidation = errors.New("validation failed")
+)
+
+// NotFoundError is returned when no row is found in the table.
+type NotFoundError struct {
+	Table string
+	Err   error
+}
+
+func (e *NotFoundError) Error() string { return e.Err.Error() }
+
+// Is reports whether the target is ErrNotFound.
+func (e *NotFoundError) Is(target error) bool { return target == ErrNotFound }
+
+// Unwrap returns the original error, which is sql.ErrNoRows.
+func (e *NotFoundError) Unwrap() error { return e.Err }
+
+// Cause returns the original error for errors.Cause of github.com/pkg/errors.
+func (e *NotFoundError) Cause() error { return e.Err }
+
+// ConstraintError is returned when a statement violates a constraint.
+// Kind is one of ErrUniqueViolation, ErrForeignKeyViolation, ErrNotNullViolation,
+// ErrCheckViolation and ErrExclusionViolation.
+type ConstraintError struct {
+	Kind       error
+	Table      string
+	Column     string
+	Constraint string
+	Err        error
+}
+
+func (e *ConstraintError) Error() string { return e.Err.Error() }
+
+// Is reports whether the target is the Kind of the violation.
+func (e *ConstraintError) Is(target error) bool { return target == e.Kind }
+
+// Unwrap returns the original error, which is *pq.Error.
+func (e *ConstraintError) Unwrap() error { return e.Err }
+
+// Cause returns the original error for errors.Cause of github.com/pkg/errors.
+func (e *ConstraintError) Cause() error { return e.Err }
+
+// FieldError is a field which fails Validate. Constraint is the name of the
+// check constraint, or empty for NOT NULL and the length of the column.
+type FieldError struct {
+	Field      string
+	Column     string
+	Constraint string
+	Message    string
+}
+
+// ValidationError is returned by Validate, listing every failing field.
+type ValidationError struct {
+	Table  string
+	Fields []FieldError
+}
+
+func (e *ValidationError) Error() string {
+	msgs := make([]string, 0, len(e.Fields))
+	for _, f := range e.Fields {
+		msgs = append(msgs, f.Column+" "+f.Message)
+	}
+	return "invalid " + e.Table + ": " + strings.Join(msgs, ", ")
+}
+
+// Is reports whether the target is ErrValidation.
+func (e *ValidationError) Is(target error) bool { return target == ErrValidation }
+
+// LockOption decides what the row lock of GetXByPkForUpdateContext and
+// GetXByPkForShareContext does when the row is locked by another transaction.
+type LockOption int
+
+const (
+	// LockWait waits for the other transaction to end.
+	LockWait LockOption = iota
+	// LockNoWait fails immediately with SQLSTATE 55P03, lock_not_available.
+	LockNoWait
+	// LockSkipLocked skips the row, which is reported as not found.
+	LockSkipLocked
+)
+
+func (o LockOption) sql() string {
+	switch o {
+	case LockNoWait:
+		return " NOWAIT"
+	case LockSkipLocked:
+		return " SKIP LOCKED"
+	}
+	return ""
+}
+
+// BeforeCreateHook is implemented by the structs which run logic before they are inserted.
+type BeforeCreateHook interface {
+	BeforeCreate(ctx context.Context) error
+}
+
+// AfterCreateHook is implemented by the structs which run logic after they are inserted.
+type AfterCreateHook interface {
+	AfterCreate(ctx context.Context) error
+}
+
+// BeforeUpdateHook is implemented by the structs which run logic before they are updated.
+type BeforeUpdateHook interface {
+	BeforeUpdate(ctx context.Context) error
+}
+
+// AfterUpdateHook is implemented by the structs which run logic after they are updated.
+type AfterUpdateHook interface {
+	AfterUpdate(ctx context.Context) error
+}
+
+// BeforeDeleteHook is implemented by the structs which run logic before they are deleted.
+type BeforeDeleteHook interface {
+	BeforeDelete(ctx context.Context) error
+}
+
+// AfterDeleteHook is implemented by the structs which run logic after they are deleted.
+type AfterDeleteHook interface {
+	AfterDelete(ctx context.Context) error
+}
+
+func beforeCreate(ctx context.Context, v interface{}) error {
+	if h, ok := v.(BeforeCreateHook); ok {
+		return h.BeforeCreate(ctx)
+	}
+	return nil
+}
+
+func afterCreate(ctx context.Context, v interface{}) error {
+	if h, ok := v.(AfterCreateHook); ok {
+		return h.AfterCreate(ctx)
+	}
+	return nil
+}
+
+func beforeUpdate(ctx context.Context, v interface{}) error {
+	if h, ok := v.(BeforeUpdateHook); ok {
+		return h.BeforeUpdate(ctx)
+	}
+	return nil
+}
+
+func afterUpdate(ctx context.Context, v interface{}) error {
+	if h, ok := v.(AfterUpdateHook); ok {
+		return h.AfterUpdate(ctx)
+	}
+	return nil
+}
+
+func beforeDelete(ctx context.Context, v interface{}) error {
+	if h, ok := v.(BeforeDeleteHook); ok {
+		return h.BeforeDelete(ctx)
+	}
+	return nil
+}
+
+func afterDelete(ctx context.Context, v interface{}) error {
+	if h, ok := v.(AfterDeleteHook); ok {
+		return h.AfterDelete(ctx)
+	}
+	return nil
+}
+
+// translateError converts sql.ErrNoRows and the SQLSTATE of the constraint
+// violations into NotFoundError and ConstraintError. The other errors are
+// returned as they are.
+func translateError(table string, err error) error {
+	if err == sql.ErrNoRows {
+		return &NotFoundError{Table: table, Err: err}
+	}
+	pqErr, ok := err.(*pq.Error)
+	if !ok {
+		return err
+	}
+	var kind error
+	switch pqErr.Code {
+	case "23505":
+		kind = ErrUniqueViolation
+	case "23503":
+		kind = ErrForeignKeyViolation
+	case "23502":
+		kind = ErrNotNullViolation
+	case "23514":
+		kind = ErrCheckViolation
+	case "23P01":
+		kind = ErrExclusionViolation
+	default:
+		return err
+	}
+	return &ConstraintError{
+		Kind:       kind,
+		Table:      pqErr.Table,
+		Column:     pqErr.Column,
+		Constraint: pqErr.Constraint,
+		Err:        err,
+	}
+}
+`
+
+	assert.Equal(expected, string(src))
+}
+
+func TestPgCreateStructWithDeprecated(t *testing.T) {
+	conn, cleanup := testPgSetup(t)
+	defer cleanup()
+	assert := assert.New(t)
+
+	schema := "public"
+	deprecated := []string{"t2", "t5"}
+	src, err := PgCreateStruct(conn, schema, "", "mypkg", "", []string{}, []string{}, []string{}, deprecated, "", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `// Code generated by dgw. DO NOT EDIT.
+
+package mypkg
+
+// T1 represents public.t1
+type T1 struct {
+	ID          int64          // id
+	I           int            // i
+	Str         string         // str
+	NullableStr sql.NullString // nullable_str
+	TWithTz     time.Time      // t_with_tz
+	TWithoutTz  time.Time      // t_without_tz
+	Tm          *time.Time     // tm
+}
+// Create inserts the T1 to the database.
+//
+// Deprecated: Use CreateContext instead.
+func (r *T1) Create(db Queryer) error {
+	return r.CreateContext(context.Background(), db)
+}
+
+// GetT1ByPk select the T1 from the database.
+//
+// Deprecated: Use GetT1ByPkContext instead.
+func GetT1ByPk(db Queryer, pk0 int64) (*T1, error) {
+	return GetT1ByPkContext(context.Background(), db, pk0)
+}
+
+// Validate checks the T1 against the constraints of t1 which can be checked
+// without the database, and returns *ValidationError listing every failing field.
+func (r *T1) Validate() error {
+	var errs []FieldError
+	if r.Str == "" {
+		errs = append(errs, FieldError{Field: "Str", Column: "str", Message: "must not be empty"})
+	}
+	if len(errs) > 0 {
+		return &ValidationError{Table: "t1", Fields: errs}
+	}
+	return nil
+}
+
+// CreateContext inserts the T1 to the database.
+func (r *T1) CreateContext(ctx context.Context, db Queryer) error {
+	if err := beforeCreate(ctx, r); err != nil {
+		return err
+	}
+	err := db.QueryRowContext(ctx,
+		` + "`INSERT INTO t1 (i, str, nullable_str, t_with_tz, t_without_tz, tm) VALUES ($1, $2, $3, $4, $5, $6) RETURNING id`" + `,
+		&r.I, &r.Str, &r.NullableStr, &r.TWithTz, &r.TWithoutTz, &r.Tm).Scan(&r.ID)
+	if err != nil {
+		return errors.WithStack(translateError("t1", err))
+	}
+	return afterCreate(ctx, r)
+}
+
+// CreateOnConflictDoNothing inserts the T1 to the database.
+// If a conflict occurs (e.g., unique constraint violation), the insert is skipped without error.
+// Returns true if the row was inserted, false if it was skipped due to conflict.
+func (r *T1) CreateOnConflictDoNothing(ctx context.Context, db Queryer) (bool, error) {
+	if err := beforeCreate(ctx, r); err != nil {
+		return false, err
+	}
+	err := db.QueryRowContext(ctx,
+		` + "`INSERT INTO t1 (i, str, nullable_str, t_with_tz, t_without_tz, tm) VALUES ($1, $2, $3, $4, $5, $6) ON CONFLICT DO NOTHING RETURNING id`" + `,
+		&r.I, &r.Str, &r.NullableStr, &r.TWithTz, &r.TWithoutTz, &r.Tm).Scan(&r.ID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return false, nil
+		}
+		return false, errors.WithStack(translateError("t1", err))
+	}
+	// Row was successfully inserted
+	return true, afterCreate(ctx, r)
+}
+
+// UpdateContext updates the T1 in the database by the primary key.
+// *NotFoundError is returned if the row is not found.
+func (r *T1) UpdateContext(ctx context.Context, db Queryer) error {
+	if err := beforeUpdate(ctx, r); err != nil {
+		return err
+	}
+	result, err := db.ExecContext(ctx,
+		` + "`UPDATE t1 SET i = $1, str = $2, nullable_str = $3, t_with_tz = $4, t_without_tz = $5, tm = $6 WHERE id = $7`" + `,
+		&r.I, &r.Str, &r.NullableStr, &r.TWithTz, &r.TWithoutTz, &r.Tm, &r.ID)
+	if err != nil {
+		return errors.WithStack(translateError("t1", err))
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return errors.WithStack(translateError("t1", err))
+	}
+	if n == 0 {
+		err = sql.ErrNoRows
+		return errors.WithStack(translateError("t1", err))
+	}
+	return afterUpdate(ctx, r)
+}
+
+// DeleteContext deletes the T1 from the database by the primary key.
+// *NotFoundError is returned if the row is not found.
+func (r *T1) DeleteContext(ctx context.Context, db Queryer) error {
+	if err := beforeDelete(ctx, r); err != nil {
+		return err
+	}
+	result, err := db.ExecContext(ctx,
+		` + "`DELETE FROM t1 WHERE id = $1`" + `,
+		&r.ID)
+	if err != nil {
+		return errors.WithStack(translateError("t1", err))
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return errors.WithStack(translateError("t1", err))
+	}
+	if n == 0 {
+		err = sql.ErrNoRows
+		return errors.WithStack(translateError("t1", err))
+	}
+	return afterDelete(ctx, r)
+}
+
+// ReloadContext selects the T1 again by its primary key, and overwrites the fields with the
+// values in the database, e.g. after a trigger or a stored procedure changed the row.
+// *NotFoundError is returned if the row is not found.
+func (r *T1) ReloadContext(ctx context.Context, db Queryer) error {
+	err := db.QueryRowContext(ctx,
+		` + "`SELECT id, i, str, nullable_str, t_with_tz, t_without_tz, tm FROM t1 WHERE id = $1`" + `,
+		r.ID).Scan(&r.ID, &r.I, &r.Str, &r.NullableStr, &r.TWithTz, &r.TWithoutTz, &r.Tm)
+	if err != nil {
+		return errors.WithStack(translateError("t1", err))
+	}
+	return nil
+}
+
+// GetT1ByPkContext select the T1 from the database.
+func GetT1ByPkContext(ctx context.Context, db Queryer, pk0 int64) (*T1, error) {
+	var r T1
+	err := db.QueryRowContext(ctx,
+		` + "`SELECT id, i, str, nullable_str, t_with_tz, t_without_tz, tm FROM t1 WHERE id = $1`" + `,
+		pk0).Scan(&r.ID, &r.I, &r.Str, &r.NullableStr, &r.TWithTz, &r.TWithoutTz, &r.Tm)
+	if err != nil {
+		return nil, errors.WithStack(translateError("t1", err))
+	}
+	return &r, nil
+}
+
+// GetT1ByPkForUpdateContext selects the T1 from the database with FOR UPDATE,
+// which locks the row until the end of the transaction. opt decides what happens if the row is locked
+// by another transaction: LockWait waits for it, LockNoWait fails with SQLSTATE 55P03, and
+// LockSkipLocked returns *NotFoundError.
+func GetT1ByPkForUpdateContext(ctx context.Context, db Queryer, pk0 int64, opt LockOption) (*T1, error) {
+	var r T1
+	err := db.QueryRowContext(ctx,
+		` + "`SELECT id, i, str, nullable_str, t_with_tz, t_without_tz, tm FROM t1 WHERE id = $1 FOR UPDATE`" + `+opt.sql(),
+		pk0).Scan(&r.ID, &r.I, &r.Str, &r.NullableStr, &r.TWithTz, &r.TWithoutTz, &r.Tm)
+	if err != nil {
+		return nil, errors.WithStack(translateError("t1", err))
+	}
+	return &r, nil
+}
+
+// GetT1ByPkForShareContext selects the T1 from the database with FOR SHARE,
+// which locks the row until the end of the transaction. opt decides what happens if the row is locked
+// by another transaction: LockWait waits for it, LockNoWait fails with SQLSTATE 55P03, and
+// LockSkipLocked returns *NotFoundError.
+func GetT1ByPkForShareContext(ctx context.Context, db Queryer, pk0 int64, opt LockOption) (*T1, error) {
+	var r T1
+	err := db.QueryRowContext(ctx,
+		` + "`SELECT id, i, str, nullable_str, t_with_tz, t_without_tz, tm FROM t1 WHERE id = $1 FOR SHARE`" + `+opt.sql(),
+		pk0).Scan(&r.ID, &r.I, &r.Str, &r.NullableStr, &r.TWithTz, &r.TWithoutTz, &r.Tm)
+	if err != nil {
+		return nil, errors.WithStack(translateError("t1", err))
+	}
+	return &r, nil
+}
+
+// ExistsT1ByPkContext checks if the T1 exists in the database.
+func ExistsT1ByPkContext(ctx context.Context, db Queryer, pk0 int64) (bool, error) {
+	var exists bool
+	err := db.QueryRowContext(ctx,
+		` + "`SELECT EXISTS (SELECT 1 FROM t1 WHERE id = $1)`" + `,
+		pk0).Scan(&exists)
+	if err != nil {
+		return false, errors.WithStack(translateError("t1", err))
+	}
+	return exists, nil
+}
+
+// GetT1ByPksContext select the T1s of the given primary keys from the database.
+// The result is ordered by the primary key, and the keys which do not exist are skipped.
+func GetT1ByPksContext(ctx context.Context, db Queryer, pks []int64) ([]*T1, error) {
+	rows, err := db.QueryContext(ctx,
+		` + "`SELECT id, i, str, nullable_str, t_with_tz, t_without_tz, tm FROM t1 WHERE id = ANY($1) ORDER BY id`" + `,
+		pq.Array(pks))
+	if err != nil {
+		return nil, errors.WithStack(translateError("t1", err))
+	}
+	defer rows.Close()
+	var rs []*T1
+	for rows.Next() {
+		var r T1
+		if err := rows.Scan(&r.ID, &r.I, &r.Str, &r.NullableStr, &r.TWithTz, &r.TWithoutTz, &r.Tm); err != nil {
+			return nil, errors.WithStack(translateError("t1", err))
+		}
+		rs = append(rs, &r)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.WithStack(translateError("t1", err))
+	}
+	return rs, nil
+}
+
+// T1ListOptions is the options of ListT1.
+type T1ListOptions struct {
+	// Limit is the maximum number of rows to return. Zero means no limit.
+	Limit int
+	// After is the primary key of the last row of the previous page.
+	// Only the rows after it are returned when it is not nil.
+	After *int64
+}
+
+// ListT1 lists the T1s ordered by the primary key.
+// Pass the primary key of the last row as opts.After to get the next page.
+func ListT1(ctx context.Context, db Queryer, opts T1ListOptions) ([]*T1, error) {
+	q := ` + "`SELECT id, i, str, nullable_str, t_with_tz, t_without_tz, tm FROM t1`" + `
+	var args []interface{}
+	if opts.After != nil {
+		q += ` + "` WHERE id > $1`" + `
+		args = append(args, *opts.After)
+	}
+	q += ` + "` ORDER BY id`" + `
+	if opts.Limit > 0 {
+		args = append(args, opts.Limit)
+		q += fmt.Sprintf(` + "` LIMIT $%d`" + `, len(args))
+	}
+	rows, err := db.QueryContext(ctx, q, args...)
+	if err != nil {
+		return nil, errors.WithStack(translateError("t1", err))
+	}
+	defer rows.Close()
+	var rs []*T1
+	for rows.Next() {
+		var r T1
+		if err := rows.Scan(&r.ID, &r.I, &r.Str, &r.NullableStr, &r.TWithTz, &r.TWithoutTz, &r.Tm); err != nil {
+			return nil, errors.WithStack(translateError("t1", err))
+		}
+		rs = append(rs, &r)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.WithStack(translateError("t1", err))
+	}
+	return rs, nil
+}
+
+// T1Filter builds a parameterized WHERE clause of t1.
+// The predicates are combined with AND.
+type T1Filter struct {
+	conds []string
+	args  []interface{}
+}
+
+// NewT1Filter creates an empty T1Filter, which matches every row.
+func NewT1Filter() *T1Filter {
+	return &T1Filter{}
+}
+
+func (f *T1Filter) add(cond string, v interface{}) *T1Filter {
+	f.args = append(f.args, v)
+	f.conds = append(f.conds, fmt.Sprintf(cond, len(f.args)))
+	return f
+}
+
+// Where returns the WHERE clause and its arguments. It returns an empty clause
+// if the filter is nil or has no predicate.
+func (f *T1Filter) Where() (string, []interface{}) {
+	if f == nil || len(f.conds) == 0 {
+		return "", nil
+	}
+	return " WHERE " + strings.Join(f.conds, " AND "), f.args
+}
+
+// IDEq filters the rows whose id equals to v.
+func (f *T1Filter) IDEq(v int64) *T1Filter {
+	return f.add("id = $%d", v)
+}
+
+// IDIn filters the rows whose id is one of vs.
+func (f *T1Filter) IDIn(vs ...int64) *T1Filter {
+	return f.add("id = ANY($%d)", pq.Array(vs))
+}
+
+// IDLt filters the rows whose id is less than v.
+func (f *T1Filter) IDLt(v int64) *T1Filter {
+	return f.add("id < $%d", v)
+}
+
+// IDGt filters the rows whose id is greater than v.
+func (f *T1Filter) IDGt(v int64) *T1Filter {
+	return f.add("id > $%d", v)
+}
+
+// IEq filters the rows whose i equals to v.
+func (f *T1Filter) IEq(v int) *T1Filter {
+	return f.add("i = $%d", v)
+}
+
+// IIn filters the rows whose i is one of vs.
+func (f *T1Filter) IIn(vs ...int) *T1Filter {
+	return f.add("i = ANY($%d)", pq.Array(vs))
+}
+
+// ILt filters the rows whose i is less than v.
+func (f *T1Filter) ILt(v int) *T1Filter {
+	return f.add("i < $%d", v)
+}
+
+// IGt filters the rows whose i is greater than v.
+func (f *T1Filter) IGt(v int) *T1Filter {
+	return f.add("i > $%d", v)
+}
+
+// StrEq filters the rows whose str equals to v.
+func (f *T1Filter) StrEq(v string) *T1Filter {
+	return f.add("str = $%d", v)
+}
+
+// StrIn filters the rows whose str is one of vs.
+func (f *T1Filter) StrIn(vs ...string) *T1Filter {
+	return f.add("str = ANY($%d)", pq.Array(vs))
+}
+
+// StrLt filters the rows whose str is less than v.
+func (f *T1Filter) StrLt(v string) *T1Filter {
+	return f.add("str < $%d", v)
+}
+
+// StrGt filters the rows whose str is greater than v.
+func (f *T1Filter) StrGt(v string) *T1Filter {
+	return f.add("str > $%d", v)
+}
+
+// NullableStrEq filters the rows whose nullable_str equals to v.
+func (f *T1Filter) NullableStrEq(v sql.NullString) *T1Filter {
+	return f.add("nullable_str = $%d", v)
+}
+
+// NullableStrIn filters the rows whose nullable_str is one of vs.
+func (f *T1Filter) NullableStrIn(vs ...sql.NullString) *T1Filter {
+	return f.add("nullable_str = ANY($%d)", pq.Array(vs))
+}
+
+// NullableStrLt filters the rows whose nullable_str is less than v.
+func (f *T1Filter) NullableStrLt(v sql.NullString) *T1Filter {
+	return f.add("nullable_str < $%d", v)
+}
+
+// NullableStrGt filters the rows whose nullable_str is greater than v.
+func (f *T1Filter) NullableStrGt(v sql.NullString) *T1Filter {
+	return f.add("nullable_str > $%d", v)
+}
+
+// NullableStrIsNull filters the rows whose nullable_str is NULL.
+func (f *T1Filter) NullableStrIsNull() *T1Filter {
+	f.conds = append(f.conds, "nullable_str IS NULL")
+	return f
+}
+
+// NullableStrIsNotNull filters the rows whose nullable_str is not NULL.
+func (f *T1Filter) NullableStrIsNotNull() *T1Filter {
+	f.conds = append(f.conds, "nullable_str IS NOT NULL")
+	return f
+}
+
+// TWithTzEq filters the rows whose t_with_tz equals to v.
+func (f *T1Filter) TWithTzEq(v time.Time) *T1Filter {
+	return f.add("t_with_tz = $%d", v)
+}
+
+// TWithTzIn filters the rows whose t_with_tz is one of vs.
+func (f *T1Filter) TWithTzIn(vs ...time.Time) *T1Filter {
+	return f.add("t_with_tz = ANY($%d)", pq.Array(vs))
+}
+
+// TWithTzLt filters the rows whose t_with_tz is less than v.
+func (f *T1Filter) TWithTzLt(v time.Time) *T1Filter {
+	return f.add("t_with_tz < $%d", v)
+}
+
+// TWithTzGt filters the rows whose t_with_tz is greater than v.
+func (f *T1Filter) TWithTzGt(v time.Time) *T1Filter {
+	return f.add("t_with_tz > $%d", v)
+}
+
+// TWithoutTzEq filters the rows whose t_without_tz equals to v.
+func (f *T1Filter) TWithoutTzEq(v time.Time) *T1Filter {
+	return f.add("t_without_tz = $%d", v)
+}
+
+// TWithoutTzIn filters the rows whose t_without_tz is one of vs.
+func (f *T1Filter) TWithoutTzIn(vs ...time.Time) *T1Filter {
+	return f.add("t_without_tz = ANY($%d)", pq.Array(vs))
+}
+
+// TWithoutTzLt filters the rows whose t_without_tz is less than v.
+func (f *T1Filter) TWithoutTzLt(v time.Time) *T1Filter {
+	return f.add("t_without_tz < $%d", v)
+}
+
+// TWithoutTzGt filters the rows whose t_without_tz is greater than v.
+func (f *T1Filter) TWithoutTzGt(v time.Time) *T1Filter {
+	return f.add("t_without_tz > $%d", v)
+}
+
+// TmEq filters the rows whose tm equals to v.
+func (f *T1Filter) TmEq(v *time.Time) *T1Filter {
+	return f.add("tm = $%d", v)
+}
+
+// TmIn filters the rows whose tm is one of vs.
+func (f *T1Filter) TmIn(vs ...*time.Time) *T1Filter {
+	return f.add("tm = ANY($%d)", pq.Array(vs))
+}
+
+// TmLt filters the rows whose tm is less than v.
+func (f *T1Filter) TmLt(v *time.Time) *T1Filter {
+	return f.add("tm < $%d", v)
+}
+
+// TmGt filters the rows whose tm is greater than v.
+func (f *T1Filter) TmGt(v *time.Time) *T1Filter {
+	return f.add("tm > $%d", v)
+}
+
+// TmIsNull filters the rows whose tm is NULL.
+func (f *T1Filter) TmIsNull() *T1Filter {
+	f.conds = append(f.conds, "tm IS NULL")
+	return f
+}
+
+// TmIsNotNull filters the rows whose tm is not NULL.
+func (f *T1Filter) TmIsNotNull() *T1Filter {
+	f.conds = append(f.conds, "tm IS NOT NULL")
+	return f
+}
+
+// FindT1 selects the T1s matching the filter from the database.
+// The result is ordered by the primary key.
+func FindT1(ctx context.Context, db Queryer, f *T1Filter) ([]*T1, error) {
+	where, args := f.Where()
+	rows, err := db.QueryContext(ctx,
+		` + "`SELECT id, i, str, nullable_str, t_with_tz, t_without_tz, tm FROM t1`" + `+where+` + "` ORDER BY id`" + `,
+		args...)
+	if err != nil {
+		return nil, errors.WithStack(translateError("t1", err))
+	}
+	defer rows.Close()
+	var rs []*T1
+	for rows.Next() {
+		var r T1
+		if err := rows.Scan(&r.ID, &r.I, &r.Str, &r.NullableStr, &r.TWithTz, &r.TWithoutTz, &r.Tm); err != nil {
+			return nil, errors.WithStack(translateError("t1", err))
+		}
+		rs = append(rs, &r)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.WithStack(translateError("t1", err))
+	}
+	return rs, nil
+}
+
+// CountT1 counts the T1s matching the filter in the database.
+// Pass nil as the filter to count every row.
+func CountT1(ctx context.Context, db Queryer, f *T1Filter) (int64, error) {
+	where, args := f.Where()
+	var n int64
+	err := db.QueryRowContext(ctx,
+		` + "`SELECT count(*) FROM t1`" + `+where,
+		args...).Scan(&n)
+	if err != nil {
+		return 0, errors.WithStack(translateError("t1", err))
+	}
+	return n, nil
+}
+
+// DeleteT1Where deletes the T1s matching the filter from the database,
+// and returns the number of the deleted rows. A filter without predicate is rejected
+// to avoid deleting every row by mistake.
+func DeleteT1Where(ctx context.Context, db Queryer, f *T1Filter) (int64, error) {
+	where, args := f.Where()
+	if where == "" {
+		return 0, errors.New("refusing to delete every row of t1 with an empty filter")
+	}
+	result, err := db.ExecContext(ctx,
+		` + "`DELETE FROM t1`" + `+where,
+		args...)
+	if err != nil {
+		return 0, errors.WithStack(translateError("t1", err))
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.WithStack(translateError("t1", err))
+	}
+	return n, nil
+}
+// T2 represents public.t2
+//
+// Deprecated: T2 is no longer maintained
+type T2 struct {
+	ID         int64     // id
+	I          int       // i
+	Str        string    // str
+	TWithTz    time.Time // t_with_tz
+	TWithoutTz time.Time // t_without_tz
+}
+// Create inserts the T2 to the database.
+//
+// Deprecated: T2 is no longer maintained
+func (r *T2) Create(db Queryer) error {
+	return r.CreateContext(context.Background(), db)
+}
+
+// GetT2ByPk select the T2 from the database.
+//
+// Deprecated: T2 is no longer maintained
+func GetT2ByPk(db Queryer, pk0 int64) (*T2, error) {
+	return GetT2ByPkContext(context.Background(), db, pk0)
+}
+
+// Validate checks the T2 against the constraints of t2 which can be checked
+// without the database, and returns *ValidationError listing every failing field.
+//
+// Deprecated: T2 is no longer maintained
+func (r *T2) Validate() error {
+	var errs []FieldError
+	if r.Str == "" {
+		errs = append(errs, FieldError{Field: "Str", Column: "str", Message: "must not be empty"})
+	}
+	if len(errs) > 0 {
+		return &ValidationError{Table: "t2", Fields: errs}
+	}
+	return nil
+}
+
+// CreateContext inserts the T2 to the database.
+//
+// Deprecated: T2 is no longer maintained
+func (r *T2) CreateContext(ctx context.Context, db Queryer) error {
+	if err := beforeCreate(ctx, r); err != nil {
+		return err
+	}
+	err := db.QueryRowContext(ctx,
+		` + "`INSERT INTO t2 (i, str, t_with_tz, t_without_tz) VALUES ($1, $2, $3, $4) RETURNING id`" + `,
+		&r.I, &r.Str, &r.TWithTz, &r.TWithoutTz).Scan(&r.ID)
+	if err != nil {
+		return errors.WithStack(translateError("t2", err))
+	}
+	return afterCreate(ctx, r)
+}
+
+// CreateOnConflictDoNothing inserts the T2 to the database.
+// If a conflict occurs (e.g., unique constraint violation), the insert is skipped without error.
+// Returns true if the row was inserted, false if it was skipped due to conflict.
+//
+// Deprecated: T2 is no longer maintained
+func (r *T2) CreateOnConflictDoNothing(ctx context.Context, db Queryer) (bool, error) {
+	if err := beforeCreate(ctx, r); err != nil {
+		return false, err
+	}
+	err := db.QueryRowContext(ctx,
+		` + "`INSERT INTO t2 (i, str, t_with_tz, t_without_tz) VALUES ($1, $2, $3, $4) ON CONFLICT DO NOTHING RETURNING id`" + `,
+		&r.I, &r.Str, &r.TWithTz, &r.TWithoutTz).Scan(&r.ID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return false, nil
+		}
+		return false, errors.WithStack(translateError("t2", err))
+	}
+	// Row was successfully inserted
+	return true, afterCreate(ctx, r)
+}
+
+// UpdateContext updates the T2 in the database by the primary key.
+// *NotFoundError is returned if the row is not found.
+//
+// Deprecated: T2 is no longer maintained
+func (r *T2) UpdateContext(ctx context.Context, db Queryer) error {
+	if err := beforeUpdate(ctx, r); err != nil {
+		return err
+	}
+	result, err := db.ExecContext(ctx,
+		` + "`UPDATE t2 SET i = $1, str = $2, t_with_tz = $3, t_without_tz = $4 WHERE id = $5`" + `,
+		&r.I, &r.Str, &r.TWithTz, &r.TWithoutTz, &r.ID)
+	if err != nil {
+		return errors.WithStack(translateError("t2", err))
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return errors.WithStack(translateError("t2", err))
+	}
+	if n == 0 {
+		err = sql.ErrNoRows
+		return errors.WithStack(translateError("t2", err))
+	}
+	return afterUpdate(ctx, r)
+}
+
+// DeleteContext deletes the T2 from the database by the primary key.
+// *NotFoundError is returned if the row is not found.
+//
+// Deprecated: T2 is no longer maintained
+func (r *T2) DeleteContext(ctx context.Context, db Queryer) error {
+	if err := beforeDelete(ctx, r); err != nil {
+		return err
+	}
+	result, err := db.ExecContext(ctx,
+		` + "`DELETE FROM t2 WHERE id = $1`" + `,
+		&r.ID)
+	if err != nil {
+		return errors.WithStack(translateError("t2", err))
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return errors.WithStack(translateError("t2", err))
+	}
+	if n == 0 {
+		err = sql.ErrNoRows
+		return errors.WithStack(translateError("t2", err))
+	}
+	return afterDelete(ctx, r)
+}
+
+// ReloadContext selects the T2 again by its primary key, and overwrites the fields with the
+// values in the database, e.g. after a trigger or a stored procedure changed the row.
+// *NotFoundError is returned if the row is not found.
+//
+// Deprecated: T2 is no longer maintained
+func (r *T2) ReloadContext(ctx context.Context, db Queryer) error {
+	err := db.QueryRowContext(ctx,
+		` + "`SELECT id, i, str, t_with_tz, t_without_tz FROM t2 WHERE id = $1`" + `,
+		r.ID).Scan(&r.ID, &r.I, &r.Str, &r.TWithTz, &r.TWithoutTz)
+	if err != nil {
+		return errors.WithStack(translateError("t2", err))
+	}
+	return nil
+}
+
+// GetT2ByPkContext select the T2 from the database.
+//
+// Deprecated: T2 is no longer maintained
+func GetT2ByPkContext(ctx context.Context, db Queryer, pk0 int64) (*T2, error) {
+	var r T2
+	err := db.QueryRowContext(ctx,
+		` + "`SELECT id, i, str, t_with_tz, t_without_tz FROM t2 WHERE id = $1`" + `,
+		pk0).Scan(&r.ID, &r.I, &r.Str, &r.TWithTz, &r.TWithoutTz)
+	if err != nil {
+		return nil, errors.WithStack(translateError("t2", err))
+	}
+	return &r, nil
+}
+
+// GetT2ByPkForUpdateContext selects the T2 from the database with FOR UPDATE,
+// which locks the row until the end of the transaction. opt decides what happens if the row is locked
+// by another transaction: LockWait waits for it, LockNoWait fails with SQLSTATE 55P03, and
+// LockSkipLocked returns *NotFoundError.
+//
+// Deprecated: T2 is no longer maintained
+func GetT2ByPkForUpdateContext(ctx context.Context, db Queryer, pk0 int64, opt LockOption) (*T2, error) {
+	var r T2
+	err := db.QueryRowContext(ctx,
+		` + "`SELECT id, i, str, t_with_tz, t_without_tz FROM t2 WHERE id = $1 FOR UPDATE`" + `+opt.sql(),
+		pk0).Scan(&r.ID, &r.I, &r.Str, &r.TWithTz, &r.TWithoutTz)
+	if err != nil {
+		return nil, errors.WithStack(translateError("t2", err))
+	}
+	return &r, nil
+}
+
+// GetT2ByPkForShareContext selects the T2 from the database with FOR SHARE,
+// which locks the row until the end of the transaction. opt decides what happens if the row is locked
+// by another transaction: LockWait waits for it, LockNoWait fails with SQLSTATE 55P03, and
+// LockSkipLocked returns *NotFoundError.
+//
+// Deprecated: T2 is no longer maintained
+func GetT2ByPkForShareContext(ctx context.Context, db Queryer, pk0 int64, opt LockOption) (*T2, error) {
+	var r T2
+	err := db.QueryRowContext(ctx,
+		` + "`SELECT id, i, str, t_with_tz, t_without_tz FROM t2 WHERE id = $1 FOR SHARE`" + `+opt.sql(),
+		pk0).Scan(&r.ID, &r.I, &r.Str, &r.TWithTz, &r.TWithoutTz)
+	if err != nil {
+		return nil, errors.WithStack(translateError("t2", err))
+	}
+	return &r, nil
+}
+
+// ExistsT2ByPkContext checks if the T2 exists in the database.
+//
+// Deprecated: T2 is no longer maintained
+func ExistsT2ByPkContext(ctx context.Context, db Queryer, pk0 int64) (bool, error) {
+	var exists bool
+	err := db.QueryRowContext(ctx,
+		` + "`SELECT EXISTS (SELECT 1 FROM t2 WHERE id = $1)`" + `,
+		pk0).Scan(&exists)
+	if err != nil {
+		return false, errors.WithStack(translateError("t2", err))
+	}
+	return exists, nil
+}
+
+// GetT2ByPksContext select the T2s of the given primary keys from the database.
+// The result is ordered by the primary key, and the keys which do not exist are skipped.
+//
+// Deprecated: T2 is no longer maintained
+func GetT2ByPksContext(ctx context.Context, db Queryer, pks []int64) ([]*T2, error) {
+	rows, err := db.QueryContext(ctx,
+		` + "`SELECT id, i, str, t_with_tz, t_without_tz FROM t2 WHERE id = ANY($1) ORDER BY id`" + `,
+		pq.Array(pks))
+	if err != nil {
+		return nil, errors.WithStack(translateError("t2", err))
+	}
+	defer rows.Close()
+	var rs []*T2
+	for rows.Next() {
+		var r T2
+		if err := rows.Scan(&r.ID, &r.I, &r.Str, &r.TWithTz, &r.TWithoutTz); err != nil {
+			return nil, errors.WithStack(translateError("t2", err))
+		}
+		rs = append(rs, &r)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.WithStack(translateError("t2", err))
+	}
+	return rs, nil
+}
+
+// T2ListOptions is the options of ListT2.
+type T2ListOptions struct {
+	// Limit is the maximum number of rows to return. Zero means no limit.
+	Limit int
+	// After is the primary key of the last row of the previous page.
+	// Only the rows after it are returned when it is not nil.
+	After *int64
+}
+
+// ListT2 lists the T2s ordered by the primary key.
+// Pass the primary key of the last row as opts.After to get the next page.
+//
+// Deprecated: T2 is no longer maintained
+func ListT2(ctx context.Context, db Queryer, opts T2ListOptions) ([]*T2, error) {
+	q := ` + "`SELECT id, i, str, t_with_tz, t_without_tz FROM t2`" + `
+	var args []interface{}
+	if opts.After != nil {
+		q += ` + "` WHERE id > $1`" + `
+		args = append(args, *opts.After)
+	}
+	q += ` + "` ORDER BY id`" + `
+	if opts.Limit > 0 {
+		args = append(args, opts.Limit)
+		q += fmt.Sprintf(` + "` LIMIT $%d`" + `, len(args))
+	}
+	rows, err := db.QueryContext(ctx, q, args...)
+	if err != nil {
+		return nil, errors.WithStack(translateError("t2", err))
+	}
+	defer rows.Close()
+	var rs []*T2
+	for rows.Next() {
+		var r T2
+		if err := rows.Scan(&r.ID, &r.I, &r.Str, &r.TWithTz, &r.TWithoutTz); err != nil {
+			return nil, errors.WithStack(translateError("t2", err))
+		}
+		rs = append(rs, &r)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.WithStack(translateError("t2", err))
+	}
+	return rs, nil
+}
+
+// T2Filter builds a parameterized WHERE clause of t2.
+// The predicates are combined with AND.
+//
+// Deprecated: T2 is no longer maintained
+type T2Filter struct {
+	conds []string
+	args  []interface{}
+}
+
+// NewT2Filter creates an empty T2Filter, which matches every row.
+func NewT2Filter() *T2Filter {
+	return &T2Filter{}
+}
+
+func (f *T2Filter) add(cond string, v interface{}) *T2Filter {
+	f.args = append(f.args, v)
+	f.conds = append(f.conds, fmt.Sprintf(cond, len(f.args)))
+	return f
+}
+
+// Where returns the WHERE clause and its arguments. It returns an empty clause
+// if the filter is nil or has no predicate.
+func (f *T2Filter) Where() (string, []interface{}) {
+	if f == nil || len(f.conds) == 0 {
+		return "", nil
+	}
+	return " WHERE " + strings.Join(f.conds, " AND "), f.args
+}
+
+// IDEq filters the rows whose id equals to v.
+func (f *T2Filter) IDEq(v int64) *T2Filter {
+	return f.add("id = $%d", v)
+}
+
+// IDIn filters the rows whose id is one of vs.
+func (f *T2Filter) IDIn(vs ...int64) *T2Filter {
+	return f.add("id = ANY($%d)", pq.Array(vs))
+}
+
+// IDLt filters the rows whose id is less than v.
+func (f *T2Filter) IDLt(v int64) *T2Filter {
+	return f.add("id < $%d", v)
+}
+
+// IDGt filters the rows whose id is greater than v.
+func (f *T2Filter) IDGt(v int64) *T2Filter {
+	return f.add("id > $%d", v)
+}
+
+// IEq filters the rows whose i equals to v.
+func (f *T2Filter) IEq(v int) *T2Filter {
+	return f.add("i = $%d", v)
+}
+
+// IIn filters the rows whose i is one of vs.
+func (f *T2Filter) IIn(vs ...int) *T2Filter {
+	return f.add("i = ANY($%d)", pq.Array(vs))
+}
+
+// ILt filters the rows whose i is less than v.
+func (f *T2Filter) ILt(v int) *T2Filter {
+	return f.add("i < $%d", v)
+}
+
+// IGt filters the rows whose i is greater than v.
+func (f *T2Filter) IGt(v int) *T2Filter {
+	return f.add("i > $%d", v)
+}
+
+// StrEq filters the rows whose str equals to v.
+func (f *T2Filter) StrEq(v string) *T2Filter {
+	return f.add("str = $%d", v)
+}
+
+// StrIn filters the rows whose str is one of vs.
+func (f *T2Filter) StrIn(vs ...string) *T2Filter {
+	return f.add("str = ANY($%d)", pq.Array(vs))
+}
+
+// StrLt filters the rows whose str is less than v.
+func (f *T2Filter) StrLt(v string) *T2Filter {
+	return f.add("str < $%d", v)
+}
+
+// StrGt filters the rows whose str is greater than v.
+func (f *T2Filter) StrGt(v string) *T2Filter {
+	return f.add("str > $%d", v)
+}
+
+// TWithTzEq filters the rows whose t_with_tz equals to v.
+func (f *T2Filter) TWithTzEq(v time.Time) *T2Filter {
+	return f.add("t_with_tz = $%d", v)
+}
+
+// TWithTzIn filters the rows whose t_with_tz is one of vs.
+func (f *T2Filter) TWithTzIn(vs ...time.Time) *T2Filter {
+	return f.add("t_with_tz = ANY($%d)", pq.Array(vs))
+}
+
+// TWithTzLt filters the rows whose t_with_tz is less than v.
+func (f *T2Filter) TWithTzLt(v time.Time) *T2Filter {
+	return f.add("t_with_tz < $%d", v)
+}
+
+// TWithTzGt filters the rows whose t_with_tz is greater than v.
+func (f *T2Filter) TWithTzGt(v time.Time) *T2Filter {
+	return f.add("t_with_tz > $%d", v)
+}
+
+// TWithoutTzEq filters the rows whose t_without_tz equals to v.
+func (f *T2Filter) TWithoutTzEq(v time.Time) *T2Filter {
+	return f.add("t_without_tz = $%d", v)
+}
+
+// TWithoutTzIn filters the rows whose t_without_tz is one of vs.
+func (f *T2Filter) TWithoutTzIn(vs ...time.Time) *T2Filter {
+	return f.add("t_without_tz = ANY($%d)", pq.Array(vs))
+}
+
+// TWithoutTzLt filters the rows whose t_without_tz is less than v.
+func (f *T2Filter) TWithoutTzLt(v time.Time) *T2Filter {
+	return f.add("t_without_tz < $%d", v)
+}
+
+// TWithoutTzGt filters the rows whose t_without_tz is greater than v.
+func (f *T2Filter) TWithoutTzGt(v time.Time) *T2Filter {
+	return f.add("t_without_tz > $%d", v)
+}
+
+// FindT2 selects the T2s matching the filter from the database.
+// The result is ordered by the primary key.
+//
+// Deprecated: T2 is no longer maintained
+func FindT2(ctx context.Context, db Queryer, f *T2Filter) ([]*T2, error) {
+	where, args := f.Where()
+	rows, err := db.QueryContext(ctx,
+		` + "`SELECT id, i, str, t_with_tz, t_without_tz FROM t2`" + `+where+` + "` ORDER BY id`" + `,
+		args...)
+	if err != nil {
+		return nil, errors.WithStack(translateError("t2", err))
+	}
+	defer rows.Close()
+	var rs []*T2
+	for rows.Next() {
+		var r T2
+		if err := rows.Scan(&r.ID, &r.I, &r.Str, &r.TWithTz, &r.TWithoutTz); err != nil {
+			return nil, errors.WithStack(translateError("t2", err))
+		}
+		rs = append(rs, &r)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.WithStack(translateError("t2", err))
+	}
+	return rs, nil
+}
+
+// CountT2 counts the T2s matching the filter in the database.
+// Pass nil as the filter to count every row.
+//
+// Deprecated: T2 is no longer maintained
+func CountT2(ctx context.Context, db Queryer, f *T2Filter) (int64, error) {
+	where, args := f.Where()
+	var n int64
+	err := db.QueryRowContext(ctx,
+		` + "`SELECT count(*) FROM t2`" + `+where,
+		args...).Scan(&n)
+	if err != nil {
+		return 0, errors.WithStack(translateError("t2", err))
+	}
+	return n, nil
+}
+
+// DeleteT2Where deletes the T2s matching the filter from the database,
+// and returns the number of the deleted rows. A filter without predicate is rejected
+// to avoid deleting every row by mistake.
+//
+// Deprecated: T2 is no longer maintained
+func DeleteT2Where(ctx context.Context, db Queryer, f *T2Filter) (int64, error) {
+	where, args := f.Where()
+	if where == "" {
+		return 0, errors.New("refusing to delete every row of t2 with an empty filter")
+	}
+	result, err := db.ExecContext(ctx,
+		` + "`DELETE FROM t2`" + `+where,
+		args...)
+	if err != nil {
+		return 0, errors.WithStack(translateError("t2", err))
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.WithStack(translateError("t2", err))
+	}
+	return n, nil
+}
+// T3 represents public.t3
+type T3 struct {
+	ID         int64     // id
+	I          int       // i
+	Str        string    // str
+	TWithTz    time.Time // t_with_tz
+	TWithoutTz time.Time // t_without_tz
+}
+
+// T3Pk represents the primary key of public.t3
+type T3Pk struct {
+	ID int64 // id
+	I  int   // i
+}
+// Create inserts the T3 to the database.
+//
+// Deprecated: Use CreateContext instead.
+func (r *T3) Create(db Queryer) error {
+	return r.CreateContext(context.Background(), db)
+}
+
+// GetT3ByPk select the T3 from the database.
+//
+// Deprecated: Use GetT3ByPkContext instead.
+func GetT3ByPk(db Queryer, pk0 int64, pk1 int) (*T3, error) {
+	return GetT3ByPkContext(context.Background(), db, pk0, pk1)
+}
+
+// Validate checks the T3 against the constraints of t3 which can be checked
+// without the database, and returns *ValidationError listing every failing field.
+func (r *T3) Validate() error {
+	var errs []FieldError
+	if r.Str == "" {
+		errs = append(errs, FieldError{Field: "Str", Column: "str", Message: "must not be empty"})
+	}
+	if len(errs) > 0 {
+		return &ValidationError{Table: "t3", Fields: errs}
+	}
+	return nil
+}
+
+// CreateContext inserts the T3 to the database.
+func (r *T3) CreateContext(ctx context.Context, db Queryer) error {
+	if err := beforeCreate(ctx, r); err != nil {
+		return err
+	}
+	err := db.QueryRowContext(ctx,
+		` + "`INSERT INTO t3 (i, str, t_with_tz, t_without_tz) VALUES ($1, $2, $3, $4) RETURNING id`" + `,
+		&r.I, &r.Str, &r.TWithTz, &r.TWithoutTz).Scan(&r.ID)
+	if err != nil {
+		return errors.WithStack(translateError("t3", err))
+	}
+	return afterCreate(ctx, r)
+}
+
+// CreateOnConflictDoNothing inserts the T3 to the database.
+// If a conflict occurs (e.g., unique constraint violation), the insert is skipped without error.
+// Returns true if the row was inserted, false if it was skipped due to conflict.
+func (r *T3) CreateOnConflictDoNothing(ctx context.Context, db Queryer) (bool, error) {
+	if err := beforeCreate(ctx, r); err != nil {
+		return false, err
+	}
+	err := db.QueryRowContext(ctx,
+		` + "`INSERT INTO t3 (i, str, t_with_tz, t_without_tz) VALUES ($1, $2, $3, $4) ON CONFLICT DO NOTHING RETURNING id`" + `,
+		&r.I, &r.Str, &r.TWithTz, &r.TWithoutTz).Scan(&r.ID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return false, nil
+		}
+		return false, errors.WithStack(translateError("t3", err))
+	}
+	// Row was successfully inserted
+	return true, afterCreate(ctx, r)
+}
+
+// UpdateContext updates the T3 in the database by the primary key.
+// *NotFoundError is returned if the row is not found.
+func (r *T3) UpdateContext(ctx context.Context, db Queryer) error {
+	if err := beforeUpdate(ctx, r); err != nil {
+		return err
+	}
+	result, err := db.ExecContext(ctx,
+		` + "`UPDATE t3 SET str = $1, t_with_tz = $2, t_without_tz = $3 WHERE id = $4 AND i = $5`" + `,
+		&r.Str, &r.TWithTz, &r.TWithoutTz, &r.ID, &r.I)
+	if err != nil {
+		return errors.WithStack(translateError("t3", err))
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return errors.WithStack(translateError("t3", err))
+	}
+	if n == 0 {
+		err = sql.ErrNoRows
+		return errors.WithStack(translateError("t3", err))
+	}
+	return afterUpdate(ctx, r)
+}
+
+// DeleteContext deletes the T3 from the database by the primary key.
+// *NotFoundError is returned if the row is not found.
+func (r *T3) DeleteContext(ctx context.Context, db Queryer) error {
+	if err := beforeDelete(ctx, r); err != nil {
+		return err
+	}
+	result, err := db.ExecContext(ctx,
+		` + "`DELETE FROM t3 WHERE id = $1 AND i = $2`" + `,
+		&r.ID, &r.I)
+	if err != nil {
+		return errors.WithStack(translateError("t3", err))
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return errors.WithStack(translateError("t3", err))
+	}
+	if n == 0 {
+		err = sql.ErrNoRows
+		return errors.WithStack(translateError("t3", err))
+	}
+	return afterDelete(ctx, r)
+}
+
+// ReloadContext selects the T3 again by its primary key, and overwrites the fields with the
+// values in the database, e.g. after a trigger or a stored procedure changed the row.
+// *NotFoundError is returned if the row is not found.
+func (r *T3) ReloadContext(ctx context.Context, db Queryer) error {
+	err := db.QueryRowContext(ctx,
+		` + "`SELECT id, i, str, t_with_tz, t_without_tz FROM t3 WHERE id = $1 AND i = $2`" + `,
+		r.ID, r.I).Scan(&r.ID, &r.I, &r.Str, &r.TWithTz, &r.TWithoutTz)
+	if err != nil {
+		return errors.WithStack(translateError("t3", err))
+	}
+	return nil
+}
+
+// GetT3ByPkContext select the T3 from the database.
+func GetT3ByPkContext(ctx context.Context, db Queryer, pk0 int64, pk1 int) (*T3, error) {
+	var r T3
+	err := db.QueryRowContext(ctx,
+		` + "`SELECT id, i, str, t_with_tz, t_without_tz FROM t3 WHERE id = $1 AND i = $2`" + `,
+		pk0, pk1).Scan(&r.ID, &r.I, &r.Str, &r.TWithTz, &r.TWithoutTz)
+	if err != nil {
+		return nil, errors.WithStack(translateError("t3", err))
+	}
+	return &r, nil
+}
+
+// GetT3ByPkForUpdateContext selects the T3 from the database with FOR UPDATE,
+// which locks the row until the end of the transaction. opt decides what happens if the row is locked
+// by another transaction: LockWait waits for it, LockNoWait fails with SQLSTATE 55P03, and
+// LockSkipLocked returns *NotFoundError.
+func GetT3ByPkForUpdateContext(ctx context.Context, db Queryer, pk0 int64, pk1 int, opt LockOption) (*T3, error) {
+	var r T3
+	err := db.QueryRowContext(ctx,
+		` + "`SELECT id, i, str, t_with_tz, t_without_tz FROM t3 WHERE id = $1 AND i = $2 FOR UPDATE`" + `+opt.sql(),
+		pk0, pk1).Scan(&r.ID, &r.I, &r.Str, &r.TWithTz, &r.TWithoutTz)
+	if err != nil {
+		return nil, errors.WithStack(translateError("t3", err))
+	}
+	return &r, nil
+}
+
+// GetT3ByPkForShareContext selects the T3 from the database with FOR SHARE,
+// which locks the row until the end of the transaction. opt decides what happens if the row is locked
+// by another transaction: LockWait waits for it, LockNoWait fails with SQLSTATE 55P03, and
+// LockSkipLocked returns *NotFoundError.
+func GetT3ByPkForShareContext(ctx context.Context, db Queryer, pk0 int64, pk1 int, opt LockOption) (*T3, error) {
+	var r T3
+	err := db.QueryRowContext(ctx,
+		` + "`SELECT id, i, str, t_with_tz, t_without_tz FROM t3 WHERE id = $1 AND i = $2 FOR SHARE`" + `+opt.sql(),
+		pk0, pk1).Scan(&r.ID, &r.I, &r.Str, &r.TWithTz, &r.TWithoutTz)
+	if err != nil {
+		return nil, errors.WithStack(translateError("t3", err))
+	}
+	return &r, nil
+}
+
+// ExistsT3ByPkContext checks if the T3 exists in the database.
+func ExistsT3ByPkContext(ctx context.Context, db Queryer, pk0 int64, pk1 int) (bool, error) {
+	var exists bool
+	err := db.QueryRowContext(ctx,
+		` + "`SELECT EXISTS (SELECT 1 FROM t3 WHERE id = $1 AND i = $2)`" + `,
+		pk0, pk1).Scan(&exists)
+	if err != nil {
+		return false, errors.WithStack(translateError("t3", err))
+	}
+	return exists, nil
+}
+
+// GetT3ByPksContext select the T3s of the given primary keys from the database.
+// The result is ordered by the primary key, and the keys which do not exist are skipped.
+func GetT3ByPksContext(ctx context.Context, db Queryer, pks []T3Pk) ([]*T3, error) {
+	if len(pks) == 0 {
+		return nil, nil
+	}
+	values := make([]string, 0, len(pks))
+	args := make([]interface{}, 0, len(pks)*2)
+	for _, pk := range pks {
+		args = append(args, pk.ID, pk.I)
+		values = append(values, fmt.Sprintf("($%d::bigint, $%d::integer)", len(args)-1, len(args)))
+	}
+	rows, err := db.QueryContext(ctx,
+		fmt.Sprintf(` + "`SELECT id, i, str, t_with_tz, t_without_tz FROM t3 WHERE (id, i) IN (VALUES %s) ORDER BY id, i`" + `, strings.Join(values, ", ")),
+		args...)
+	if err != nil {
+		return nil, errors.WithStack(translateError("t3", err))
+	}
+	defer rows.Close()
+	var rs []*T3
+	for rows.Next() {
+		var r T3
+		if err := rows.Scan(&r.ID, &r.I, &r.Str, &r.TWithTz, &r.TWithoutTz); err != nil {
+			return nil, errors.WithStack(translateError("t3", err))
+		}
+		rs = append(rs, &r)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.WithStack(translateError("t3", err))
+	}
+	return rs, nil
+}
+
+// T3ListOptions is the options of ListT3.
+type T3ListOptions struct {
+	// Limit is the maximum number of rows to return. Zero means no limit.
+	Limit int
+	// After is the primary key of the last row of the previous page.
+	// Only the rows after it are returned when it is not nil.
+	After *T3Pk
+}
+
+// ListT3 lists the T3s ordered by the primary key.
+// Pass the primary key of the last row as opts.After to get the next page.
+func ListT3(ctx context.Context, db Queryer, opts T3ListOptions) ([]*T3, error) {
+	q := ` + "`SELECT id, i, str, t_with_tz, t_without_tz FROM t3`" + `
+	var args []interface{}
+	if opts.After != nil {
+		q += ` + "` WHERE (id, i) > ($1, $2)`" + `
+		args = append(args, opts.After.ID, opts.After.I)
+	}
+	q += ` + "` ORDER BY id, i`" + `
+	if opts.Limit > 0 {
+		args = append(args, opts.Limit)
+		q += fmt.Sprintf(` + "` LIMIT $%d`" + `, len(args))
+	}
+	rows, err := db.QueryContext(ctx, q, args...)
+	if err != nil {
+		return nil, errors.WithStack(translateError("t3", err))
+	}
+	defer rows.Close()
+	var rs []*T3
+	for rows.Next() {
+		var r T3
+		if err := rows.Scan(&r.ID, &r.I, &r.Str, &r.TWithTz, &r.TWithoutTz); err != nil {
+			return nil, errors.WithStack(translateError("t3", err))
+		}
+		rs = append(rs, &r)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.WithStack(translateError("t3", err))
+	}
+	return rs, nil
+}
+
+// T3Filter builds a parameterized WHERE clause of t3.
+// The predicates are combined with AND.
+type T3Filter struct {
+	conds []string
+	args  []interface{}
+}
+
+// NewT3Filter creates an empty T3Filter, which matches every row.
+func NewT3Filter() *T3Filter {
+	return &T3Filter{}
+}
+
+func (f *T3Filter) add(cond string, v interface{}) *T3Filter {
+	f.args = append(f.args, v)
+	f.conds = append(f.conds, fmt.Sprintf(cond, len(f.args)))
+	return f
+}
+
+// Where returns the WHERE clause and its arguments. It returns an empty clause
+// if the filter is nil or has no predicate.
+func (f *T3Filter) Where() (string, []interface{}) {
+	if f == nil || len(f.conds) == 0 {
+		return "", nil
+	}
+	return " WHERE " + strings.Join(f.conds, " AND "), f.args
+}
+
+// IDEq filters the rows whose id equals to v.
+func (f *T3Filter) IDEq(v int64) *T3Filter {
+	return f.add("id = $%d", v)
+}
+
+// IDIn filters the rows whose id is one of vs.
+func (f *T3Filter) IDIn(vs ...int64) *T3Filter {
+	return f.add("id = ANY($%d)", pq.Array(vs))
+}
+
+// IDLt filters the rows whose id is less than v.
+func (f *T3Filter) IDLt(v int64) *T3Filter {
+	return f.add("id < $%d", v)
+}
+
+// IDGt filters the rows whose id is greater than v.
+func (f *T3Filter) IDGt(v int64) *T3Filter {
+	return f.add("id > $%d", v)
+}
+
+// IEq filters the rows whose i equals to v.
+func (f *T3Filter) IEq(v int) *T3Filter {
+	return f.add("i = $%d", v)
+}
+
+// IIn filters the rows whose i is one of vs.
+func (f *T3Filter) IIn(vs ...int) *T3Filter {
+	return f.add("i = ANY($%d)", pq.Array(vs))
+}
+
+// ILt filters the rows whose i is less than v.
+func (f *T3Filter) ILt(v int) *T3Filter {
+	return f.add("i < $%d", v)
+}
+
+// IGt filters the rows whose i is greater than v.
+func (f *T3Filter) IGt(v int) *T3Filter {
+	return f.add("i > $%d", v)
+}
+
+// StrEq filters the rows whose str equals to v.
+func (f *T3Filter) StrEq(v string) *T3Filter {
+	return f.add("str = $%d", v)
+}
+
+// StrIn filters the rows whose str is one of vs.
+func (f *T3Filter) StrIn(vs ...string) *T3Filter {
+	return f.add("str = ANY($%d)", pq.Array(vs))
+}
+
+// StrLt filters the rows whose str is less than v.
+func (f *T3Filter) StrLt(v string) *T3Filter {
+	return f.add("str < $%d", v)
+}
+
+// StrGt filters the rows whose str is greater than v.
+func (f *T3Filter) StrGt(v string) *T3Filter {
+	return f.add("str > $%d", v)
+}
+
+// TWithTzEq filters the rows whose t_with_tz equals to v.
+func (f *T3Filter) TWithTzEq(v time.Time) *T3Filter {
+	return f.add("t_with_tz = $%d", v)
+}
+
+// TWithTzIn filters the rows whose t_with_tz is one of vs.
+func (f *T3Filter) TWithTzIn(vs ...time.Time) *T3Filter {
+	return f.add("t_with_tz = ANY($%d)", pq.Array(vs))
+}
+
+// TWithTzLt filters the rows whose t_with_tz is less than v.
+func (f *T3Filter) TWithTzLt(v time.Time) *T3Filter {
+	return f.add("t_with_tz < $%d", v)
+}
+
+// TWithTzGt filters the rows whose t_with_tz is greater than v.
+func (f *T3Filter) TWithTzGt(v time.Time) *T3Filter {
+	return f.add("t_with_tz > $%d", v)
+}
+
+// TWithoutTzEq filters the rows whose t_without_tz equals to v.
+func (f *T3Filter) TWithoutTzEq(v time.Time) *T3Filter {
+	return f.add("t_without_tz = $%d", v)
+}
+
+// TWithoutTzIn filters the rows whose t_without_tz is one of vs.
+func (f *T3Filter) TWithoutTzIn(vs ...time.Time) *T3Filter {
+	return f.add("t_without_tz = ANY($%d)", pq.Array(vs))
+}
+
+// TWithoutTzLt filters the rows whose t_without_tz is less than v.
+func (f *T3Filter) TWithoutTzLt(v time.Time) *T3Filter {
+	return f.add("t_without_tz < $%d", v)
+}
+
+// TWithoutTzGt filters the rows whose t_without_tz is greater than v.
+func (f *T3Filter) TWithoutTzGt(v time.Time) *T3Filter {
+	return f.add("t_without_tz > $%d", v)
+}
+
+// FindT3 selects the T3s matching the filter from the database.
+// The result is ordered by the primary key.
+func FindT3(ctx context.Context, db Queryer, f *T3Filter) ([]*T3, error) {
+	where, args := f.Where()
+	rows, err := db.QueryContext(ctx,
+		` + "`SELECT id, i, str, t_with_tz, t_without_tz FROM t3`" + `+where+` + "` ORDER BY id, i`" + `,
+		args...)
+	if err != nil {
+		return nil, errors.WithStack(translateError("t3", err))
+	}
+	defer rows.Close()
+	var rs []*T3
+	for rows.Next() {
+		var r T3
+		if err := rows.Scan(&r.ID, &r.I, &r.Str, &r.TWithTz, &r.TWithoutTz); err != nil {
+			return nil, errors.WithStack(translateError("t3", err))
+		}
+		rs = append(rs, &r)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.WithStack(translateError("t3", err))
+	}
+	return rs, nil
+}
+
+// CountT3 counts the T3s matching the filter in the database.
+// Pass nil as the filter to count every row.
+func CountT3(ctx context.Context, db Queryer, f *T3Filter) (int64, error) {
+	where, args := f.Where()
+	var n int64
+	err := db.QueryRowContext(ctx,
+		` + "`SELECT count(*) FROM t3`" + `+where,
+		args...).Scan(&n)
+	if err != nil {
+		return 0, errors.WithStack(translateError("t3", err))
+	}
+	return n, nil
+}
+
+// DeleteT3Where deletes the T3s matching the filter from the database,
+// and returns the number of the deleted rows. A filter without predicate is rejected
+// to avoid deleting every row by mistake.
+func DeleteT3Where(ctx context.Context, db Queryer, f *T3Filter) (int64, error) {
+	where, args := f.Where()
+	if where == "" {
+		return 0, errors.New("refusing to delete every row of t3 with an empty filter")
+	}
+	result, err := db.ExecContext(ctx,
+		` + "`DELETE FROM t3`" + `+where,
+		args...)
+	if err != nil {
+		return 0, errors.WithStack(translateError("t3", err))
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.WithStack(translateError("t3", err))
+	}
+	return n, nil
+}
+// T4 represents public.t4
+type T4 struct {
+	ID int // id
+	I  int // i
+}
+
+// T4Pk represents the primary key of public.t4
+type T4Pk struct {
+	ID int // id
+	I  int // i
+}
+// Create inserts the T4 to the database.
+//
+// Deprecated: Use CreateContext instead.
+func (r *T4) Create(db Queryer) error {
+	return r.CreateContext(context.Background(), db)
+}
+
+// GetT4ByPk select the T4 from the database.
+//
+// Deprecated: Use GetT4ByPkContext instead.
+func GetT4ByPk(db Queryer, pk0 int, pk1 int) (*T4, error) {
+	return GetT4ByPkContext(context.Background(), db, pk0, pk1)
+}
+
+// Validate checks the T4 against the constraints of t4 which can be checked
+// without the database, and returns *ValidationError listing every failing field.
+func (r *T4) Validate() error {
+	return nil
+}
+
+// CreateContext inserts the T4 to the database.
+func (r *T4) CreateContext(ctx context.Context, db Queryer) error {
+	if err := beforeCreate(ctx, r); err != nil {
+		return err
+	}
+	_, err := db.ExecContext(ctx,
+		` + "`INSERT INTO t4 (id, i) VALUES ($1, $2)`" + `,
+		&r.ID, &r.I)
+	if err != nil {
+		return errors.WithStack(translateError("t4", err))
+	}
+	return afterCreate(ctx, r)
+}
+
+// CreateOnConflictDoNothing inserts the T4 to the database.
+// If a conflict occurs (e.g., unique constraint violation), the insert is skipped without error.
+// Returns true if the row was inserted, false if it was skipped due to conflict.
+func (r *T4) CreateOnConflictDoNothing(ctx context.Context, db Queryer) (bool, error) {
+	if err := beforeCreate(ctx, r); err != nil {
+		return false, err
+	}
+	result, err := db.ExecContext(ctx,
+		` + "`INSERT INTO t4 (id, i) VALUES ($1, $2) ON CONFLICT DO NOTHING`" + `,
+		&r.ID, &r.I)
+	if err != nil {
+		return false, errors.WithStack(translateError("t4", err))
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, errors.WithStack(translateError("t4", err))
+	}
+	if rowsAffected == 0 {
+		return false, nil
+	}
+	return true, afterCreate(ctx, r)
+}
+
+// DeleteContext deletes the T4 from the database by the primary key.
+// *NotFoundError is returned if the row is not found.
+func (r *T4) DeleteContext(ctx context.Context, db Queryer) error {
+	if err := beforeDelete(ctx, r); err != nil {
+		return err
+	}
+	result, err := db.ExecContext(ctx,
+		` + "`DELETE FROM t4 WHERE id = $1 AND i = $2`" + `,
+		&r.ID, &r.I)
+	if err != nil {
+		return errors.WithStack(translateError("t4", err))
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return errors.WithStack(translateError("t4", err))
+	}
+	if n == 0 {
+		err = sql.ErrNoRows
+		return errors.WithStack(translateError("t4", err))
+	}
+	return afterDelete(ctx, r)
+}
+
+// ReloadContext selects the T4 again by its primary key, and overwrites the fields with the
+// values in the database, e.g. after a trigger or a stored procedure changed the row.
+// *NotFoundError is returned if the row is not found.
+func (r *T4) ReloadContext(ctx context.Context, db Queryer) error {
+	err := db.QueryRowContext(ctx,
+		` + "`SELECT id, i FROM t4 WHERE id = $1 AND i = $2`" + `,
+		r.ID, r.I).Scan(&r.ID, &r.I)
+	if err != nil {
+		return errors.WithStack(translateError("t4", err))
+	}
+	return nil
+}
+
+// GetT4ByPkContext select the T4 from the database.
+func GetT4ByPkContext(ctx context.Context, db Queryer, pk0 int, pk1 int) (*T4, error) {
+	var r T4
+	err := db.QueryRowContext(ctx,
+		` + "`SELECT id, i FROM t4 WHERE id = $1 AND i = $2`" + `,
+		pk0, pk1).Scan(&r.ID, &r.I)
+	if err != nil {
+		return nil, errors.WithStack(translateError("t4", err))
+	}
+	return &r, nil
+}
+
+// GetT4ByPkForUpdateContext selects the T4 from the database with FOR UPDATE,
+// which locks the row until the end of the transaction. opt decides what happens if the row is locked
+// by another transaction: LockWait waits for it, LockNoWait fails with SQLSTATE 55P03, and
+// LockSkipLocked returns *NotFoundError.
+func GetT4ByPkForUpdateContext(ctx context.Context, db Queryer, pk0 int, pk1 int, opt LockOption) (*T4, error) {
+	var r T4
+	err := db.QueryRowContext(ctx,
+		` + "`SELECT id, i FROM t4 WHERE id = $1 AND i = $2 FOR UPDATE`" + `+opt.sql(),
+		pk0, pk1).Scan(&r.ID, &r.I)
+	if err != nil {
+		return nil, errors.WithStack(translateError("t4", err))
+	}
+	return &r, nil
+}
+
+// GetT4ByPkForShareContext selects the T4 from the database with FOR SHARE,
+// which locks the row until the end of the transaction. opt decides what happens if the row is locked
+// by another transaction: LockWait waits for it, LockNoWait fails with SQLSTATE 55P03, and
+// LockSkipLocked returns *NotFoundError.
+func GetT4ByPkForShareContext(ctx context.Context, db Queryer, pk0 int, pk1 int, opt LockOption) (*T4, error) {
+	var r T4
+	err := db.QueryRowContext(ctx,
+		` + "`SELECT id, i FROM t4 WHERE id = $1 AND i = $2 FOR SHARE`" + `+opt.sql(),
+		pk0, pk1).Scan(&r.ID, &r.I)
+	if err != nil {
+		return nil, errors.WithStack(translateError("t4", err))
+	}
+	return &r, nil
+}
+
+// ExistsT4ByPkContext checks if the T4 exists in the database.
+func ExistsT4ByPkContext(ctx context.Context, db Queryer, pk0 int, pk1 int) (bool, error) {
+	var exists bool
+	err := db.QueryRowContext(ctx,
+		` + "`SELECT EXISTS (SELECT 1 FROM t4 WHERE id = $1 AND i = $2)`" + `,
+		pk0, pk1).Scan(&exists)
+	if err != nil {
+		return false, errors.WithStack(translateError("t4", err))
+	}
+	return exists, nil
+}
+
+// GetT4ByPksContext select the T4s of the given primary keys from the database.
+// The result is ordered by the primary key, and the keys which do not exist are skipped.
+func GetT4ByPksContext(ctx context.Context, db Queryer, pks []T4Pk) ([]*T4, error) {
+	if len(pks) == 0 {
+		return nil, nil
+	}
+	values := make([]string, 0, len(pks))
+	args := make([]interface{}, 0, len(pks)*2)
+	for _, pk := range pks {
+		args = append(args, pk.ID, pk.I)
+		values = append(values, fmt.Sprintf("($%d::integer, $%d::integer)", len(args)-1, len(args)))
+	}
+	rows, err := db.QueryContext(ctx,
+		fmt.Sprintf(` + "`SELECT id, i FROM t4 WHERE (id, i) IN (VALUES %s) ORDER BY id, i`" + `, strings.Join(values, ", ")),
+		args...)
+	if err != nil {
+		return nil, errors.WithStack(translateError("t4", err))
+	}
+	defer rows.Close()
+	var rs []*T4
+	for rows.Next() {
+		var r T4
+		if err := rows.Scan(&r.ID, &r.I); err != nil {
+			return nil, errors.WithStack(translateError("t4", err))
+		}
+		rs = append(rs, &r)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.WithStack(translateError("t4", err))
+	}
+	return rs, nil
+}
+
+// T4ListOptions is the options of ListT4.
+type T4ListOptions struct {
+	// Limit is the maximum number of rows to return. Zero means no limit.
+	Limit int
+	// After is the primary key of the last row of the previous page.
+	// Only the rows after it are returned when it is not nil.
+	After *T4Pk
+}
+
+// ListT4 lists the T4s ordered by the primary key.
+// Pass the primary key of the last row as opts.After to get the next page.
+func ListT4(ctx context.Context, db Queryer, opts T4ListOptions) ([]*T4, error) {
+	q := ` + "`SELECT id, i FROM t4`" + `
+	var args []interface{}
+	if opts.After != nil {
+		q += ` + "` WHERE (id, i) > ($1, $2)`" + `
+		args = append(args, opts.After.ID, opts.After.I)
+	}
+	q += ` + "` ORDER BY id, i`" + `
+	if opts.Limit > 0 {
+		args = append(args, opts.Limit)
+		q += fmt.Sprintf(` + "` LIMIT $%d`" + `, len(args))
+	}
+	rows, err := db.QueryContext(ctx, q, args...)
+	if err != nil {
+		return nil, errors.WithStack(translateError("t4", err))
+	}
+	defer rows.Close()
+	var rs []*T4
+	for rows.Next() {
+		var r T4
+		if err := rows.Scan(&r.ID, &r.I); err != nil {
+			return nil, errors.WithStack(translateError("t4", err))
+		}
+		rs = append(rs, &r)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.WithStack(translateError("t4", err))
+	}
+	return rs, nil
+}
+
+// T4Filter builds a parameterized WHERE clause of t4.
+// The predicates are combined with AND.
+type T4Filter struct {
+	conds []string
+	args  []interface{}
+}
+
+// NewT4Filter creates an empty T4Filter, which matches every row.
+func NewT4Filter() *T4Filter {
+	return &T4Filter{}
+}
+
+func (f *T4Filter) add(cond string, v interface{}) *T4Filter {
+	f.args = append(f.args, v)
+	f.conds = append(f.conds, fmt.Sprintf(cond, len(f.args)))
+	return f
+}
+
+// Where returns the WHERE clause and its arguments. It returns an empty clause
+// if the filter is nil or has no predicate.
+func (f *T4Filter) Where() (string, []interface{}) {
+	if f == nil || len(f.conds) == 0 {
+		return "", nil
+	}
+	return " WHERE " + strings.Join(f.conds, " AND "), f.args
+}
+
+// IDEq filters the rows whose id equals to v.
+func (f *T4Filter) IDEq(v int) *T4Filter {
+	return f.add("id = $%d", v)
+}
+
+// IDIn filters the rows whose id is one of vs.
+func (f *T4Filter) IDIn(vs ...int) *T4Filter {
+	return f.add("id = ANY($%d)", pq.Array(vs))
+}
+
+// IDLt filters the rows whose id is less than v.
+func (f *T4Filter) IDLt(v int) *T4Filter {
+	return f.add("id < $%d", v)
+}
+
+// IDGt filters the rows whose id is greater than v.
+func (f *T4Filter) IDGt(v int) *T4Filter {
+	return f.add("id > $%d", v)
+}
+
+// IEq filters the rows whose i equals to v.
+func (f *T4Filter) IEq(v int) *T4Filter {
+	return f.add("i = $%d", v)
+}
+
+// IIn filters the rows whose i is one of vs.
+func (f *T4Filter) IIn(vs ...int) *T4Filter {
+	return f.add("i = ANY($%d)", pq.Array(vs))
+}
+
+// ILt filters the rows whose i is less than v.
+func (f *T4Filter) ILt(v int) *T4Filter {
+	return f.add("i < $%d", v)
+}
+
+// IGt filters the rows whose i is greater than v.
+func (f *T4Filter) IGt(v int) *T4Filter {
+	return f.add("i > $%d", v)
+}
+
+// FindT4 selects the T4s matching the filter from the database.
+// The result is ordered by the primary key.
+func FindT4(ctx context.Context, db Queryer, f *T4Filter) ([]*T4, error) {
+	where, args := f.Where()
+	rows, err := db.QueryContext(ctx,
+		` + "`SELECT id, i FROM t4`" + `+where+` + "` ORDER BY id, i`" + `,
+		args...)
+	if err != nil {
+		return nil, errors.WithStack(translateError("t4", err))
+	}
+	defer rows.Close()
+	var rs []*T4
+	for rows.Next() {
+		var r T4
+		if err := rows.Scan(&r.ID, &r.I); err != nil {
+			return nil, errors.WithStack(translateError("t4", err))
+		}
+		rs = append(rs, &r)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.WithStack(translateError("t4", err))
+	}
+	return rs, nil
+}
+
+// CountT4 counts the T4s matching the filter in the database.
+// Pass nil as the filter to count every row.
+func CountT4(ctx context.Context, db Queryer, f *T4Filter) (int64, error) {
+	where, args := f.Where()
+	var n int64
+	err := db.QueryRowContext(ctx,
+		` + "`SELECT count(*) FROM t4`" + `+where,
+		args...).Scan(&n)
+	if err != nil {
+		return 0, errors.WithStack(translateError("t4", err))
+	}
+	return n, nil
+}
+
+// DeleteT4Where deletes the T4s matching the filter from the database,
+// and returns the number of the deleted rows. A filter without predicate is rejected
+// to avoid deleting every row by mistake.
+func DeleteT4Where(ctx context.Context, db Queryer, f *T4Filter) (int64, error) {
+	where, args := f.Where()
+	if where == "" {
+		return 0, errors.New("refusing to delete every row of t4 with an empty filter")
+	}
+	result, err := db.ExecContext(ctx,
+		` + "`DELETE FROM t4`" + `+where,
+		args...)
+	if err != nil {
+		return 0, errors.WithStack(translateError("t4", err))
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.WithStack(translateError("t4", err))
+	}
+	return n, nil
+}
+// T5 represents public.t5
+//
+// Deprecated: T5 is no longer maintained
+type T5 struct {
+	ID int // id
+	I  int // i
+}
+
+// T5Pk represents the primary key of public.t5
+type T5Pk struct {
+	ID int // id
+	I  int // i
+}
+// Create inserts the T5 to the database.
+//
+// Deprecated: T5 is no longer maintained
+func (r *T5) Create(db Queryer) error {
+	return r.CreateContext(context.Background(), db)
+}
+
+// GetT5ByPk select the T5 from the database.
+//
+// Deprecated: T5 is no longer maintained
+func GetT5ByPk(db Queryer, pk0 int, pk1 int) (*T5, error) {
+	return GetT5ByPkContext(context.Background(), db, pk0, pk1)
+}
+
+// Validate checks the T5 against the constraints of t5 which can be checked
+// without the database, and returns *ValidationError listing every failing field.
+//
+// Deprecated: T5 is no longer maintained
+func (r *T5) Validate() error {
+	return nil
+}
+
+// CreateContext inserts the T5 to the database.
+//
+// Deprecated: T5 is no longer maintained
+func (r *T5) CreateContext(ctx context.Context, db Queryer) error {
+	if err := beforeCreate(ctx, r); err != nil {
+		return err
+	}
+	err := db.QueryRowContext(ctx,
+		` + "`INSERT INTO t5 (i) VALUES ($1) RETURNING id`" + `,
+		&r.I).Scan(&r.ID)
+	if err != nil {
+		return errors.WithStack(translateError("t5", err))
+	}
+	return afterCreate(ctx, r)
+}
+
+// CreateOnConflictDoNothing inserts the T5 to the database.
+// If a conflict occurs (e.g., unique constraint violation), the insert is skipped without error.
+// Returns true if the row was inserted, false if it was skipped due to conflict.
+//
+// Deprecated: T5 is no longer maintained
+func (r *T5) CreateOnConflictDoNothing(ctx context.Context, db Queryer) (bool, error) {
+	if err := beforeCreate(ctx, r); err != nil {
+		return false, err
+	}
+	err := db.QueryRowContext(ctx,
+		` + "`INSERT INTO t5 (i) VALUES ($1) ON CONFLICT DO NOTHING RETURNING id`" + `,
+		&r.I).Scan(&r.ID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return false, nil
+		}
+		return false, errors.WithStack(translateError("t5", err))
+	}
+	// Row was successfully inserted
+	return true, afterCreate(ctx, r)
+}
+
+// DeleteContext deletes the T5 from the database by the primary key.
+// *NotFoundError is returned if the row is not found.
+//
+// Deprecated: T5 is no longer maintained
+func (r *T5) DeleteContext(ctx context.Context, db Queryer) error {
+	if err := beforeDelete(ctx, r); err != nil {
+		return err
+	}
+	result, err := db.ExecContext(ctx,
+		` + "`DELETE FROM t5 WHERE id = $1 AND i = $2`" + `,
+		&r.ID, &r.I)
+	if err != nil {
+		return errors.WithStack(translateError("t5", err))
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return errors.WithStack(translateError("t5", err))
+	}
+	if n == 0 {
+		err = sql.ErrNoRows
+		return errors.WithStack(translateError("t5", err))
+	}
+	return afterDelete(ctx, r)
+}
+
+// ReloadContext selects the T5 again by its primary key, and overwrites the fields with the
+// values in the database, e.g. after a trigger or a stored procedure changed the row.
+// *NotFoundError is returned if the row is not found.
+//
+// Deprecated: T5 is no longer maintained
+func (r *T5) ReloadContext(ctx context.Context, db Queryer) error {
+	err := db.QueryRowContext(ctx,
+		` + "`SELECT id, i FROM t5 WHERE id = $1 AND i = $2`" + `,
+		r.ID, r.I).Scan(&r.ID, &r.I)
+	if err != nil {
+		return errors.WithStack(translateError("t5", err))
+	}
+	return nil
+}
+
+// GetT5ByPkContext select the T5 from the database.
+//
+// Deprecated: T5 is no longer maintained
+func GetT5ByPkContext(ctx context.Context, db Queryer, pk0 int, pk1 int) (*T5, error) {
+	var r T5
+	err := db.QueryRowContext(ctx,
+		` + "`SELECT id, i FROM t5 WHERE id = $1 AND i = $2`" + `,
+		pk0, pk1).Scan(&r.ID, &r.I)
+	if err != nil {
+		return nil, errors.WithStack(translateError("t5", err))
+	}
+	return &r, nil
+}
+
+// GetT5ByPkForUpdateContext selects the T5 from the database with FOR UPDATE,
+// which locks the row until the end of the transaction. opt decides what happens if the row is locked
+// by another transaction: LockWait waits for it, LockNoWait fails with SQLSTATE 55P03, and
+// LockSkipLocked returns *NotFoundError.
+//
+// Deprecated: T5 is no longer maintained
+func GetT5ByPkForUpdateContext(ctx context.Context, db Queryer, pk0 int, pk1 int, opt LockOption) (*T5, error) {
+	var r T5
+	err := db.QueryRowContext(ctx,
+		` + "`SELECT id, i FROM t5 WHERE id = $1 AND i = $2 FOR UPDATE`" + `+opt.sql(),
+		pk0, pk1).Scan(&r.ID, &r.I)
+	if err != nil {
+		return nil, errors.WithStack(translateError("t5", err))
+	}
+	return &r, nil
+}
+
+// GetT5ByPkForShareContext selects the T5 from the database with FOR SHARE,
+// which locks the row until the end of the transaction. opt decides what happens if the row is locked
+// by another transaction: LockWait waits for it, LockNoWait fails with SQLSTATE 55P03, and
+// LockSkipLocked returns *NotFoundError.
+//
+// Deprecated: T5 is no longer maintained
+func GetT5ByPkForShareContext(ctx context.Context, db Queryer, pk0 int, pk1 int, opt LockOption) (*T5, error) {
+	var r T5
+	err := db.QueryRowContext(ctx,
+		` + "`SELECT id, i FROM t5 WHERE id = $1 AND i = $2 FOR SHARE`" + `+opt.sql(),
+		pk0, pk1).Scan(&r.ID, &r.I)
+	if err != nil {
+		return nil, errors.WithStack(translateError("t5", err))
+	}
+	return &r, nil
+}
+
+// ExistsT5ByPkContext checks if the T5 exists in the database.
+//
+// Deprecated: T5 is no longer maintained
+func ExistsT5ByPkContext(ctx context.Context, db Queryer, pk0 int, pk1 int) (bool, error) {
+	var exists bool
+	err := db.QueryRowContext(ctx,
+		` + "`SELECT EXISTS (SELECT 1 FROM t5 WHERE id = $1 AND i = $2)`" + `,
+		pk0, pk1).Scan(&exists)
+	if err != nil {
+		return false, errors.WithStack(translateError("t5", err))
+	}
+	return exists, nil
+}
+
+// GetT5ByPksContext select the T5s of the given primary keys from the database.
+// The result is ordered by the primary key, and the keys which do not exist are skipped.
+//
+// Deprecated: T5 is no longer maintained
+func GetT5ByPksContext(ctx context.Context, db Queryer, pks []T5Pk) ([]*T5, error) {
+	if len(pks) == 0 {
+		return nil, nil
+	}
+	values := make([]string, 0, len(pks))
+	args := make([]interface{}, 0, len(pks)*2)
+	for _, pk := range pks {
+		args = append(args, pk.ID, pk.I)
+		values = append(values, fmt.Sprintf("($%d::integer, $%d::integer)", len(args)-1, len(args)))
+	}
+	rows, err := db.QueryContext(ctx,
+		fmt.Sprintf(` + "`SELECT id, i FROM t5 WHERE (id, i) IN (VALUES %s) ORDER BY id, i`" + `, strings.Join(values, ", ")),
+		args...)
+	if err != nil {
+		return nil, errors.WithStack(translateError("t5", err))
+	}
+	defer rows.Close()
+	var rs []*T5
+	for rows.Next() {
+		var r T5
+		if err := rows.Scan(&r.ID, &r.I); err != nil {
+			return nil, errors.WithStack(translateError("t5", err))
+		}
+		rs = append(rs, &r)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.WithStack(translateError("t5", err))
+	}
+	return rs, nil
+}
+
+// T5ListOptions is the options of ListT5.
+type T5ListOptions struct {
+	// Limit is the maximum number of rows to return. Zero means no limit.
+	Limit int
+	// After is the primary key of the last row of the previous page.
+	// Only the rows after it are returned when it is not nil.
+	After *T5Pk
+}
+
+// ListT5 lists the T5s ordered by the primary key.
+// Pass the primary key of the last row as opts.After to get the next page.
+//
+// Deprecated: T5 is no longer maintained
+func ListT5(ctx context.Context, db Queryer, opts T5ListOptions) ([]*T5, error) {
+	q := ` + "`SELECT id, i FROM t5`" + `
+	var args []interface{}
+	if opts.After != nil {
+		q += ` + "` WHERE (id, i) > ($1, $2)`" + `
+		args = append(args, opts.After.ID, opts.After.I)
+	}
+	q += ` + "` ORDER BY id, i`" + `
+	if opts.Limit > 0 {
+		args = append(args, opts.Limit)
+		q += fmt.Sprintf(` + "` LIMIT $%d`" + `, len(args))
+	}
+	rows, err := db.QueryContext(ctx, q, args...)
+	if err != nil {
+		return nil, errors.WithStack(translateError("t5", err))
+	}
+	defer rows.Close()
+	var rs []*T5
+	for rows.Next() {
+		var r T5
+		if err := rows.Scan(&r.ID, &r.I); err != nil {
+			return nil, errors.WithStack(translateError("t5", err))
+		}
+		rs = append(rs, &r)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.WithStack(translateError("t5", err))
+	}
+	return rs, nil
+}
+
+// T5Filter builds a parameterized WHERE clause of t5.
+// The predicates are combined with AND.
+//
+// Deprecated: T5 is no longer maintained
+type T5Filter struct {
+	conds []string
+	args  []interface{}
+}
+
+// NewT5Filter creates an empty T5Filter, which matches every row.
+func NewT5Filter() *T5Filter {
+	return &T5Filter{}
+}
+
+func (f *T5Filter) add(cond string, v interface{}) *T5Filter {
+	f.args = append(f.args, v)
+	f.conds = append(f.conds, fmt.Sprintf(cond, len(f.args)))
+	return f
+}
+
+// Where returns the WHERE clause and its arguments. It returns an empty clause
+// if the filter is nil or has no predicate.
+func (f *T5Filter) Where() (string, []interface{}) {
+	if f == nil || len(f.conds) == 0 {
+		return "", nil
+	}
+	return " WHERE " + strings.Join(f.conds, " AND "), f.args
+}
+
+// IDEq filters the rows whose id equals to v.
+func (f *T5Filter) IDEq(v int) *T5Filter {
+	return f.add("id = $%d", v)
+}
+
+// IDIn filters the rows whose id is one of vs.
+func (f *T5Filter) IDIn(vs ...int) *T5Filter {
+	return f.add("id = ANY($%d)", pq.Array(vs))
+}
+
+// IDLt filters the rows whose id is less than v.
+func (f *T5Filter) IDLt(v int) *T5Filter {
+	return f.add("id < $%d", v)
+}
+
+// IDGt filters the rows whose id is greater than v.
+func (f *T5Filter) IDGt(v int) *T5Filter {
+	return f.add("id > $%d", v)
+}
+
+// IEq filters the rows whose i equals to v.
+func (f *T5Filter) IEq(v int) *T5Filter {
+	return f.add("i = $%d", v)
+}
+
+// IIn filters the rows whose i is one of vs.
+func (f *T5Filter) IIn(vs ...int) *T5Filter {
+	return f.add("i = ANY($%d)", pq.Array(vs))
+}
+
+// ILt filters the rows whose i is less than v.
+func (f *T5Filter) ILt(v int) *T5Filter {
+	return f.add("i < $%d", v)
+}
+
+// IGt filters the rows whose i is greater than v.
+func (f *T5Filter) IGt(v int) *T5Filter {
+	return f.add("i > $%d", v)
+}
+
+// FindT5 selects the T5s matching the filter from the database.
+// The result is ordered by the primary key.
+//
+// Deprecated: T5 is no longer maintained
+func FindT5(ctx context.Context, db Queryer, f *T5Filter) ([]*T5, error) {
+	where, args := f.Where()
+	rows, err := db.QueryContext(ctx,
+		` + "`SELECT id, i FROM t5`" + `+where+` + "` ORDER BY id, i`" + `,
+		args...)
+	if err != nil {
+		return nil, errors.WithStack(translateError("t5", err))
+	}
+	defer rows.Close()
+	var rs []*T5
+	for rows.Next() {
+		var r T5
+		if err := rows.Scan(&r.ID, &r.I); err != nil {
+			return nil, errors.WithStack(translateError("t5", err))
+		}
+		rs = append(rs, &r)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.WithStack(translateError("t5", err))
+	}
+	return rs, nil
+}
+
+// CountT5 counts the T5s matching the filter in the database.
+// Pass nil as the filter to count every row.
+//
+// Deprecated: T5 is no longer maintained
+func CountT5(ctx context.Context, db Queryer, f *T5Filter) (int64, error) {
+	where, args := f.Where()
+	var n int64
+	err := db.QueryRowContext(ctx,
+		` + "`SELECT count(*) FROM t5`" + `+where,
+		args...).Scan(&n)
+	if err != nil {
+		return 0, errors.WithStack(translateError("t5", err))
+	}
+	return n, nil
+}
+
+// DeleteT5Where deletes the T5s matching the filter from the database,
+// and returns the number of the deleted rows. A filter without predicate is rejected
+// to avoid deleting every row by mistake.
+//
+// Deprecated: T5 is no longer maintained
+func DeleteT5Where(ctx context.Context, db Queryer, f *T5Filter) (int64, error) {
+	where, args := f.Where()
+	if where == "" {
+		return 0, errors.New("refusing to delete every row of t5 with an empty filter")
+	}
+	result, err := db.ExecContext(ctx,
+		` + "`DELETE FROM t5`" + `+where,
+		args...)
+	if err != nil {
+		return 0, errors.WithStack(translateError("t5", err))
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.WithStack(translateError("t5", err))
+	}
+	return n, nil
+}
+// T6 represents public.t6
+type T6 struct {
+	ID int // id
+	I  int // i
+}
+
+// T6Pk represents the primary key of public.t6
+type T6Pk struct {
+	ID int // id
+	I  int // i
+}
+// Create inserts the T6 to the database.
+//
+// Deprecated: Use CreateContext instead.
+func (r *T6) Create(db Queryer) error {
+	return r.CreateContext(context.Background(), db)
+}
+
+// GetT6ByPk select the T6 from the database.
+//
+// Deprecated: Use GetT6ByPkContext instead.
+func GetT6ByPk(db Queryer, pk0 int, pk1 int) (*T6, error) {
+	return GetT6ByPkContext(context.Background(), db, pk0, pk1)
+}
+
+// Validate checks the T6 against the constraints of t6 which can be checked
+// without the database, and returns *ValidationError listing every failing field.
+func (r *T6) Validate() error {
+	return nil
+}
+
+// CreateContext inserts the T6 to the database.
+func (r *T6) CreateContext(ctx context.Context, db Queryer) error {
+	if err := beforeCreate(ctx, r); err != nil {
+		return err
+	}
+	err := db.QueryRowContext(ctx,
+		` + "`INSERT INTO t6 (i) VALUES ($1) RETURNING id`" + `,
+		&r.I).Scan(&r.ID)
+	if err != nil {
+		return errors.WithStack(translateError("t6", err))
+	}
+	return afterCreate(ctx, r)
+}
+
+// CreateOnConflictDoNothing inserts the T6 to the database.
+// If a conflict occurs (e.g., unique constraint violation), the insert is skipped without error.
+// Returns true if the row was inserted, false if it was skipped due to conflict.
+func (r *T6) CreateOnConflictDoNothing(ctx context.Context, db Queryer) (bool, error) {
+	if err := beforeCreate(ctx, r); err != nil {
+		return false, err
+	}
+	err := db.QueryRowContext(ctx,
+		` + "`INSERT INTO t6 (i) VALUES ($1) ON CONFLICT DO NOTHING RETURNING id`" + `,
+		&r.I).Scan(&r.ID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return false, nil
+		}
+		return false, errors.WithStack(translateError("t6", err))
+	}
+	// Row was successfully inserted
+	return true, afterCreate(ctx, r)
+}
+
+// DeleteContext deletes the T6 from the database by the primary key.
+// *NotFoundError is returned if the row is not found.
+func (r *T6) DeleteContext(ctx context.Context, db Queryer) error {
+	if err := beforeDelete(ctx, r); err != nil {
+		return err
+	}
+	result, err := db.ExecContext(ctx,
+		` + "`DELETE FROM t6 WHERE id = $1 AND i = $2`" + `,
+		&r.ID, &r.I)
+	if err != nil {
+		return errors.WithStack(translateError("t6", err))
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return errors.WithStack(translateError("t6", err))
+	}
+	if n == 0 {
+		err = sql.ErrNoRows
+		return errors.WithStack(translateError("t6", err))
+	}
+	return afterDelete(ctx, r)
+}
+
+// ReloadContext selects the T6 again by its primary key, and overwrites the fields with the
+// values in the database, e.g. after a trigger or a stored procedure changed the row.
+// *NotFoundError is returned if the row is not found.
+func (r *T6) ReloadContext(ctx context.Context, db Queryer) error {
+	err := db.QueryRowContext(ctx,
+		` + "`SELECT id, i FROM t6 WHERE id = $1 AND i = $2`" + `,
+		r.ID, r.I).Scan(&r.ID, &r.I)
+	if err != nil {
+		return errors.WithStack(translateError("t6", err))
+	}
+	return nil
+}
+
+// GetT6ByPkContext select the T6 from the database.
+func GetT6ByPkContext(ctx context.Context, db Queryer, pk0 int, pk1 int) (*T6, error) {
+	var r T6
+	err := db.QueryRowContext(ctx,
+		` + "`SELECT id, i FROM t6 WHERE id = $1 AND i = $2`" + `,
+		pk0, pk1).Scan(&r.ID, &r.I)
+	if err != nil {
+		return nil, errors.WithStack(translateError("t6", err))
+	}
+	return &r, nil
+}
+
+// GetT6ByPkForUpdateContext selects the T6 from the database with FOR UPDATE,
+// which locks the row until the end of the transaction. opt decides what happens if the row is locked
+// by another transaction: LockWait waits for it, LockNoWait fails with SQLSTATE 55P03, and
+// LockSkipLocked returns *NotFoundError.
+func GetT6ByPkForUpdateContext(ctx context.Context, db Queryer, pk0 int, pk1 int, opt LockOption) (*T6, error) {
+	var r T6
+	err := db.QueryRowContext(ctx,
+		` + "`SELECT id, i FROM t6 WHERE id = $1 AND i = $2 FOR UPDATE`" + `+opt.sql(),
+		pk0, pk1).Scan(&r.ID, &r.I)
+	if err != nil {
+		return nil, errors.WithStack(translateError("t6", err))
+	}
+	return &r, nil
+}
+
+// GetT6ByPkForShareContext selects the T6 from the database with FOR SHARE,
+// which locks the row until the end of the transaction. opt decides what happens if the row is locked
+// by another transaction: LockWait waits for it, LockNoWait fails with SQLSTATE 55P03, and
+// LockSkipLocked returns *NotFoundError.
+func GetT6ByPkForShareContext(ctx context.Context, db Queryer, pk0 int, pk1 int, opt LockOption) (*T6, error) {
+	var r T6
+	err := db.QueryRowContext(ctx,
+		` + "`SELECT id, i FROM t6 WHERE id = $1 AND i = $2 FOR SHARE`" + `+opt.sql(),
+		pk0, pk1).Scan(&r.ID, &r.I)
+	if err != nil {
+		return nil, errors.WithStack(translateError("t6", err))
+	}
+	return &r, nil
+}
+
+// ExistsT6ByPkContext checks if the T6 exists in the database.
+func ExistsT6ByPkContext(ctx context.Context, db Queryer, pk0 int, pk1 int) (bool, error) {
+	var exists bool
+	err := db.QueryRowContext(ctx,
+		` + "`SELECT EXISTS (SELECT 1 FROM t6 WHERE id = $1 AND i = $2)`" + `,
+		pk0, pk1).Scan(&exists)
+	if err != nil {
+		return false, errors.WithStack(translateError("t6", err))
+	}
+	return exists, nil
+}
+
+// GetT6ByPksContext select the T6s of the given primary keys from the database.
+// The result is ordered by the primary key, and the keys which do not exist are skipped.
+func GetT6ByPksContext(ctx context.Context, db Queryer, pks []T6Pk) ([]*T6, error) {
+	if len(pks) == 0 {
+		return nil, nil
+	}
+	values := make([]string, 0, len(pks))
+	args := make([]interface{}, 0, len(pks)*2)
+	for _, pk := range pks {
+		args = append(args, pk.ID, pk.I)
+		values = append(values, fmt.Sprintf("($%d::integer, $%d::integer)", len(args)-1, len(args)))
+	}
+	rows, err := db.QueryContext(ctx,
+		fmt.Sprintf(` + "`SELECT id, i FROM t6 WHERE (id, i) IN (VALUES %s) ORDER BY id, i`" + `, strings.Join(values, ", ")),
+		args...)
+	if err != nil {
+		return nil, errors.WithStack(translateError("t6", err))
+	}
+	defer rows.Close()
+	var rs []*T6
+	for rows.Next() {
+		var r T6
+		if err := rows.Scan(&r.ID, &r.I); err != nil {
+			return nil, errors.WithStack(translateError("t6", err))
+		}
+		rs = append(rs, &r)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.WithStack(translateError("t6", err))
+	}
+	return rs, nil
+}
+
+// T6ListOptions is the options of ListT6.
+type T6ListOptions struct {
+	// Limit is the maximum number of rows to return. Zero means no limit.
+	Limit int
+	// After is the primary key of the last row of the previous page.
+	// Only the rows after it are returned when it is not nil.
+	After *T6Pk
+}
+
+// ListT6 lists the T6s ordered by the primary key.
+// Pass the primary key of the last row as opts.After to get the next page.
+func ListT6(ctx context.Context, db Queryer, opts T6ListOptions) ([]*T6, error) {
+	q := ` + "`SELECT id, i FROM t6`" + `
+	var args []interface{}
+	if opts.After != nil {
+		q += ` + "` WHERE (id, i) > ($1, $2)`" + `
+		args = append(args, opts.After.ID, opts.After.I)
+	}
+	q += ` + "` ORDER BY id, i`" + `
+	if opts.Limit > 0 {
+		args = append(args, opts.Limit)
+		q += fmt.Sprintf(` + "` LIMIT $%d`" + `, len(args))
+	}
+	rows, err := db.QueryContext(ctx, q, args...)
+	if err != nil {
+		return nil, errors.WithStack(translateError("t6", err))
+	}
+	defer rows.Close()
+	var rs []*T6
+	for rows.Next() {
+		var r T6
+		if err := rows.Scan(&r.ID, &r.I); err != nil {
+			return nil, errors.WithStack(translateError("t6", err))
+		}
+		rs = append(rs, &r)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.WithStack(translateError("t6", err))
+	}
+	return rs, nil
+}
+
+// T6Filter builds a parameterized WHERE clause of t6.
+// The predicates are combined with AND.
+type T6Filter struct {
+	conds []string
+	args  []interface{}
+}
+
+// NewT6Filter creates an empty T6Filter, which matches every row.
+func NewT6Filter() *T6Filter {
+	return &T6Filter{}
+}
+
+func (f *T6Filter) add(cond string, v interface{}) *T6Filter {
+	f.args = append(f.args, v)
+	f.conds = append(f.conds, fmt.Sprintf(cond, len(f.args)))
+	return f
+}
+
+// Where returns the WHERE clause and its arguments. It returns an empty clause
+// if the filter is nil or has no predicate.
+func (f *T6Filter) Where() (string, []interface{}) {
+	if f == nil || len(f.conds) == 0 {
+		return "", nil
+	}
+	return " WHERE " + strings.Join(f.conds, " AND "), f.args
+}
+
+// IDEq filters the rows whose id equals to v.
+func (f *T6Filter) IDEq(v int) *T6Filter {
+	return f.add("id = $%d", v)
+}
+
+// IDIn filters the rows whose id is one of vs.
+func (f *T6Filter) IDIn(vs ...int) *T6Filter {
+	return f.add("id = ANY($%d)", pq.Array(vs))
+}
+
+// IDLt filters the rows whose id is less than v.
+func (f *T6Filter) IDLt(v int) *T6Filter {
+	return f.add("id < $%d", v)
+}
+
+// IDGt filters the rows whose id is greater than v.
+func (f *T6Filter) IDGt(v int) *T6Filter {
+	return f.add("id > $%d", v)
+}
+
+// IEq filters the rows whose i equals to v.
+func (f *T6Filter) IEq(v int) *T6Filter {
+	return f.add("i = $%d", v)
+}
+
+// IIn filters the rows whose i is one of vs.
+func (f *T6Filter) IIn(vs ...int) *T6Filter {
+	return f.add("i = ANY($%d)", pq.Array(vs))
+}
+
+// ILt filters the rows whose i is less than v.
+func (f *T6Filter) ILt(v int) *T6Filter {
+	return f.add("i < $%d", v)
+}
+
+// IGt filters the rows whose i is greater than v.
+func (f *T6Filter) IGt(v int) *T6Filter {
+	return f.add("i > $%d", v)
+}
+
+// FindT6 selects the T6s matching the filter from the database.
+// The result is ordered by the primary key.
+func FindT6(ctx context.Context, db Queryer, f *T6Filter) ([]*T6, error) {
+	where, args := f.Where()
+	rows, err := db.QueryContext(ctx,
+		` + "`SELECT id, i FROM t6`" + `+where+` + "` ORDER BY id, i`" + `,
+		args...)
+	if err != nil {
+		return nil, errors.WithStack(translateError("t6", err))
+	}
+	defer rows.Close()
+	var rs []*T6
+	for rows.Next() {
+		var r T6
+		if err := rows.Scan(&r.ID, &r.I); err != nil {
+			return nil, errors.WithStack(translateError("t6", err))
+		}
+		rs = append(rs, &r)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.WithStack(translateError("t6", err))
+	}
+	return rs, nil
+}
+
+// CountT6 counts the T6s matching the filter in the database.
+// Pass nil as the filter to count every row.
+func CountT6(ctx context.Context, db Queryer, f *T6Filter) (int64, error) {
+	where, args := f.Where()
+	var n int64
+	err := db.QueryRowContext(ctx,
+		` + "`SELECT count(*) FROM t6`" + `+where,
+		args...).Scan(&n)
+	if err != nil {
+		return 0, errors.WithStack(translateError("t6", err))
+	}
+	return n, nil
+}
+
+// DeleteT6Where deletes the T6s matching the filter from the database,
+// and returns the number of the deleted rows. A filter without predicate is rejected
+// to avoid deleting every row by mistake.
+func DeleteT6Where(ctx context.Context, db Queryer, f *T6Filter) (int64, error) {
+	where, args := f.Where()
+	if where == "" {
+		return 0, errors.New("refusing to delete every row of t6 with an empty filter")
+	}
+	result, err := db.ExecContext(ctx,
+		` + "`DELETE FROM t6`" + `+where,
+		args...)
+	if err != nil {
+		return 0, errors.WithStack(translateError("t6", err))
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.WithStack(translateError("t6", err))
+	}
+	return n, nil
+}
+
+var (
+	// ErrNotFound is matched by errors.Is when no row is found.
+	ErrNotFound = errors.New("not found")
+	// ErrUniqueViolation is matched by errors.Is on a unique constraint violation.
+	ErrUniqueViolation = errors.New("unique violation")
+	// ErrForeignKeyViolation is matched by errors.Is on a foreign key constraint violation.
+	ErrForeignKeyViolation = errors.New("foreign key violation")
+	// ErrNotNullViolation is matched by errors.Is on a not-null constraint violation.
+	ErrNotNullViolation = errors.New("not null violation")
+	// ErrCheckViolation is matched by errors.Is on a check constraint violation.
+	ErrCheckViolation = errors.New("check violation")
+	// ErrExclusionViolation is matched by errors.Is on an exclusion constraint violation.
+	ErrExclusionViolation = errors.New("exclusion violation")
+	// ErrValidation is matched by errors.Is when Validate fails.
+	ErrValidation = errors.New("validation failed")
+)
+
+// NotFoundError is returned when no row is found in the table.
+type NotFoundError struct {
+	Table string
+	Err   error
+}
+
+func (e *NotFoundError) Error() string { return e.Err.Error() }
+
+// Is reports whether the target is ErrNotFound.
+func (e *NotFoundError) Is(target error) bool { return target == ErrNotFound }
+
+// Unwrap returns the original error, which is sql.ErrNoRows.
+func (e *NotFoundError) Unwrap() error { return e.Err }
+
+// Cause returns the original error for errors.Cause of github.com/pkg/errors.
+func (e *NotFoundError) Cause() error { return e.Err }
+
+// ConstraintError is returned when a statement violates a constraint.
+// Kind is one of ErrUniqueViolation, ErrForeignKeyViolation, ErrNotNullViolation,
+// ErrCheckViolation and ErrExclusionViolation.
+type ConstraintError struct {
+	Kind       error
+	Table      string
+	Column     string
+	Constraint string
+	Err        error
+}
+
+func (e *ConstraintError) Error() string { return e.Err.Error() }
+
+// Is reports whether the target is the Kind of the violation.
+func (e *ConstraintError) Is(target error) bool { return target == e.Kind }
+
+// Unwrap returns the original error, which is *pq.Error.
+func (e *ConstraintError) Unwrap() error { return e.Err }
+
+// Cause returns the original error for errors.Cause of github.com/pkg/errors.
+func (e *ConstraintError) Cause() error { return e.Err }
+
+// FieldError is a field which fails Validate. Constraint is the name of the
+// check constraint, or empty for NOT NULL and the length of the column.
+type FieldError struct {
+	Field      string
+	Column     string
+	Constraint string
+	Message    string
+}
+
+// ValidationError is returned by Validate, listing every failing field.
+type ValidationError struct {
+	Table  string
+	Fields []FieldError
+}
+
+func (e *ValidationError) Error() string {
+	msgs := make([]string, 0, len(e.Fields))
+	for _, f := range e.Fields {
+		msgs = append(msgs, f.Column+" "+f.Message)
+	}
+	return "invalid " + e.Table + ": " + strings.Join(msgs, ", ")
+}
+
+// Is reports whether the target is ErrValidation.
+func (e *ValidationError) Is(target error) bool { return target == ErrValidation }
+
+// LockOption decides what the row lock of GetXByPkForUpdateContext and
+// GetXByPkForShareContext does when the row is locked by another transaction.
+type LockOption int
+
+const (
+	// LockWait waits for the other transaction to end.
+	LockWait LockOption = iota
+	// LockNoWait fails immediately with SQLSTATE 55P03, lock_not_available.
+	LockNoWait
+	// LockSkipLocked skips the row, which is reported as not found.
+	LockSkipLocked
+)
+
+func (o LockOption) sql() string {
+	switch o {
+	case LockNoWait:
+		return " NOWAIT"
+	case LockSkipLocked:
+		return " SKIP LOCKED"
+	}
+	return ""
+}
+
+// BeforeCreateHook is implemented by the structs which run logic before they are inserted.
+type BeforeCreateHook interface {
+	BeforeCreate(ctx context.Context) error
+}
+
+// AfterCreateHook is implemented by the structs which run logic after they are inserted.
+type AfterCreateHook interface {
+	AfterCreate(ctx context.Context) error
+}
+
+// BeforeUpdateHook is implemented by the structs which run logic before they are updated.
+type BeforeUpdateHook interface {
+	BeforeUpdate(ctx context.Context) error
+}
+
+// AfterUpdateHook is implemented by the structs which run logic after they are updated.
+type AfterUpdateHook interface {
+	AfterUpdate(ctx context.Context) error
+}
+
+// BeforeDeleteHook is implemented by the structs which run logic before they are deleted.
+type BeforeDeleteHook interface {
+	BeforeDelete(ctx context.Context) error
+}
+
+// AfterDeleteHook is implemented by the structs which run logic after they are deleted.
+type AfterDeleteHook interface {
+	AfterDelete(ctx context.Context) error
+}
+
+func beforeCreate(ctx context.Context, v interface{}) error {
+	if h, ok := v.(BeforeCreateHook); ok {
+		return h.BeforeCreate(ctx)
+	}
+	return nil
+}
+
+func afterCreate(ctx context.Context, v interface{}) error {
+	if h, ok := v.(AfterCreateHook); ok {
+		return h.AfterCreate(ctx)
+	}
+	return nil
+}
+
+func beforeUpdate(ctx context.Context, v interface{}) error {
+	if h, ok := v.(BeforeUpdateHook); ok {
+		return h.BeforeUpdate(ctx)
+	}
+	return nil
+}
+
+func afterUpdate(ctx context.Context, v interface{}) error {
+	if h, ok := v.(AfterUpdateHook); ok {
+		return h.AfterUpdate(ctx)
+	}
+	return nil
+}
+
+func beforeDelete(ctx context.Context, v interface{}) error {
+	if h, ok := v.(BeforeDeleteHook); ok {
+		return h.BeforeDelete(ctx)
+	}
+	return nil
+}
+
+func afterDelete(ctx context.Context, v interface{}) error {
+	if h, ok := v.(AfterDeleteHook); ok {
+		return h.AfterDelete(ctx)
+	}
+	return nil
+}
+
+// translateError converts sql.ErrNoRows and the SQLSTATE of the constraint
+// violations into NotFoundError and ConstraintError. The other errors are
+// returned as they are.
+func translateError(table string, err error) error {
+	if err == sql.ErrNoRows {
+		return &NotFoundError{Table: table, Err: err}
+	}
+	pqErr, ok := err.(*pq.Error)
+	if !ok {
+		return err
+	}
+	var kind error
+	switch pqErr.Code {
+	case "23505":
+		kind = ErrUniqueViolation
+	case "23503":
+		kind = ErrForeignKeyViolation
+	case "23502":
+		kind = ErrNotNullViolation
+	case "23514":
+		kind = ErrCheckViolation
+	case "23P01":
+		kind = ErrExclusionViolation
+	default:
+		return err
+	}
+	return &ConstraintError{
+		Kind:       kind,
+		Table:      pqErr.Table,
+		Column:     pqErr.Column,
+		Constraint: pqErr.Constraint,
+		Err:        err,
+	}
+}
+`
+
+	assert.Equal(expected, string(src))
+}
+
+func TestPgCreateStructWithQueryer(t *testing.T) {
+	conn, cleanup := testPgSetup(t)
+	defer cleanup()
+	assert := assert.New(t)
+
+	schema := "public"
+	deprecated := []string{"t2", "t5"}
+	src, err := PgCreateStruct(conn, schema, "", "mypkg", "", []string{}, []string{}, []string{}, deprecated, "MyQueryer", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `
+// T1 represents public.t1
+type T1 struct {
+	ID          int64          // id
+	I           int            // i
+	Str         string         // str
+	NullableStr sql.NullString // nullable_str
+	TWithTz     time.Time      // t_with_tz
+	TWithoutTz  time.Time      // t_without_tz
+	Tm          *time.Time     // tm
+}
+// Create inserts the T1 to the database.
+//
+// Deprecated: Use CreateContext instead.
+func (r *T1) Create(db MyQueryer) error {
+	return r.CreateContext(context.Background(), db)
+}
+
+// GetT1ByPk select the T1 from the database.
+//
+// Deprecated: Use GetT1ByPkContext instead.
+func GetT1ByPk(db MyQueryer, pk0 int64) (*T1, error) {
+	return GetT1ByPkContext(context.Background(), db, pk0)
+}
+
+// Validate checks the T1 against the constraints of t1 which can be checked
+// without the database, and returns *ValidationError listing every failing field.
+func (r *T1) Validate() error {
+	var errs []FieldError
+	if r.Str == "" {
+		errs = append(errs, FieldError{Field: "Str", Column: "str", Message: "must not be empty"})
+	}
+	if len(errs) > 0 {
+		return &ValidationError{Table: "t1", Fields: errs}
+	}
+	return nil
+}
+
+// CreateContext inserts the T1 to the database.
+func (r *T1) CreateContext(ctx context.Context, db MyQueryer) error {
+	if err := beforeCreate(ctx, r); err != nil {
+		return err
+	}
+	err := db.QueryRowContext(ctx,
+		` + "`INSERT INTO t1 (i, str, nullable_str, t_with_tz, t_without_tz, tm) VALUES ($1, $2, $3, $4, $5, $6) RETURNING id`" + `,
+		&r.I, &r.Str, &r.NullableStr, &r.TWithTz, &r.TWithoutTz, &r.Tm).Scan(&r.ID)
+	if err != nil {
+		return errors.WithStack(translateError("t1", err))
+	}
+	return afterCreate(ctx, r)
+}
+
+// CreateOnConflictDoNothing inserts the T1 to the database.
+// If a conflict occurs (e.g., unique constraint violation), the insert is skipped without error.
+// Returns true if the row was inserted, false if it was skipped due to conflict.
+func (r *T1) CreateOnConflictDoNothing(ctx context.Context, db MyQueryer) (bool, error) {
+	if err := beforeCreate(ctx, r); err != nil {
+		return false, err
+	}
+	err := db.QueryRowContext(ctx,
+		` + "`INSERT INTO t1 (i, str, nullable_str, t_with_tz, t_without_tz, tm) VALUES ($1, $2, $3, $4, $5, $6) ON CONFLICT DO NOTHING RETURNING id`" + `,
+		&r.I, &r.Str, &r.NullableStr, &r.TWithTz, &r.TWithoutTz, &r.Tm).Scan(&r.ID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return false, nil
+		}
+		return false, errors.WithStack(translateError("t1", err))
+	}
+	// Row was successfully inserted
+	return true, afterCreate(ctx, r)
+}
+
+// UpdateContext updates the T1 in the database by the primary key.
+// *NotFoundError is returned if the row is not found.
+func (r *T1) UpdateContext(ctx context.Context, db MyQueryer) error {
+	if err := beforeUpdate(ctx, r); err != nil {
+		return err
+	}
+	result, err := db.ExecContext(ctx,
+		` + "`UPDATE t1 SET i = $1, str = $2, nullable_str = $3, t_with_tz = $4, t_without_tz = $5, tm = $6 WHERE id = $7`" + `,
+		&r.I, &r.Str, &r.NullableStr, &r.TWithTz, &r.TWithoutTz, &r.Tm, &r.ID)
+	if err != nil {
+		return errors.WithStack(translateError("t1", err))
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return errors.WithStack(translateError("t1", err))
+	}
+	if n == 0 {
+		err = sql.ErrNoRows
+		return errors.WithStack(translateError("t1", err))
+	}
+	return afterUpdate(ctx, r)
+}
+
+// DeleteContext deletes the T1 from the database by the primary key.
+// *NotFoundError is returned if the row is not found.
+func (r *T1) DeleteContext(ctx context.Context, db MyQueryer) error {
+	if err := beforeDelete(ctx, r); err != nil {
+		return err
+	}
+	result, err := db.ExecContext(ctx,
+		` + "`DELETE FROM t1 WHERE id = $1`" + `,
+		&r.ID)
+	if err != nil {
+		return errors.WithStack(translateError("t1", err))
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return errors.WithStack(translateError("t1", err))
+	}
+	if n == 0 {
+		err = sql.ErrNoRows
+		return errors.WithStack(translateError("t1", err))
+	}
+	return afterDelete(ctx, r)
+}
+
+// ReloadContext selects the T1 again by its primary key, and overwrites the fields with the
+// values in the database, e.g. after a trigger or a stored procedure changed the row.
+// *NotFoundError is returned if the row is not found.
+func (r *T1) ReloadContext(ctx context.Context, db MyQueryer) error {
+	err := db.QueryRowContext(ctx,
+		` + "`SELECT id, i, str, nullable_str, t_with_tz, t_without_tz, tm FROM t1 WHERE id = $1`" + `,
+		r.ID).Scan(&r.ID, &r.I, &r.Str, &r.NullableStr, &r.TWithTz, &r.TWithoutTz, &r.Tm)
+	if err != nil {
+		return errors.WithStack(translateError("t1", err))
+	}
+	return nil
+}
+
+// GetT1ByPkContext select the T1 from the database.
+func GetT1ByPkContext(ctx context.Context, db MyQueryer, pk0 int64) (*T1, error) {
+	var r T1
+	err := db.QueryRowContext(ctx,
+		` + "`SELECT id, i, str, nullable_str, t_with_tz, t_without_tz, tm FROM t1 WHERE id = $1`" + `,
+		pk0).Scan(&r.ID, &r.I, &r.Str, &r.NullableStr, &r.TWithTz, &r.TWithoutTz, &r.Tm)
+	if err != nil {
+		return nil, errors.WithStack(translateError("t1", err))
+	}
+	return &r, nil
+}
+
+// GetT1ByPkForUpdateContext selects the T1 from the database with FOR UPDATE,
+// which locks the row until the end of the transaction. opt decides what happens if the row is locked
+// by another transaction: LockWait waits for it, LockNoWait fails with SQLSTATE 55P03, and
+// LockSkipLocked returns *NotFoundError.
+func GetT1ByPkForUpdateContext(ctx context.Context, db MyQueryer, pk0 int64, opt LockOption) (*T1, error) {
+	var r T1
+	err := db.QueryRowContext(ctx,
+		` + "`SELECT id, i, str, nullable_str, t_with_tz, t_without_tz, tm FROM t1 WHERE id = $1 FOR UPDATE`" + `+opt.sql(),
+		pk0).Scan(&r.ID, &r.I, &r.Str, &r.NullableStr, &r.TWithTz, &r.TWithoutTz, &r.Tm)
+	if err != nil {
+		return nil, errors.WithStack(translateError("t1", err))
+	}
+	return &r, nil
+}
+
+// GetT1ByPkForShareContext selects the T1 from the database with FOR SHARE,
+// which locks the row until the end of the transaction. opt decides what happens if the row is locked
+// by another transaction: LockWait waits for it, LockNoWait fails with SQLSTATE 55P03, and
+// LockSkipLocked returns *NotFoundError.
+func GetT1ByPkForShareContext(ctx context.Context, db MyQueryer, pk0 int64, opt LockOption) (*T1, error) {
+	var r T1
+	err := db.QueryRowContext(ctx,
+		` + "`SELECT id, i, str, nullable_str, t_with_tz, t_without_tz, tm FROM t1 WHERE id = $1 FOR SHARE`" + `+opt.sql(),
+		pk0).Scan(&r.ID, &r.I, &r.Str, &r.NullableStr, &r.TWithTz, &r.TWithoutTz, &r.Tm)
+	if err != nil {
+		return nil, errors.WithStack(translateError("t1", err))
+	}
+	return &r, nil
+}
+
+// ExistsT1ByPkContext checks if the T1 exists in the database.
+func ExistsT1ByPkContext(ctx context.Context, db MyQueryer, pk0 int64) (bool, error) {
+	var exists bool
+	err := db.QueryRowContext(ctx,
+		` + "`SELECT EXISTS (SELECT 1 FROM t1 WHERE id = $1)`" + `,
+		pk0).Scan(&exists)
+	if err != nil {
+		return false, errors.WithStack(translateError("t1", err))
+	}
+	return exists, nil
+}
+
+// GetT1ByPksContext select the T1s of the given primary keys from the database.
+// The result is ordered by the primary key, and the keys which do not exist are skipped.
+func GetT1ByPksContext(ctx context.Context, db MyQueryer, pks []int64) ([]*T1, error) {
+	rows, err := db.QueryContext(ctx,
+		` + "`SELECT id, i, str, nullable_str, t_with_tz, t_without_tz, tm FROM t1 WHERE id = ANY($1) ORDER BY id`" + `,
+		pq.Array(pks))
+	if err != nil {
+		return nil, errors.WithStack(translateError("t1", err))
+	}
+	defer rows.Close()
+	var rs []*T1
+	for rows.Next() {
+		var r T1
+		if err := rows.Scan(&r.ID, &r.I, &r.Str, &r.NullableStr, &r.TWithTz, &r.TWithoutTz, &r.Tm); err != nil {
+			return nil, errors.WithStack(translateError("t1", err))
+		}
+		rs = append(rs, &r)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.WithStack(translateError("t1", err))
+	}
+	return rs, nil
+}
+
+// T1ListOptions is the options of ListT1.
+type T1ListOptions struct {
+	// Limit is the maximum number of rows to return. Zero means no limit.
+	Limit int
+	// After is the primary key of the last row of the previous page.
+	// Only the rows after it are returned when it is not nil.
+	After *int64
+}
+
+// ListT1 lists the T1s ordered by the primary key.
+// Pass the primary key of the last row as opts.After to get the next page.
+func ListT1(ctx context.Context, db MyQueryer, opts T1ListOptions) ([]*T1, error) {
+	q := ` + "`SELECT id, i, str, nullable_str, t_with_tz, t_without_tz, tm FROM t1`" + `
+	var args []interface{}
+	if opts.After != nil {
+		q += ` + "` WHERE id > $1`" + `
+		args = append(args, *opts.After)
+	}
+	q += ` + "` ORDER BY id`" + `
+	if opts.Limit > 0 {
+		args = append(args, opts.Limit)
+		q += fmt.Sprintf(` + "` LIMIT $%d`" + `, len(args))
+	}
+	rows, err := db.QueryContext(ctx, q, args...)
+	if err != nil {
+		return nil, errors.WithStack(translateError("t1", err))
+	}
+	defer rows.Close()
+	var rs []*T1
+	for rows.Next() {
+		var r T1
+		if err := rows.Scan(&r.ID, &r.I, &r.Str, &r.NullableStr, &r.TWithTz, &r.TWithoutTz, &r.Tm); err != nil {
+			return nil, errors.WithStack(translateError("t1", err))
+		}
+		rs = append(rs, &r)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.WithStack(translateError("t1", err))
+	}
+	return rs, nil
+}
+
+// T1Filter builds a parameterized WHERE clause of t1.
+// The predicates are combined with AND.
+type T1Filter struct {
+	conds []string
+	args  []interface{}
+}
+
+// NewT1Filter creates an empty T1Filter, which matches every row.
+func NewT1Filter() *T1Filter {
+	return &T1Filter{}
+}
+
+func (f *T1Filter) add(cond string, v interface{}) *T1Filter {
+	f.args = append(f.args, v)
+	f.conds = append(f.conds, fmt.Sprintf(cond, len(f.args)))
+	return f
+}
+
+// Where returns the WHERE clause and its arguments. It returns an empty clause
+// if the filter is nil or has no predicate.
+func (f *T1Filter) Where() (string, []interface{}) {
+	if f == nil || len(f.conds) == 0 {
+		return "", nil
+	}
+	return " WHERE " + strings.Join(f.conds, " AND "), f.args
+}
+
+// IDEq filters the rows whose id equals to v.
+func (f *T1Filter) IDEq(v int64) *T1Filter {
+	return f.add("id = $%d", v)
+}
+
+// IDIn filters the rows whose id is one of vs.
+func (f *T1Filter) IDIn(vs ...int64) *T1Filter {
+	return f.add("id = ANY($%d)", pq.Array(vs))
+}
+
+// IDLt filters the rows whose id is less than v.
+func (f *T1Filter) IDLt(v int64) *T1Filter {
+	return f.add("id < $%d", v)
+}
+
+// IDGt filters the rows whose id is greater than v.
+func (f *T1Filter) IDGt(v int64) *T1Filter {
+	return f.add("id > $%d", v)
+}
+
+// IEq filters the rows whose i equals to v.
+func (f *T1Filter) IEq(v int) *T1Filter {
+	return f.add("i = $%d", v)
+}
+
+// IIn filters the rows whose i is one of vs.
+func (f *T1Filter) IIn(vs ...int) *T1Filter {
+	return f.add("i = ANY($%d)", pq.Array(vs))
+}
+
+// ILt filters the rows whose i is less than v.
+func (f *T1Filter) ILt(v int) *T1Filter {
+	return f.add("i < $%d", v)
+}
+
+// IGt filters the rows whose i is greater than v.
+func (f *T1Filter) IGt(v int) *T1Filter {
+	return f.add("i > $%d", v)
+}
+
+// StrEq filters the rows whose str equals to v.
+func (f *T1Filter) StrEq(v string) *T1Filter {
+	return f.add("str = $%d", v)
+}
+
+// StrIn filters the rows whose str is one of vs.
+func (f *T1Filter) StrIn(vs ...string) *T1Filter {
+	return f.add("str = ANY($%d)", pq.Array(vs))
+}
+
+// StrLt filters the rows whose str is less than v.
+func (f *T1Filter) StrLt(v string) *T1Filter {
+	return f.add("str < $%d", v)
+}
+
+// StrGt filters the rows whose str is greater than v.
+func (f *T1Filter) StrGt(v string) *T1Filter {
+	return f.add("str > $%d", v)
+}
+
+// NullableStrEq filters the rows whose nullable_str equals to v.
+func (f *T1Filter) NullableStrEq(v sql.NullString) *T1Filter {
+	return f.add("nullable_str = $%d", v)
+}
+
+// NullableStrIn filters the rows whose nullable_str is one of vs.
+func (f *T1Filter) NullableStrIn(vs ...sql.NullString) *T1Filter {
+	return f.add("nullable_str = ANY($%d)", pq.Array(vs))
+}
+
+// NullableStrLt filters the rows whose nullable_str is less than v.
+func (f *T1Filter) NullableStrLt(v sql.NullString) *T1Filter {
+	return f.add("nullable_str < $%d", v)
+}
+
+// NullableStrGt filters the rows whose nullable_str is greater than v.
+func (f *T1Filter) NullableStrGt(v sql.NullString) *T1Filter {
+	return f.add("nullable_str > $%d", v)
+}
+
+// NullableStrIsNull filters the rows whose nullable_str is NULL.
+func (f *T1Filter) NullableStrIsNull() *T1Filter {
+	f.conds = append(f.conds, "nullable_str IS NULL")
+	return f
+}
+
+// NullableStrIsNotNull filters the rows whose nullable_str is not NULL.
+func (f *T1Filter) NullableStrIsNotNull() *T1Filter {
+	f.conds = append(f.conds, "nullable_str IS NOT NULL")
+	return f
+}
+
+// TWithTzEq filters the rows whose t_with_tz equals to v.
+func (f *T1Filter) TWithTzEq(v time.Time) *T1Filter {
+	return f.add("t_with_tz = $%d", v)
+}
+
+// TWithTzIn filters the rows whose t_with_tz is one of vs.
+func (f *T1Filter) TWithTzIn(vs ...time.Time) *T1Filter {
+	return f.add("t_with_tz = ANY($%d)", pq.Array(vs))
+}
+
+// TWithTzLt filters the rows whose t_with_tz is less than v.
+func (f *T1Filter) TWithTzLt(v time.Time) *T1Filter {
+	return f.add("t_with_tz < $%d", v)
+}
+
+// TWithTzGt filters the rows whose t_with_tz is greater than v.
+func (f *T1Filter) TWithTzGt(v time.Time) *T1Filter {
+	return f.add("t_with_tz > $%d", v)
+}
+
+// TWithoutTzEq filters the rows whose t_without_tz equals to v.
+func (f *T1Filter) TWithoutTzEq(v time.Time) *T1Filter {
+	return f.add("t_without_tz = $%d", v)
+}
+
+// TWithoutTzIn filters the rows whose t_without_tz is one of vs.
+func (f *T1Filter) TWithoutTzIn(vs ...time.Time) *T1Filter {
+	return f.add("t_without_tz = ANY($%d)", pq.Array(vs))
+}
+
+// TWithoutTzLt filters the rows whose t_without_tz is less than v.
+func (f *T1Filter) TWithoutTzLt(v time.Time) *T1Filter {
+	return f.add("t_without_tz < $%d", v)
+}
+
+// TWithoutTzGt filters the rows whose t_without_tz is greater than v.
+func (f *T1Filter) TWithoutTzGt(v time.Time) *T1Filter {
+	return f.add("t_without_tz > $%d", v)
+}
+
+// TmEq filters the rows whose tm equals to v.
+func (f *T1Filter) TmEq(v *time.Time) *T1Filter {
+	return f.add("tm = $%d", v)
+}
+
+// TmIn filters the rows whose tm is one of vs.
+func (f *T1Filter) TmIn(vs ...*time.Time) *T1Filter {
+	return f.add("tm = ANY($%d)", pq.Array(vs))
+}
+
+// TmLt filters the rows whose tm is less than v.
+func (f *T1Filter) TmLt(v *time.Time) *T1Filter {
+	return f.add("tm < $%d", v)
+}
+
+// TmGt filters the rows whose tm is greater than v.
+func (f *T1Filter) TmGt(v *time.Time) *T1Filter {
+	return f.add("tm > $%d", v)
+}
+
+// TmIsNull filters the rows whose tm is NULL.
+func (f *T1Filter) TmIsNull() *T1Filter {
+	f.conds = append(f.conds, "tm IS NULL")
+	return f
+}
+
+// TmIsNotNull filters the rows whose tm is not NULL.
+func (f *T1Filter) TmIsNotNull() *T1Filter {
+	f.conds = append(f.conds, "tm IS NOT NULL")
+	return f
+}
+
+// FindT1 selects the T1s matching the filter from the database.
+// The result is ordered by the primary key.
+func FindT1(ctx context.Context, db MyQueryer, f *T1Filter) ([]*T1, error) {
+	where, args := f.Where()
+	rows, err := db.QueryContext(ctx,
+		` + "`SELECT id, i, str, nullable_str, t_with_tz, t_without_tz, tm FROM t1`" + `+where+` + "` ORDER BY id`" + `,
+		args...)
+	if err != nil {
+		return nil, errors.WithStack(translateError("t1", err))
+	}
+	defer rows.Close()
+	var rs []*T1
+	for rows.Next() {
+		var r T1
+		if err := rows.Scan(&r.ID, &r.I, &r.Str, &r.NullableStr, &r.TWithTz, &r.TWithoutTz, &r.Tm); err != nil {
+			return nil, errors.WithStack(translateError("t1", err))
+		}
+		rs = append(rs, &r)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.WithStack(translateError("t1", err))
+	}
+	return rs, nil
+}
+
+// CountT1 counts the T1s matching the filter in the database.
+// Pass nil as the filter to count every row.
+func CountT1(ctx context.Context, db MyQueryer, f *T1Filter) (int64, error) {
+	where, args := f.Where()
+	var n int64
+	err := db.QueryRowContext(ctx,
+		` + "`SELECT count(*) FROM t1`" + `+where,
+		args...).Scan(&n)
+	if err != nil {
+		return 0, errors.WithStack(translateError("t1", err))
+	}
+	return n, nil
+}
+
+// DeleteT1Where deletes the T1s matching the filter from the database,
+// and returns the number of the deleted rows. A filter without predicate is rejected
+// to avoid deleting every row by mistake.
+func DeleteT1Where(ctx context.Context, db MyQueryer, f *T1Filter) (int64, error) {
+	where, args := f.Where()
+	if where == "" {
+		return 0, errors.New("refusing to delete every row of t1 with an empty filter")
+	}
+	result, err := db.ExecContext(ctx,
+		` + "`DELETE FROM t1`" + `+where,
+		args...)
+	if err != nil {
+		return 0, errors.WithStack(translateError("t1", err))
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.WithStack(translateError("t1", err))
+	}
+	return n, nil
+}
+`
+
+	assert.Contains(string(src), expected)
+}
+
+func TestNewExcludeColumns(t *testing.T) {
+	assert := assert.New(t)
+
+	ex, err := NewExcludeColumns([]string{"t1.str", "t1.tm", "t2.str"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.True(ex.Contains("t1", "str"))
+	assert.True(ex.Contains("t1", "tm"))
+	assert.True(ex.Contains("t2", "str"))
+	// exclusion must not cross the table boundary
+	assert.False(ex.Contains("t2", "tm"))
+	assert.False(ex.Contains("t3", "str"))
+	assert.False(ex.Contains("t1", "id"))
+
+	// no exclusion at all
+	ex, err = NewExcludeColumns([]string{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.False(ex.Contains("t1", "str"))
+
+	var nilEx *ExcludeColumns
+	assert.False(nilEx.Contains("t1", "str"))
+
+	// a column name without a table name is not allowed
+	for _, spec := range []string{"str", "t1.", ".str", "", "."} {
+		if _, err := NewExcludeColumns([]string{spec}); err == nil {
+			t.Errorf("expected error for spec %q, got nil", spec)
+		}
+	}
+}
+
+func TestExcludeColumnsValidate(t *testing.T) {
+	tbls := []*PgTable{
+		{
+			Schema: "public",
+			Name:   "t1",
+			Columns: []*PgColumn{
+				{Name: "id", IsPrimaryKey: true},
+				{Name: "str"},
+				{Name: "tm"},
+			},
+		},
+		{
+			Schema: "public",
+			Name:   "t_nopk",
+			Columns: []*PgColumn{
+				{Name: "a"},
+				{Name: "b"},
+			},
+		},
+	}
+
+	tests := []struct {
+		name   string
+		specs  []string
+		errStr string
+	}{
+		{"valid", []string{"t1.str", "t1.tm", "t_nopk.a"}, ""},
+		{"unknown table", []string{"t2.str"}, "no such table t2"},
+		{"unknown column", []string{"t1.nosuch"}, "no such column t1.nosuch"},
+		{"primary key", []string{"t1.id"}, "cannot exclude primary key column t1.id"},
+		{"all columns", []string{"t_nopk.a", "t_nopk.b"}, "all columns of t_nopk are excluded"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ex, err := NewExcludeColumns(tt.specs)
+			if err != nil {
+				t.Fatal(err)
+			}
+			err = ex.Validate(tbls)
+			if tt.errStr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorContains(t, err, tt.errStr)
+		})
+	}
+
+	var nilEx *ExcludeColumns
+	assert.NoError(t, nilEx.Validate(tbls))
+}
+
+func TestPgCreateStructWithExcludeColumn(t *testing.T) {
+	conn, cleanup := testPgSetup(t)
+	defer cleanup()
+	assert := assert.New(t)
+
+	schema := "public"
+	exCols := []string{"t1.nullable_str", "t1.tm"}
+	src, err := PgCreateStruct(conn, schema, "", "mypkg", "", []string{}, exCols, []string{}, []string{}, "", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	srcStr := string(src)
+
+	// compare ignoring gofmt alignment
+	re := regexp.MustCompile(`\s`)
+	expectedStruct := `// T1 represents public.t1
+type T1 struct {
+	ID int64 // id
+	I int // i
+	Str string // str
+	TWithTz time.Time // t_with_tz
+	TWithoutTz time.Time // t_without_tz
+}`
+	assert.Contains(re.ReplaceAllString(srcStr, ""), re.ReplaceAllString(expectedStruct, ""))
+
+	// the excluded columns are gone from the generated SQL and the scan/param lists
+	assert.Contains(srcStr, "INSERT INTO t1 (i, str, t_with_tz, t_without_tz) VALUES ($1, $2, $3, $4) RETURNING id")
+	assert.Contains(srcStr, "SELECT id, i, str, t_with_tz, t_without_tz FROM t1 WHERE id = $1")
+	assert.Contains(srcStr, "&r.I, &r.Str, &r.TWithTz, &r.TWithoutTz).Scan(&r.ID)")
+	assert.Contains(srcStr, "pk0).Scan(&r.ID, &r.I, &r.Str, &r.TWithTz, &r.TWithoutTz)")
+	assert.NotContains(srcStr, "nullable_str")
+	assert.NotContains(srcStr, "NullableStr")
+	assert.NotContains(srcStr, "&r.Tm")
+
+	// other tables are not affected
+	assert.Contains(srcStr, "INSERT INTO t2 (i, str, t_with_tz, t_without_tz) VALUES ($1, $2, $3, $4) RETURNING id")
+	assert.Contains(srcStr, "SELECT id, i, str, t_with_tz, t_without_tz FROM t2 WHERE id = $1")
+}
+
+func TestPgCreateStructWithInvalidExcludeColumn(t *testing.T) {
+	conn, cleanup := testPgSetup(t)
+	defer cleanup()
+
+	tests := []struct {
+		name   string
+		exCols []string
+		errStr string
+	}{
+		{"primary key", []string{"t1.id"}, "cannot exclude primary key column t1.id"},
+		{"unknown column", []string{"t1.nosuch"}, "no such column t1.nosuch"},
+		{"unknown table", []string{"nosuch.str"}, "no such table nosuch"},
+		{"without table name", []string{"nullable_str"}, `invalid exclude column "nullable_str"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := PgCreateStruct(conn, "public", "", "mypkg", "", []string{}, tt.exCols, []string{}, []string{}, "", nil)
+			assert.ErrorContains(t, err, tt.errStr)
+		})
+	}
+}
+
+func TestPgCreateStructWithErrorWrap(t *testing.T) {
+	conn, cleanup := testPgSetup(t)
+	defer cleanup()
+
+	tests := []struct {
+		errorWrap   string
+		contains    []string
+		notContains []string
+	}{
+		{
+			errorWrap: ErrorWrapStd,
+			contains: []string{
+				`return fmt.Errorf("create t1: %w", translateError("t1", err))`,
+				`return nil, fmt.Errorf("get by pk t1: %w", translateError("t1", err))`,
+			},
+			notContains: []string{"errors.WithStack", "Cause() error"},
+		},
+		{
+			errorWrap: ErrorWrapNone,
+			contains: []string{
+				`return translateError("t1", err)`,
+				`return nil, translateError("t1", err)`,
+			},
+			notContains: []string{"errors.WithStack", "fmt.Errorf", "Cause() error"},
+		},
+		{
+			errorWrap: ErrorWrapPkg,
+			contains: []string{
+				`return errors.WithStack(translateError("t1", err))`,
+				`func (e *NotFoundError) Cause() error { return e.Err }`,
+			},
+			notContains: []string{"fmt.Errorf"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.errorWrap, func(t *testing.T) {
+			genCfg := NewGenConfig()
+			genCfg.ErrorWrap = tt.errorWrap
+			src, err := PgCreateStruct(conn, "public", "", "mypkg", "", []string{}, []string{}, []string{}, []string{}, "", genCfg)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, s := range tt.contains {
+				assert.Contains(t, string(src), s)
+			}
+			for _, s := range tt.notContains {
+				assert.NotContains(t, string(src), s)
+			}
+		})
+	}
+
+	genCfg := NewGenConfig()
+	genCfg.ErrorWrap = "xerrors"
+	_, err := PgCreateStruct(conn, "public", "", "mypkg", "", []string{}, []string{}, []string{}, []string{}, "", genCfg)
+	assert.ErrorContains(t, err, `invalid error wrapping style "xerrors"`)
+}
+
+func TestPgCreateStructWithNoHelper(t *testing.T) {
+	conn, cleanup := testPgSetup(t)
+	defer cleanup()
+	assert := assert.New(t)
+
+	genCfg := NewGenConfig()
+	genCfg.NoHelper = true
+	src, err := PgCreateStruct(conn, "public", "", "mypkg", "", []string{}, []string{}, []string{}, []string{}, "", genCfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	srcStr := string(src)
+
+	// the generated code refers to the helper of the other output
+	assert.Contains(srcStr, `return errors.WithStack(translateError("t1", err))`)
+	assert.NotContains(srcStr, "func translateError(")
+	assert.NotContains(srcStr, "ErrNotFound = ")
+	assert.NotContains(srcStr, "type LockOption int")
+	assert.NotContains(srcStr, "type BeforeCreateHook interface")
+}
+
+func TestPgCreateStructWithPgx(t *testing.T) {
+	conn, cleanup := testPgSetup(t)
+	defer cleanup()
+	assert := assert.New(t)
+
+	genCfg := NewGenConfig()
+	genCfg.Driver = DriverPgx
+	src, err := PgCreateStruct(conn, "public", "", "mypkg", "", []string{}, []string{}, []string{}, []string{}, "", genCfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	srcStr := string(src)
+
+	// pgtype-aware type map
+	re := regexp.MustCompile(`\s`)
+	expectedStruct := `// T1 represents public.t1
+type T1 struct {
+	ID int64 // id
+	I int32 // i
+	Str string // str
+	NullableStr pgtype.Text // nullable_str
+	TWithTz time.Time // t_with_tz
+	TWithoutTz time.Time // t_without_tz
+	Tm pgtype.Time // tm
+}`
+	assert.Contains(re.ReplaceAllString(srcStr, ""), re.ReplaceAllString(expectedStruct, ""))
+
+	// pgx-native calls
+	assert.Contains(srcStr, "err := db.QueryRow(ctx,\n\t\t`INSERT INTO t1 (i, str, nullable_str, t_with_tz, t_without_tz, tm) VALUES ($1, $2, $3, $4, $5, $6) RETURNING id`")
+	assert.Contains(srcStr, "rows, err := db.Query(ctx,\n\t\t`SELECT id, i, str, nullable_str, t_with_tz, t_without_tz, tm FROM t1 WHERE id = ANY($1) ORDER BY id`,\n\t\tpks)")
+	assert.Contains(srcStr, "if err == pgx.ErrNoRows {")
+	assert.Contains(srcStr, "if result.RowsAffected() == 0 {")
+	assert.Contains(srcStr, "var pgErr *pgconn.PgError")
+	assert.NotContains(srcStr, "Context(ctx,")
+	assert.NotContains(srcStr, "pq.")
+
+	// bulk operations
+	assert.Contains(srcStr, "func CreateT1Batch(ctx context.Context, db Queryer, rs []*T1) error {")
+	assert.Contains(srcStr, "return row.Scan(&r.ID)")
+	assert.Contains(srcStr, "func CopyT1From(ctx context.Context, db Queryer, rs []*T1) (int64, error) {")
+	assert.Contains(srcStr, `[]string{"i", "str", "nullable_str", "t_with_tz", "t_without_tz", "tm"},`)
+	assert.Contains(srcStr, "return []interface{}{r.I, r.Str, r.NullableStr, r.TWithTz, r.TWithoutTz, r.Tm}, nil")
+	assert.Contains(srcStr, `[]string{"id", "i"},`)
+
+	assert.Contains(QueryInterface(genCfg), "QueryRow(context.Context, string, ...interface{}) pgx.Row")
+
+	genCfg.Driver = "mysql"
+	_, err = PgCreateStruct(conn, "public", "", "mypkg", "", []string{}, []string{}, []string{}, []string{}, "", genCfg)
+	assert.ErrorContains(err, `invalid driver "mysql"`)
+}
+
+func TestPgLoadIndexDef(t *testing.T) {
+	conn, cleanup := testPgSetup(t, "indexes")
+	defer cleanup()
+
+	idxs, err := PgLoadIndexDef(conn, "public", "t1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, idx := range idxs {
+		names = append(names, idx.Name)
+	}
+	assert.Equal(t, []string{"t1_i_key", "t1_lower_str_idx", "t1_pkey", "t1_str_idx"}, names)
+	assert.Equal(t, []string{"i"}, idxs[0].Columns)
+	assert.True(t, idxs[0].IsUnique)
+	assert.Equal(t, []string{"lower(str)"}, idxs[1].Columns)
+	assert.True(t, idxs[2].IsPrimary)
+	assert.Equal(t, "btree", idxs[3].Method)
+
+	idxs, err = PgLoadIndexDef(conn, "public", "t2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "t2_str_idx", idxs[2].Name)
+	assert.Equal(t, "str <> ''::text", idxs[2].Predicate)
+
+	idxs, err = PgLoadIndexDef(conn, "public", "t3")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, []string{"id", "i"}, idxs[0].Columns)
+}
+
+func TestPgCreateStructWithIndexLookup(t *testing.T) {
+	conn, cleanup := testPgSetup(t, "indexes")
+	defer cleanup()
+	assert := assert.New(t)
+
+	src, err := PgCreateStruct(conn, "public", "", "mypkg", "", []string{}, []string{}, []string{}, []string{}, "", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.NotContains(string(src), "ByIContext(")
+
+	genCfg := NewGenConfig()
+	genCfg.IndexLookup = true
+	src, err = PgCreateStruct(conn, "public", "", "mypkg", "", []string{}, []string{}, []string{}, []string{}, "", genCfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	srcStr := string(src)
+
+	// unique and non-unique indexes
+	assert.Contains(srcStr, "func ListT1ByIContext(ctx context.Context, db Queryer, v int) ([]*T1, error) {")
+	assert.Contains(srcStr, "func ListT1ByStrContext(ctx context.Context, db Queryer, v string) ([]*T1, error) {")
+	assert.Contains(srcStr, "`SELECT id, i, str, nullable_str, t_with_tz, t_without_tz, tm FROM t1 WHERE str = $1 ORDER BY id`")
+	// the leading column of the composite primary key
+	assert.Contains(srcStr, "func ListT3ByIDContext(ctx context.Context, db Queryer, v int64) ([]*T3, error) {")
+	assert.NotContains(srcStr, "func ListT3ByIContext(")
+	// single column primary key, partial index
+	assert.NotContains(srcStr, "func ListT1ByIDContext(")
+	assert.NotContains(srcStr, "func ListT2ByStrContext(")
+}
+
+func TestPgCreateStructWithGeneratedColumn(t *testing.T) {
+	conn, cleanup := testPgSetup(t, "generated")
+	defer cleanup()
+	assert := assert.New(t)
+
+	cols, err := PgLoadColumnDef(conn, "public", "t7")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal("", cols[1].Generated)
+	assert.Equal("s", cols[2].Generated)
+
+	genCfg := NewGenConfig()
+	genCfg.Driver = DriverPgx
+	src, err := PgCreateStruct(conn, "public", "", "mypkg", "", []string{}, []string{}, []string{}, []string{}, "", genCfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	srcStr := string(src)
+
+	// the generated column is not written and is scanned back
+	assert.Contains(srcStr, "`INSERT INTO t7 (i) VALUES ($1) RETURNING id, doubled`")
+	assert.Contains(srcStr, "Scan(&r.ID, &r.Doubled)")
+	assert.Contains(srcStr, `[]string{"i"}`)
+}
+
+func TestPgCreateStructWithDefaults(t *testing.T) {
+	conn, cleanup := testPgSetup(t, "defaults")
+	defer cleanup()
+	assert := assert.New(t)
+
+	genCfg := NewGenConfig()
+	genCfg.Defaults = DefaultsOmit
+	src, err := PgCreateStruct(conn, "public", "", "mypkg", "", []string{}, []string{}, []string{}, []string{}, "", genCfg)
 	if err != nil {
-		return errors.WithStack(err)
+		t.Fatal(err)
 	}
-	return nil
-}
+	srcStr := string(src)
 
-// CreateOnConflictDoNothing inserts the T6 to the database.
-// If a conflict occurs (e.g., unique constraint violation), the insert is skipped without error.
-// Returns true if the row was inserted, false if it was skipped due to conflict.
-func (r *T6) CreateOnConflictDoNothing(ctx context.Context, db Queryer) (bool, error) {
-	err := db.QueryRowContext(ctx,
-		` + "`INSERT INTO t6 () VALUES () ON CONFLICT DO NOTHING RETURNING id, i`" + `,
-	).Scan(&r.ID, &r.I)
+	// the columns with defaults are never sent and always scanned back
+	assert.Contains(srcStr, "`INSERT INTO t8 (code) VALUES ($1) RETURNING created_at, note`")
+	assert.Contains(srcStr, "Scan(&r.CreatedAt, &r.Note)")
+	assert.NotContains(srcStr, "insertQuery")
+
+	genCfg.Defaults = DefaultsZero
+	src, err = PgCreateStruct(conn, "public", "", "mypkg", "", []string{}, []string{}, []string{}, []string{}, "", genCfg)
 	if err != nil {
-		if err == sql.ErrNoRows {
-			return false, nil
-		}
-		return false, errors.WithStack(err)
+		t.Fatal(err)
 	}
-	// Row was successfully inserted
-	return true, nil
+	srcStr = string(src)
+
+	// the columns with defaults are sent unless they are zero
+	assert.Contains(srcStr, "func (r *T8) insertQuery(suffix string) (string, []interface{}, []interface{}) {")
+	assert.Contains(srcStr, `cols := []string{"code"}`)
+	assert.Contains(srcStr, "rets := []string{}")
+	assert.Contains(srcStr, "if r.CreatedAt.IsZero() {")
+	assert.Contains(srcStr, "if !r.Note.Valid {")
+	assert.Contains(srcStr, `q, args, dest := r.insertQuery(" ON CONFLICT DO NOTHING")`)
+	// the tables without defaults are not affected
+	assert.NotContains(srcStr, "func (r *T1) insertQuery(")
+	assert.Contains(srcStr, "`INSERT INTO t1 (i, str, nullable_str, t_with_tz, t_without_tz, tm) VALUES ($1, $2, $3, $4, $5, $6) RETURNING id`")
 }
 
-// GetT6ByPkContext select the T6 from the database.
-func GetT6ByPkContext(ctx context.Context, db Queryer, pk0 int, pk1 int) (*T6, error) {
-	var r T6
-	err := db.QueryRowContext(ctx,
-		` + "`SELECT id, i FROM t6 WHERE id = $1 AND i = $2`" + `,
-		pk0, pk1).Scan(&r.ID, &r.I)
-	if err != nil {
-		return nil, errors.WithStack(err)
+func TestIsZeroField(t *testing.T) {
+	tests := []struct {
+		typ      string
+		expected string
+	}{
+		{"string", `r.F == ""`},
+		{"bool", "!r.F"},
+		{"int64", "r.F == 0"},
+		{"*string", "r.F == nil"},
+		{"[]byte", "len(r.F) == 0"},
+		{"time.Time", "r.F.IsZero()"},
+		{"Interval", "r.F == (Interval{})"},
+		{"sql.NullString", "!r.F.Valid"},
+		{"sql.Null[int32]", "!r.F.Valid"},
+		{"pgtype.Timestamptz", "!r.F.Valid"},
+		{"decimal.NullDecimal", "!r.F.Valid"},
+		{"uuid.UUID", "reflect.ValueOf(r.F).IsZero()"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.typ, func(t *testing.T) {
+			assert.Equal(t, tt.expected, isZeroField(&StructField{Name: "F", Type: tt.typ}))
+		})
 	}
-	return &r, nil
 }
-`
 
-	assert.Equal(expected, string(src))
+func TestIsChangedField(t *testing.T) {
+	tests := []struct {
+		typ     string
+		changed string
+		clone   string
+	}{
+		{"string", "r.F != s.F", ""},
+		{"int64", "r.F != s.F", ""},
+		{"time.Time", "!r.F.Equal(s.F)", ""},
+		{"*time.Time", "!reflect.DeepEqual(r.F, s.F)", "if r.F != nil {\nv := *r.F\ns.F = &v\n}"},
+		{"[]byte", "!reflect.DeepEqual(r.F, s.F)", "s.F = append(r.F[:0:0], r.F...)"},
+		{"pgtype.FlatArray[int32]", "!reflect.DeepEqual(r.F, s.F)", "s.F = append(r.F[:0:0], r.F...)"},
+		{"pgtype.Hstore", "!reflect.DeepEqual(r.F, s.F)", "s.F = maps.Clone(r.F)"},
+		{"sql.NullString", "!reflect.DeepEqual(r.F, s.F)", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.typ, func(t *testing.T) {
+			f := &StructField{Name: "F", Type: tt.typ}
+			assert.Equal(t, tt.changed, isChangedField(f))
+			assert.Equal(t, tt.clone, cloneField(f))
+		})
+	}
 }
 
-func TestPgCreateStructWithQueryer(t *testing.T) {
-	conn, cleanup := testPgSetup(t)
+func TestPgCreateStructWithIdentityColumn(t *testing.T) {
+	conn, cleanup := testPgSetup(t, "generated", "defaults", "identity")
 	defer cleanup()
 	assert := assert.New(t)
 
-	schema := "public"
-	deprecated := []string{"t2", "t5"}
-	src, err := PgCreateStruct(conn, schema, "", "mypkg", "", []string{}, []string{}, []string{}, deprecated, "MyQueryer")
+	src, err := PgCreateStruct(conn, "public", "", "mypkg", "", []string{}, []string{}, []string{}, []string{}, "", nil)
 	if err != nil {
 		t.Fatal(err)
 	}
+	srcStr := string(src)
 
-	expected := `
-// T1 represents public.t1
-type T1 struct {
-	ID          int64          // id
-	I           int            // i
-	Str         string         // str
-	NullableStr sql.NullString // nullable_str
-	TWithTz     time.Time      // t_with_tz
-	TWithoutTz  time.Time      // t_without_tz
-	Tm          *time.Time     // tm
-}
-// Create inserts the T1 to the database.
-//
-// Deprecated: Use CreateContext instead.
-func (r *T1) Create(db MyQueryer) error {
-	return r.CreateContext(context.Background(), db)
-}
-
-// GetT1ByPk select the T1 from the database.
-//
-// Deprecated: Use GetT1ByPkContext instead.
-func GetT1ByPk(db MyQueryer, pk0 int64) (*T1, error) {
-	return GetT1ByPkContext(context.Background(), db, pk0)
-}
+	// the identity and serial columns out of the primary key are not written
+	assert.Contains(srcStr, "`INSERT INTO t9 (code, str) VALUES ($1, $2) RETURNING seq, n`")
+	assert.Contains(srcStr, "Scan(&r.Seq, &r.N)")
+	assert.NotContains(srcStr, "OVERRIDING SYSTEM VALUE")
 
-// CreateContext inserts the T1 to the database.
-func (r *T1) CreateContext(ctx context.Context, db MyQueryer) error {
-	err := db.QueryRowContext(ctx,
-		` + "`INSERT INTO t1 (i, str, nullable_str, t_with_tz, t_without_tz, tm) VALUES ($1, $2, $3, $4, $5, $6) RETURNING id`" + `,
-		&r.I, &r.Str, &r.NullableStr, &r.TWithTz, &r.TWithoutTz, &r.Tm).Scan(&r.ID)
+	genCfg := NewGenConfig()
+	genCfg.OverridingSystemValue = true
+	src, err = PgCreateStruct(conn, "public", "", "mypkg", "", []string{}, []string{}, []string{}, []string{}, "", genCfg)
 	if err != nil {
-		return errors.WithStack(err)
+		t.Fatal(err)
 	}
-	return nil
+	srcStr = string(src)
+
+	assert.Contains(srcStr, "func (r *T9) CreateOverridingSystemValueContext(ctx context.Context, db Queryer) error {")
+	assert.Contains(srcStr, "`INSERT INTO t9 (code, seq, n, str) OVERRIDING SYSTEM VALUE VALUES ($1, $2, $3, $4)`")
+	assert.Contains(srcStr, "`INSERT INTO t1 (id, i, str, nullable_str, t_with_tz, t_without_tz, tm) OVERRIDING SYSTEM VALUE VALUES ($1, $2, $3, $4, $5, $6, $7)`")
+	assert.Contains(srcStr, "`INSERT INTO t7 (id, i) OVERRIDING SYSTEM VALUE VALUES ($1, $2) RETURNING doubled`")
+	// the tables without the generated keys
+	assert.NotContains(srcStr, "func (r *T4) CreateOverridingSystemValueContext(")
+	assert.NotContains(srcStr, "func (r *T8) CreateOverridingSystemValueContext(")
 }
 
-// CreateOnConflictDoNothing inserts the T1 to the database.
-// If a conflict occurs (e.g., unique constraint violation), the insert is skipped without error.
-// Returns true if the row was inserted, false if it was skipped due to conflict.
-func (r *T1) CreateOnConflictDoNothing(ctx context.Context, db MyQueryer) (bool, error) {
-	err := db.QueryRowContext(ctx,
-		` + "`INSERT INTO t1 (i, str, nullable_str, t_with_tz, t_without_tz, tm) VALUES ($1, $2, $3, $4, $5, $6) ON CONFLICT DO NOTHING RETURNING id`" + `,
-		&r.I, &r.Str, &r.NullableStr, &r.TWithTz, &r.TWithoutTz, &r.Tm).Scan(&r.ID)
+func TestPgCreateStructWithVersionColumn(t *testing.T) {
+	conn, cleanup := testPgSetup(t, "version")
+	defer cleanup()
+	assert := assert.New(t)
+
+	src, err := PgCreateStruct(conn, "public", "", "mypkg", "", []string{}, []string{}, []string{}, []string{}, "", nil)
 	if err != nil {
-		if err == sql.ErrNoRows {
-			return false, nil
-		}
-		return false, errors.WithStack(err)
+		t.Fatal(err)
 	}
-	// Row was successfully inserted
-	return true, nil
-}
+	srcStr := string(src)
+	assert.Contains(srcStr, "`UPDATE t_versioned SET str = $1, version = $2 WHERE id = $3`")
+	assert.NotContains(srcStr, "StaleObjectError")
 
-// GetT1ByPkContext select the T1 from the database.
-func GetT1ByPkContext(ctx context.Context, db MyQueryer, pk0 int64) (*T1, error) {
-	var r T1
-	err := db.QueryRowContext(ctx,
-		` + "`SELECT id, i, str, nullable_str, t_with_tz, t_without_tz, tm FROM t1 WHERE id = $1`" + `,
-		pk0).Scan(&r.ID, &r.I, &r.Str, &r.NullableStr, &r.TWithTz, &r.TWithoutTz, &r.Tm)
+	genCfg := NewGenConfig()
+	genCfg.VersionColumns = []string{"version"}
+	src, err = PgCreateStruct(conn, "public", "", "mypkg", "", []string{}, []string{}, []string{}, []string{}, "", genCfg)
 	if err != nil {
-		return nil, errors.WithStack(err)
+		t.Fatal(err)
 	}
-	return &r, nil
-}
-`
+	srcStr = string(src)
+
+	// the version is checked and incremented
+	assert.Contains(srcStr, "`UPDATE t_versioned SET str = $1, version = version + 1 WHERE id = $2 AND version = $3 RETURNING version`")
+	assert.Contains(srcStr, "&r.Str, &r.ID, &r.Version).Scan(&r.Version)")
+	assert.Contains(srcStr, "`DELETE FROM t_versioned WHERE id = $1 AND version = $2`")
+	assert.Contains(srcStr, `err = &StaleObjectError{Table: "t_versioned", Version: int64(r.Version)}`)
+	assert.Contains(srcStr, "type StaleObjectError struct {")
+	// the tables without the version column
+	assert.Contains(srcStr, "`DELETE FROM t1 WHERE id = $1`")
+
+	genCfg.VersionColumns = []string{"t1.i", "version"}
+	src, err = PgCreateStruct(conn, "public", "", "mypkg", "", []string{}, []string{}, []string{}, []string{}, "", genCfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	srcStr = string(src)
+	assert.Contains(srcStr, "`UPDATE t1 SET str = $1, nullable_str = $2, t_with_tz = $3, t_without_tz = $4, tm = $5, i = i + 1 WHERE id = $6 AND i = $7 RETURNING i`")
+	assert.Contains(srcStr, "RETURNING version`")
 
-	assert.Contains(string(src), expected)
+	for _, tt := range []struct {
+		spec string
+		err  string
+	}{
+		{"t1.str", `version column "t1.str" must be a NOT NULL integer out of the primary key`},
+		{"t1.id", `version column "t1.id" must be a NOT NULL integer out of the primary key`},
+		{"t1.nope", `version column "t1.nope" not found`},
+		{"nope.version", `version column "nope.version": no such table nope`},
+		{"public.t1.i", `version column "public.t1.i": no such table public.t1`},
+		{"t1.", `invalid version column "t1."`},
+	} {
+		genCfg.VersionColumns = []string{tt.spec}
+		_, err = PgCreateStruct(conn, "public", "", "mypkg", "", []string{}, []string{}, []string{}, []string{}, "", genCfg)
+		assert.ErrorContains(err, tt.err)
+	}
 }
 
-func TestNewExcludeColumns(t *testing.T) {
+func TestPgCreateStructWithSoftDelete(t *testing.T) {
+	conn, cleanup := testPgSetup(t, "soft_delete")
+	defer cleanup()
 	assert := assert.New(t)
 
-	ex, err := NewExcludeColumns([]string{"t1.str", "t1.tm", "t2.str"})
+	src, err := PgCreateStruct(conn, "public", "", "mypkg", "", []string{}, []string{}, []string{}, []string{}, "", nil)
 	if err != nil {
 		t.Fatal(err)
 	}
-	assert.True(ex.Contains("t1", "str"))
-	assert.True(ex.Contains("t1", "tm"))
-	assert.True(ex.Contains("t2", "str"))
-	// exclusion must not cross the table boundary
-	assert.False(ex.Contains("t2", "tm"))
-	assert.False(ex.Contains("t3", "str"))
-	assert.False(ex.Contains("t1", "id"))
-
-	// no exclusion at all
-	ex, err = NewExcludeColumns([]string{})
+	srcStr := string(src)
+	assert.Contains(srcStr, "`DELETE FROM t_soft_deleted WHERE id = $1`")
+	assert.NotContains(srcStr, "IncludeDeleted")
+
+	genCfg := NewGenConfig()
+	genCfg.SoftDeleteColumn = "deleted_at"
+	genCfg.VersionColumns = []string{"version"}
+	genCfg.IndexLookup = true
+	src, err = PgCreateStruct(conn, "public", "", "mypkg", "", []string{}, []string{}, []string{}, []string{}, "", genCfg)
 	if err != nil {
 		t.Fatal(err)
 	}
-	assert.False(ex.Contains("t1", "str"))
+	srcStr = string(src)
+
+	// the delete methods set the column
+	assert.Contains(srcStr, "`UPDATE t_soft_deleted SET deleted_at = now() WHERE id = $1 AND deleted_at IS NULL RETURNING deleted_at`")
+	assert.Contains(srcStr, "&r.ID).Scan(&r.DeletedAt)")
+	assert.Contains(srcStr, "`UPDATE t_soft_deleted SET deleted_at = now()`+where")
+	// the queries exclude the soft-deleted rows by default
+	assert.Contains(srcStr, "`SELECT id, str, deleted_at FROM t_soft_deleted WHERE id = $1 AND deleted_at IS NULL`")
+	assert.Contains(srcStr, "`SELECT EXISTS (SELECT 1 FROM t_soft_deleted WHERE id = $1 AND deleted_at IS NULL)`")
+	assert.Contains(srcStr, "`SELECT id, str, deleted_at FROM t_soft_deleted WHERE id = ANY($1) AND deleted_at IS NULL ORDER BY id`")
+	assert.Contains(srcStr, "`SELECT id, str, deleted_at FROM t_soft_deleted WHERE str = $1 AND deleted_at IS NULL ORDER BY id`")
+	assert.Contains(srcStr, "q += ` WHERE deleted_at IS NULL`")
+	assert.Contains(srcStr, `conds = append(conds, "deleted_at IS NULL")`)
+	// and the variants include them
+	assert.Contains(srcStr, "func GetTSoftDeletedByPkIncludeDeletedContext(ctx context.Context, db Queryer, pk0 int64) (*TSoftDeleted, error) {")
+	assert.Contains(srcStr, "`SELECT id, str, deleted_at FROM t_soft_deleted WHERE id = $1`")
+	assert.Contains(srcStr, "func ExistsTSoftDeletedByPkIncludeDeletedContext(")
+	assert.Contains(srcStr, "func GetTSoftDeletedByPksIncludeDeletedContext(")
+	assert.Contains(srcStr, "func (f *TSoftDeletedFilter) IncludeDeleted() *TSoftDeletedFilter {")
+	assert.Contains(srcStr, "IncludeDeleted bool")
+	assert.Contains(srcStr, "func ListTSoftDeletedByStrIncludeDeletedContext(ctx context.Context, db Queryer, v string) ([]*TSoftDeleted, error) {")
+	assert.Contains(srcStr, "`SELECT id, str, deleted_at FROM t_soft_deleted WHERE str = $1 ORDER BY id`")
+	// UPDATE leaves the column to the delete methods
+	assert.Contains(srcStr, "`UPDATE t_soft_deleted SET str = $1 WHERE id = $2`")
+	// the tables without the column
+	assert.Contains(srcStr, "`DELETE FROM t1 WHERE id = $1`")
+	assert.NotContains(srcStr, "GetT1ByPkIncludeDeletedContext")
+}
+
+func TestPgCreateStructWithDirtyTracking(t *testing.T) {
+	conn, cleanup := testPgSetup(t, "version", "timestamps")
+	defer cleanup()
+	assert := assert.New(t)
 
-	var nilEx *ExcludeColumns
-	assert.False(nilEx.Contains("t1", "str"))
+	src, err := PgCreateStruct(conn, "public", "", "mypkg", "", []string{}, []string{}, []string{}, []string{}, "", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.NotContains(string(src), "Snapshot")
 
-	// a column name without a table name is not allowed
-	for _, spec := range []string{"str", "t1.", ".str", "", "."} {
-		if _, err := NewExcludeColumns([]string{spec}); err == nil {
-			t.Errorf("expected error for spec %q, got nil", spec)
-		}
+	genCfg := NewGenConfig()
+	genCfg.VersionColumns = []string{"version"}
+	genCfg.UpdatedAtColumns = []string{"updated_at"}
+	genCfg.DirtyTracking = true
+	src, err = PgCreateStruct(conn, "public", "", "mypkg", "", []string{}, []string{}, []string{}, []string{}, "", genCfg)
+	if err != nil {
+		t.Fatal(err)
 	}
-}
+	srcStr := string(src)
 
-func TestExcludeColumnsValidate(t *testing.T) {
-	tbls := []*PgTable{
-		{
-			Schema: "public",
-			Name:   "t1",
-			Columns: []*PgColumn{
-				{Name: "id", IsPrimaryKey: true},
-				{Name: "str"},
-				{Name: "tm"},
-			},
-		},
-		{
-			Schema: "public",
-			Name:   "t_nopk",
+	assert.Contains(srcStr, "loaded *T1 // snapshot of UpdateChangedContext")
+	assert.Contains(srcStr, `	if r.Tm != nil {
+		v := *r.Tm
+		s.Tm = &v
+	}`)
+	assert.Contains(srcStr, "func (r *T1) UpdateChangedContext(ctx context.Context, db Queryer) error {")
+	assert.Contains(srcStr, `	if !r.TWithTz.Equal(s.TWithTz) {
+		args = append(args, &r.TWithTz)
+		sets = append(sets, fmt.Sprintf("t_with_tz = $%d", len(args)))
+	}`)
+	// nothing is sent without the changes, but the after hook still balances the before hook
+	assert.Contains(srcStr, `	if err := beforeUpdate(ctx, r); err != nil {
+		return err
+	}
+	args := []interface{}{&r.ID}`)
+	assert.Contains(srcStr, `	if len(sets) == 0 {
+		return afterUpdate(ctx, r)
+	}`)
+	// the arguments of the primary key and the version come first
+	assert.Contains(srcStr, `	args := []interface{}{&r.ID, &r.Version}`)
+	assert.Contains(srcStr, "q := `UPDATE t_versioned SET ` + strings.Join(sets, \", \") + ` WHERE id = $1 AND version = $2 RETURNING version`")
+	// the updated_at column is set only when another column changed
+	assert.Contains(srcStr, `	sets = append(sets, "updated_at = now()")
+	q := `+"`UPDATE t_timestamps SET ` + strings.Join(sets, \", \") + ` WHERE id = $1 RETURNING updated_at`")
+	// the reads and the writes take the snapshot
+	assert.Contains(srcStr, `		r.Snapshot()
+		rs = append(rs, &r)`)
+	assert.Contains(srcStr, `	r.Snapshot()
+	return &r, nil`)
+	assert.Contains(srcStr, `	r.Snapshot()
+	return afterCreate(ctx, r)`)
+	// the tables without the primary key
+	assert.NotContains(srcStr, "func (r *T4) Snapshot()")
+}
+
+func TestCheckMethodNames(t *testing.T) {
+	newStruct := func(col string, genCfg *GenConfig) *Struct {
+		tbl := &PgTable{
+			Name: "t_collision",
 			Columns: []*PgColumn{
-				{Name: "a"},
-				{Name: "b"},
+				{Name: "id", DataType: "bigint", DDLType: "bigint", NotNull: true, IsPrimaryKey: true, Identity: "d"},
+				{Name: col, DataType: "jsonb", DDLType: "jsonb", NotNull: true},
 			},
-		},
+		}
+		st, err := PgTableToStruct(tbl, &defaultTypeMapCfg, autoGenKeyCfg, []string{}, "", nil, genCfg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return st
 	}
+	dirtyTracking := NewGenConfig()
+	dirtyTracking.DirtyTracking = true
 
 	tests := []struct {
 		name   string
-		specs  []string
-		errStr string
+		col    string
+		genCfg *GenConfig
+		err    string
 	}{
-		{"valid", []string{"t1.str", "t1.tm", "t_nopk.a"}, ""},
-		{"unknown table", []string{"t2.str"}, "no such table t2"},
-		{"unknown column", []string{"t1.nosuch"}, "no such column t1.nosuch"},
-		{"primary key", []string{"t1.id"}, "cannot exclude primary key column t1.id"},
-		{"all columns", []string{"t_nopk.a", "t_nopk.b"}, "all columns of t_nopk are excluded"},
+		{"validate", "validate", nil, "column t_collision.validate collides with the generated method TCollision.Validate, exclude it with --exclude-column"},
+		{"reload", "reload_context", nil, "column t_collision.reload_context collides with the generated method TCollision.ReloadContext, exclude it with --exclude-column"},
+		{"snapshot of dirty tracking", "snapshot", dirtyTracking, "column t_collision.snapshot collides with the generated method TCollision.Snapshot, exclude it with --exclude-column"},
+		{"snapshot without dirty tracking", "snapshot", nil, ""},
+		{"overriding without the option", "create_overriding_system_value_context", nil, ""},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			ex, err := NewExcludeColumns(tt.specs)
-			if err != nil {
-				t.Fatal(err)
-			}
-			err = ex.Validate(tbls)
-			if tt.errStr == "" {
+			err := checkMethodNames(newStruct(tt.col, tt.genCfg))
+			if tt.err == "" {
 				assert.NoError(t, err)
-				return
+			} else {
+				assert.EqualError(t, err, tt.err)
 			}
-			assert.ErrorContains(t, err, tt.errStr)
 		})
 	}
 
-	var nilEx *ExcludeColumns
-	assert.NoError(t, nilEx.Validate(tbls))
+	// methodNames lists every exported method of the template
+	genCfg := NewGenConfig()
+	genCfg.DirtyTracking = true
+	genCfg.OverridingSystemValue = true
+	st := newStruct("str", genCfg)
+	src, err := PgExecuteDefaultMethodTmpl(&StructTmpl{Struct: st})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, m := range regexp.MustCompile(`func \(r \*TCollision\) ([A-Z]\w*)\(`).FindAllStringSubmatch(string(src), -1) {
+		names = append(names, m[1])
+	}
+	assert.ElementsMatch(t, names, methodNames(st))
 }
 
-func TestPgCreateStructWithExcludeColumn(t *testing.T) {
-	conn, cleanup := testPgSetup(t)
+func TestPgCreateStructWithReload(t *testing.T) {
+	conn, cleanup := testPgSetup(t, "soft_delete")
 	defer cleanup()
 	assert := assert.New(t)
 
-	schema := "public"
-	exCols := []string{"t1.nullable_str", "t1.tm"}
-	src, err := PgCreateStruct(conn, schema, "", "mypkg", "", []string{}, exCols, []string{}, []string{}, "")
+	genCfg := NewGenConfig()
+	genCfg.SoftDeleteColumn = "deleted_at"
+	src, err := PgCreateStruct(conn, "public", "", "mypkg", "", []string{}, []string{}, []string{}, []string{}, "", genCfg)
 	if err != nil {
 		t.Fatal(err)
 	}
 	srcStr := string(src)
 
-	// compare ignoring gofmt alignment
-	re := regexp.MustCompile(`\s`)
-	expectedStruct := `// T1 represents public.t1
-type T1 struct {
-	ID int64 // id
-	I int // i
-	Str string // str
-	TWithTz time.Time // t_with_tz
-	TWithoutTz time.Time // t_without_tz
-}`
-	assert.Contains(re.ReplaceAllString(srcStr, ""), re.ReplaceAllString(expectedStruct, ""))
+	assert.Contains(srcStr, "func (r *T3) ReloadContext(ctx context.Context, db Queryer) error {")
+	assert.Contains(srcStr, "`SELECT id, i, str, t_with_tz, t_without_tz FROM t3 WHERE id = $1 AND i = $2`,\n\t\tr.ID, r.I).Scan(&r.ID, &r.I, &r.Str, &r.TWithTz, &r.TWithoutTz)")
+	// the soft-deleted row is reloaded as well
+	assert.Contains(srcStr, "`SELECT id, str, deleted_at FROM t_soft_deleted WHERE id = $1`,\n\t\tr.ID).Scan(&r.ID, &r.Str, &r.DeletedAt)")
+}
 
-	// the excluded columns are gone from the generated SQL and the scan/param lists
-	assert.Contains(srcStr, "INSERT INTO t1 (i, str, t_with_tz, t_without_tz) VALUES ($1, $2, $3, $4) RETURNING id")
-	assert.Contains(srcStr, "SELECT id, i, str, t_with_tz, t_without_tz FROM t1 WHERE id = $1")
-	assert.Contains(srcStr, "&r.I, &r.Str, &r.TWithTz, &r.TWithoutTz).Scan(&r.ID)")
-	assert.Contains(srcStr, "pk0).Scan(&r.ID, &r.I, &r.Str, &r.TWithTz, &r.TWithoutTz)")
-	assert.NotContains(srcStr, "nullable_str")
-	assert.NotContains(srcStr, "NullableStr")
-	assert.NotContains(srcStr, "&r.Tm")
+func TestPgCreateStructWithRowLock(t *testing.T) {
+	conn, cleanup := testPgSetup(t, "soft_delete")
+	defer cleanup()
+	assert := assert.New(t)
 
-	// other tables are not affected
-	assert.Contains(srcStr, "INSERT INTO t2 (i, str, t_with_tz, t_without_tz) VALUES ($1, $2, $3, $4) RETURNING id")
-	assert.Contains(srcStr, "SELECT id, i, str, t_with_tz, t_without_tz FROM t2 WHERE id = $1")
+	genCfg := NewGenConfig()
+	genCfg.SoftDeleteColumn = "deleted_at"
+	src, err := PgCreateStruct(conn, "public", "", "mypkg", "", []string{}, []string{}, []string{}, []string{}, "", genCfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	srcStr := string(src)
+
+	assert.Contains(srcStr, "func GetT1ByPkForUpdateContext(ctx context.Context, db Queryer, pk0 int64, opt LockOption) (*T1, error) {")
+	assert.Contains(srcStr, "`SELECT id, i, str, nullable_str, t_with_tz, t_without_tz, tm FROM t1 WHERE id = $1 FOR UPDATE`+opt.sql(),")
+	assert.Contains(srcStr, "func GetT3ByPkForShareContext(ctx context.Context, db Queryer, pk0 int64, pk1 int, opt LockOption) (*T3, error) {")
+	assert.Contains(srcStr, "FROM t3 WHERE id = $1 AND i = $2 FOR SHARE`+opt.sql(),")
+	// the soft-deleted rows are not locked, and there is no variant including them
+	assert.Contains(srcStr, "FROM t_soft_deleted WHERE id = $1 AND deleted_at IS NULL FOR UPDATE`+opt.sql(),")
+	assert.NotContains(srcStr, "IncludeDeletedForUpdate")
+	assert.Contains(srcStr, `		return " SKIP LOCKED"`)
 }
 
-func TestPgCreateStructWithInvalidExcludeColumn(t *testing.T) {
-	conn, cleanup := testPgSetup(t)
+func TestPgCreateStructWithHooks(t *testing.T) {
+	conn, cleanup := testPgSetup(t, "timestamps")
 	defer cleanup()
+	assert := assert.New(t)
 
-	tests := []struct {
-		name   string
-		exCols []string
-		errStr string
-	}{
-		{"primary key", []string{"t1.id"}, "cannot exclude primary key column t1.id"},
-		{"unknown column", []string{"t1.nosuch"}, "no such column t1.nosuch"},
-		{"unknown table", []string{"nosuch.str"}, "no such table nosuch"},
-		{"without table name", []string{"nullable_str"}, `invalid exclude column "nullable_str"`},
+	genCfg := NewGenConfig()
+	genCfg.Driver = DriverPgx
+	genCfg.CreatedAtColumns = []string{"created_at"}
+	genCfg.TimestampClock = TimestampClockGo
+	src, err := PgCreateStruct(conn, "public", "", "mypkg", "", []string{}, []string{}, []string{}, []string{}, "", genCfg)
+	if err != nil {
+		t.Fatal(err)
 	}
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			_, err := PgCreateStruct(conn, "public", "", "mypkg", "", []string{}, tt.exCols, []string{}, []string{}, "")
-			assert.ErrorContains(t, err, tt.errStr)
-		})
+	srcStr := string(src)
+
+	assert.Contains(srcStr, `type BeforeCreateHook interface {
+	BeforeCreate(ctx context.Context) error
+}`)
+	assert.Contains(srcStr, `func afterDelete(ctx context.Context, v interface{}) error {
+	if h, ok := v.(AfterDeleteHook); ok {
+		return h.AfterDelete(ctx)
+	}
+	return nil
+}`)
+	// the before hook runs ahead of the timestamps and the statement
+	assert.Contains(srcStr, `func (r *TTimestamps) CreateContext(ctx context.Context, db Queryer) error {
+	if err := beforeCreate(ctx, r); err != nil {
+		return err
+	}
+	now := Now()
+	r.CreatedAt = now
+`)
+	assert.Contains(srcStr, `func (r *T1) UpdateContext(ctx context.Context, db Queryer) error {
+	if err := beforeUpdate(ctx, r); err != nil {
+		return err
+	}
+`)
+	assert.Contains(srcStr, `func (r *T1) DeleteContext(ctx context.Context, db Queryer) error {
+	if err := beforeDelete(ctx, r); err != nil {
+		return err
+	}
+`)
+	assert.Contains(srcStr, "return afterUpdate(ctx, r)")
+	assert.Contains(srcStr, "return afterDelete(ctx, r)")
+	// the after hook runs only for the inserted row
+	assert.Contains(srcStr, `	if result.RowsAffected() == 0 {
+		return false, nil
+	}
+	return true, afterCreate(ctx, r)`)
+	// every row of the batch and the copy
+	assert.Contains(srcStr, `	for _, r := range rs {
+		if err := afterCreate(ctx, r); err != nil {
+			return n, err
+		}
+	}`)
+}
+
+func TestPgCreateStructWithTimestamps(t *testing.T) {
+	conn, cleanup := testPgSetup(t, "soft_delete", "timestamps")
+	defer cleanup()
+	assert := assert.New(t)
+
+	genCfg := NewGenConfig()
+	genCfg.Driver = DriverPgx
+	genCfg.CreatedAtColumns = []string{"created_at"}
+	genCfg.UpdatedAtColumns = []string{"*ed_at"}
+	genCfg.SoftDeleteColumn = "deleted_at"
+	src, err := PgCreateStruct(conn, "public", "", "mypkg", "", []string{}, []string{}, []string{}, []string{}, "", genCfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	srcStr := string(src)
+
+	// the database clock sets the columns by now() and scans them back
+	assert.Contains(srcStr, "`INSERT INTO t_timestamps (str, created_at, updated_at) VALUES ($1, now(), now()) RETURNING id, created_at, updated_at`")
+	assert.Contains(srcStr, "&r.Str).Scan(&r.ID, &r.CreatedAt, &r.UpdatedAt)")
+	assert.Contains(srcStr, "`UPDATE t_timestamps SET str = $1, updated_at = now() WHERE id = $2 RETURNING updated_at`")
+	// COPY cannot send now(), so it copies Now() of the Go clock
+	assert.Contains(srcStr, `pgx.Identifier{"t_timestamps"},
+		[]string{"str", "created_at", "updated_at"},`)
+	assert.Contains(srcStr, `now := Now()
+			r.CreatedAt = now
+			r.UpdatedAt = pgtype.Timestamptz{Time: now, Valid: true}
+			return []interface{}{r.Str, r.CreatedAt, r.UpdatedAt}, nil`)
+	// the soft delete column is not matched by the pattern, nor set by UPDATE
+	assert.Contains(srcStr, "`UPDATE t_soft_deleted SET str = $1 WHERE id = $2`")
+	assert.Contains(srcStr, "var Now = time.Now")
+
+	genCfg.Driver = DriverPq
+	genCfg.UpdatedAtColumns = []string{"updated_at"}
+	genCfg.TimestampClock = TimestampClockGo
+	src, err = PgCreateStruct(conn, "public", "", "mypkg", "", []string{}, []string{}, []string{}, []string{}, "", genCfg)
+	if err != nil {
+		t.Fatal(err)
 	}
+	srcStr = string(src)
+
+	// the Go clock sets the fields before the statements
+	assert.Contains(srcStr, "var Now = time.Now")
+	assert.Contains(srcStr, "`INSERT INTO t_timestamps (str, created_at, updated_at) VALUES ($1, $2, $3) RETURNING id`")
+	assert.Contains(srcStr, "`UPDATE t_timestamps SET str = $1, updated_at = $2 WHERE id = $3`")
+	assert.Contains(srcStr, `	now := Now()
+	r.CreatedAt = now
+	r.UpdatedAt = new(time.Time)
+	*r.UpdatedAt = now
+`)
+	assert.Contains(srcStr, `	now := Now()
+	r.UpdatedAt = new(time.Time)
+	*r.UpdatedAt = now
+`)
+
+	genCfg.TimestampClock = "wall"
+	_, err = PgCreateStruct(conn, "public", "", "mypkg", "", []string{}, []string{}, []string{}, []string{}, "", genCfg)
+	assert.Error(err)
 }
@@ -57,8 +57,8 @@ type T2Table struct {
 // Create inserts the T2 to the database.
 func (r *T2Table) Create(db Queryer) error {
 	err := db.QueryRow(
-		`INSERT INTO t2 (str, t_with_tz, t_without_tz) VALUES ($1, $2, $3) RETURNING id, i`,
-		&r.Str, &r.TWithTz, &r.TWithoutTz).Scan(&r.ID, &r.I)
+		`INSERT INTO t2 (i, str, t_with_tz, t_without_tz) VALUES ($1, $2, $3, $4) RETURNING id`,
+		&r.I, &r.Str, &r.TWithTz, &r.TWithoutTz).Scan(&r.ID)
 	if err != nil {
 		return err
 	}
@@ -106,6 +106,71 @@ func GetT3TableByPk(db Queryer, pk0 int, pk1 int) (*T3, error) {
 	return &r, nil
 }
 
+// T4Table represents public.t4
+type T4Table struct {
+	ID            int64     // id
+	Bytes         []byte    // bytes
+	NullableBytes []byte    // nullable_bytes
+	Span          Interval  // span
+	NullableSpan  *Interval // nullable_span
+}
+
+// Create inserts the T4 to the database.
+func (r *T4Table) Create(db Queryer) error {
+	err := db.QueryRow(
+		`INSERT INTO t4 (bytes, nullable_bytes, span, nullable_span) VALUES ($1, $2, $3, $4) RETURNING id`,
+		&r.Bytes, &r.NullableBytes, &r.Span, &r.NullableSpan).Scan(&r.ID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetT4TableByPk select the T4 from the database.
+func GetT4TableByPk(db Queryer, pk0 int64) (*T4, error) {
+	var r T4
+	err := db.QueryRow(
+		`SELECT id, bytes, nullable_bytes, span, nullable_span FROM t4 WHERE id = $1`,
+		pk0).Scan(&r.ID, &r.Bytes, &r.NullableBytes, &r.Span, &r.NullableSpan)
+	if err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// T5Table represents public.t5
+type T5Table struct {
+	ID     int64          // id
+	Name   string         // name CHECK (((name)::text <> status))
+	Status string         // status CHECK (((name)::text <> status)) CHECK ((status = ANY (ARRAY['active'::text, 'inactive'::text])))
+	Score  sql.NullInt64  // score CHECK (((score >= 0) AND (score <= 100)))
+	Price  float64        // price CHECK ((price > (0)::numeric))
+	Note   sql.NullString // note
+}
+
+// Create inserts the T5 to the database.
+func (r *T5Table) Create(db Queryer) error {
+	err := db.QueryRow(
+		`INSERT INTO t5 (name, status, score, price, note) VALUES ($1, $2, $3, $4, $5) RETURNING id`,
+		&r.Name, &r.Status, &r.Score, &r.Price, &r.Note).Scan(&r.ID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetT5TableByPk select the T5 from the database.
+func GetT5TableByPk(db Queryer, pk0 int64) (*T5, error) {
+	var r T5
+	err := db.QueryRow(
+		`SELECT id, name, status, score, price, note FROM t5 WHERE id = $1`,
+		pk0).Scan(&r.ID, &r.Name, &r.Status, &r.Score, &r.Price, &r.Note)
+	if err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
 // UserAccountTable represents public.user_account
 type UserAccountTable struct {
 	ID        int64  // id
@@ -1,6 +1,8 @@
 DROP TABLE if EXISTS t1;
 DROP TABLE if EXISTS t2;
 DROP TABLE if EXISTS t3;
+DROP TABLE if EXISTS t4;
+DROP TABLE if EXISTS t5;
 DROP TABLE if EXISTS user_account;
 DROP TABLE if EXISTS user_account_composite_pk;
 DROP TABLE if EXISTS user_account_uuid;
@@ -64,3 +66,21 @@ CREATE TABLE t3 (
   , i integer not null
   , PRIMARY KEY(id, i)
 );
+
+CREATE TABLE t4 (
+  id bigserial primary key
+  , bytes bytea not null
+  , nullable_bytes bytea
+  , span interval not null
+  , nullable_span interval
+);
+
+CREATE TABLE t5 (
+  id bigserial primary key
+  , name character varying(16) not null
+  , status text not null default 'active' CHECK (status IN ('active', 'inactive'))
+  , score integer CHECK (score BETWEEN 0 AND 100)
+  , price numeric(10, 2) not null CHECK (price > 0)
+  , note text
+  , CONSTRAINT t5_check CHECK (name <> status)
+);
@@ -5,8 +5,14 @@ package dgwexample
 import (
 	"context"
 	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"strconv"
+	"strings"
 	"time"
+	"unicode/utf8"
 
+	"github.com/lib/pq"
 	"github.com/pkg/errors"
 )
 
@@ -38,21 +44,40 @@ func GetT1ByPk(db Queryer, pk0 int64) (*T1, error) {
 	return GetT1ByPkContext(context.Background(), db, pk0)
 }
 
+// Validate checks the T1 against the constraints of t1 which can be checked
+// without the database, and returns *ValidationError listing every failing field.
+func (r *T1) Validate() error {
+	var errs []FieldError
+	if r.Str == "" {
+		errs = append(errs, FieldError{Field: "Str", Column: "str", Message: "must not be empty"})
+	}
+	if len(errs) > 0 {
+		return &ValidationError{Table: "t1", Fields: errs}
+	}
+	return nil
+}
+
 // CreateContext inserts the T1 to the database.
 func (r *T1) CreateContext(ctx context.Context, db Queryer) error {
+	if err := beforeCreate(ctx, r); err != nil {
+		return err
+	}
 	err := db.QueryRowContext(ctx,
 		`INSERT INTO t1 (i, str, num_float, nullable_str, t_with_tz, t_without_tz, nullable_tz, json_data, xml_data) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9) RETURNING id`,
 		&r.I, &r.Str, &r.NumFloat, &r.NullableStr, &r.TWithTz, &r.TWithoutTz, &r.NullableTz, &r.JSONData, &r.XMLData).Scan(&r.ID)
 	if err != nil {
-		return errors.WithStack(err)
+		return errors.WithStack(translateError("t1", err))
 	}
-	return nil
+	return afterCreate(ctx, r)
 }
 
 // CreateOnConflictDoNothing inserts the T1 to the database.
 // If a conflict occurs (e.g., unique constraint violation), the insert is skipped without error.
 // Returns true if the row was inserted, false if it was skipped due to conflict.
 func (r *T1) CreateOnConflictDoNothing(ctx context.Context, db Queryer) (bool, error) {
+	if err := beforeCreate(ctx, r); err != nil {
+		return false, err
+	}
 	err := db.QueryRowContext(ctx,
 		`INSERT INTO t1 (i, str, num_float, nullable_str, t_with_tz, t_without_tz, nullable_tz, json_data, xml_data) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9) ON CONFLICT DO NOTHING RETURNING id`,
 		&r.I, &r.Str, &r.NumFloat, &r.NullableStr, &r.TWithTz, &r.TWithoutTz, &r.NullableTz, &r.JSONData, &r.XMLData).Scan(&r.ID)
@@ -60,10 +85,69 @@ func (r *T1) CreateOnConflictDoNothing(ctx context.Context, db Queryer) (bool, e
 		if err == sql.ErrNoRows {
 			return false, nil
 		}
-		return false, errors.WithStack(err)
+		return false, errors.WithStack(translateError("t1", err))
 	}
 	// Row was successfully inserted
-	return true, nil
+	return true, afterCreate(ctx, r)
+}
+
+// UpdateContext updates the T1 in the database by the primary key.
+// *NotFoundError is returned if the row is not found.
+func (r *T1) UpdateContext(ctx context.Context, db Queryer) error {
+	if err := beforeUpdate(ctx, r); err != nil {
+		return err
+	}
+	result, err := db.ExecContext(ctx,
+		`UPDATE t1 SET i = $1, str = $2, num_float = $3, nullable_str = $4, t_with_tz = $5, t_without_tz = $6, nullable_tz = $7, json_data = $8, xml_data = $9 WHERE id = $10`,
+		&r.I, &r.Str, &r.NumFloat, &r.NullableStr, &r.TWithTz, &r.TWithoutTz, &r.NullableTz, &r.JSONData, &r.XMLData, &r.ID)
+	if err != nil {
+		return errors.WithStack(translateError("t1", err))
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return errors.WithStack(translateError("t1", err))
+	}
+	if n == 0 {
+		err = sql.ErrNoRows
+		return errors.WithStack(translateError("t1", err))
+	}
+	return afterUpdate(ctx, r)
+}
+
+// DeleteContext deletes the T1 from the database by the primary key.
+// *NotFoundError is returned if the row is not found.
+func (r *T1) DeleteContext(ctx context.Context, db Queryer) error {
+	if err := beforeDelete(ctx, r); err != nil {
+		return err
+	}
+	result, err := db.ExecContext(ctx,
+		`DELETE FROM t1 WHERE id = $1`,
+		&r.ID)
+	if err != nil {
+		return errors.WithStack(translateError("t1", err))
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return errors.WithStack(translateError("t1", err))
+	}
+	if n == 0 {
+		err = sql.ErrNoRows
+		return errors.WithStack(translateError("t1", err))
+	}
+	return afterDelete(ctx, r)
+}
+
+// ReloadContext selects the T1 again by its primary key, and overwrites the fields with the
+// values in the database, e.g. after a trigger or a stored procedure changed the row.
+// *NotFoundError is returned if the row is not found.
+func (r *T1) ReloadContext(ctx context.Context, db Queryer) error {
+	err := db.QueryRowContext(ctx,
+		`SELECT id, i, str, num_float, nullable_str, t_with_tz, t_without_tz, nullable_tz, json_data, xml_data FROM t1 WHERE id = $1`,
+		r.ID).Scan(&r.ID, &r.I, &r.Str, &r.NumFloat, &r.NullableStr, &r.TWithTz, &r.TWithoutTz, &r.NullableTz, &r.JSONData, &r.XMLData)
+	if err != nil {
+		return errors.WithStack(translateError("t1", err))
+	}
+	return nil
 }
 
 // GetT1ByPkContext select the T1 from the database.
@@ -73,11 +157,390 @@ func GetT1ByPkContext(ctx context.Context, db Queryer, pk0 int64) (*T1, error) {
 		`SELECT id, i, str, num_float, nullable_str, t_with_tz, t_without_tz, nullable_tz, json_data, xml_data FROM t1 WHERE id = $1`,
 		pk0).Scan(&r.ID, &r.I, &r.Str, &r.NumFloat, &r.NullableStr, &r.TWithTz, &r.TWithoutTz, &r.NullableTz, &r.JSONData, &r.XMLData)
 	if err != nil {
-		return nil, errors.WithStack(err)
+		return nil, errors.WithStack(translateError("t1", err))
+	}
+	return &r, nil
+}
+
+// GetT1ByPkForUpdateContext selects the T1 from the database with FOR UPDATE,
+// which locks the row until the end of the transaction. opt decides what happens if the row is locked
+// by another transaction: LockWait waits for it, LockNoWait fails with SQLSTATE 55P03, and
+// LockSkipLocked returns *NotFoundError.
+func GetT1ByPkForUpdateContext(ctx context.Context, db Queryer, pk0 int64, opt LockOption) (*T1, error) {
+	var r T1
+	err := db.QueryRowContext(ctx,
+		`SELECT id, i, str, num_float, nullable_str, t_with_tz, t_without_tz, nullable_tz, json_data, xml_data FROM t1 WHERE id = $1 FOR UPDATE`+opt.sql(),
+		pk0).Scan(&r.ID, &r.I, &r.Str, &r.NumFloat, &r.NullableStr, &r.TWithTz, &r.TWithoutTz, &r.NullableTz, &r.JSONData, &r.XMLData)
+	if err != nil {
+		return nil, errors.WithStack(translateError("t1", err))
+	}
+	return &r, nil
+}
+
+// GetT1ByPkForShareContext selects the T1 from the database with FOR SHARE,
+// which locks the row until the end of the transaction. opt decides what happens if the row is locked
+// by another transaction: LockWait waits for it, LockNoWait fails with SQLSTATE 55P03, and
+// LockSkipLocked returns *NotFoundError.
+func GetT1ByPkForShareContext(ctx context.Context, db Queryer, pk0 int64, opt LockOption) (*T1, error) {
+	var r T1
+	err := db.QueryRowContext(ctx,
+		`SELECT id, i, str, num_float, nullable_str, t_with_tz, t_without_tz, nullable_tz, json_data, xml_data FROM t1 WHERE id = $1 FOR SHARE`+opt.sql(),
+		pk0).Scan(&r.ID, &r.I, &r.Str, &r.NumFloat, &r.NullableStr, &r.TWithTz, &r.TWithoutTz, &r.NullableTz, &r.JSONData, &r.XMLData)
+	if err != nil {
+		return nil, errors.WithStack(translateError("t1", err))
 	}
 	return &r, nil
 }
 
+// ExistsT1ByPkContext checks if the T1 exists in the database.
+func ExistsT1ByPkContext(ctx context.Context, db Queryer, pk0 int64) (bool, error) {
+	var exists bool
+	err := db.QueryRowContext(ctx,
+		`SELECT EXISTS (SELECT 1 FROM t1 WHERE id = $1)`,
+		pk0).Scan(&exists)
+	if err != nil {
+		return false, errors.WithStack(translateError("t1", err))
+	}
+	return exists, nil
+}
+
+// GetT1ByPksContext select the T1s of the given primary keys from the database.
+// The result is ordered by the primary key, and the keys which do not exist are skipped.
+func GetT1ByPksContext(ctx context.Context, db Queryer, pks []int64) ([]*T1, error) {
+	rows, err := db.QueryContext(ctx,
+		`SELECT id, i, str, num_float, nullable_str, t_with_tz, t_without_tz, nullable_tz, json_data, xml_data FROM t1 WHERE id = ANY($1) ORDER BY id`,
+		pq.Array(pks))
+	if err != nil {
+		return nil, errors.WithStack(translateError("t1", err))
+	}
+	defer rows.Close()
+	var rs []*T1
+	for rows.Next() {
+		var r T1
+		if err := rows.Scan(&r.ID, &r.I, &r.Str, &r.NumFloat, &r.NullableStr, &r.TWithTz, &r.TWithoutTz, &r.NullableTz, &r.JSONData, &r.XMLData); err != nil {
+			return nil, errors.WithStack(translateError("t1", err))
+		}
+		rs = append(rs, &r)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.WithStack(translateError("t1", err))
+	}
+	return rs, nil
+}
+
+// T1ListOptions is the options of ListT1.
+type T1ListOptions struct {
+	// Limit is the maximum number of rows to return. Zero means no limit.
+	Limit int
+	// After is the primary key of the last row of the previous page.
+	// Only the rows after it are returned when it is not nil.
+	After *int64
+}
+
+// ListT1 lists the T1s ordered by the primary key.
+// Pass the primary key of the last row as opts.After to get the next page.
+func ListT1(ctx context.Context, db Queryer, opts T1ListOptions) ([]*T1, error) {
+	q := `SELECT id, i, str, num_float, nullable_str, t_with_tz, t_without_tz, nullable_tz, json_data, xml_data FROM t1`
+	var args []interface{}
+	if opts.After != nil {
+		q += ` WHERE id > $1`
+		args = append(args, *opts.After)
+	}
+	q += ` ORDER BY id`
+	if opts.Limit > 0 {
+		args = append(args, opts.Limit)
+		q += fmt.Sprintf(` LIMIT $%d`, len(args))
+	}
+	rows, err := db.QueryContext(ctx, q, args...)
+	if err != nil {
+		return nil, errors.WithStack(translateError("t1", err))
+	}
+	defer rows.Close()
+	var rs []*T1
+	for rows.Next() {
+		var r T1
+		if err := rows.Scan(&r.ID, &r.I, &r.Str, &r.NumFloat, &r.NullableStr, &r.TWithTz, &r.TWithoutTz, &r.NullableTz, &r.JSONData, &r.XMLData); err != nil {
+			return nil, errors.WithStack(translateError("t1", err))
+		}
+		rs = append(rs, &r)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.WithStack(translateError("t1", err))
+	}
+	return rs, nil
+}
+
+// T1Filter builds a parameterized WHERE clause of t1.
+// The predicates are combined with AND.
+type T1Filter struct {
+	conds []string
+	args  []interface{}
+}
+
+// NewT1Filter creates an empty T1Filter, which matches every row.
+func NewT1Filter() *T1Filter {
+	return &T1Filter{}
+}
+
+func (f *T1Filter) add(cond string, v interface{}) *T1Filter {
+	f.args = append(f.args, v)
+	f.conds = append(f.conds, fmt.Sprintf(cond, len(f.args)))
+	return f
+}
+
+// Where returns the WHERE clause and its arguments. It returns an empty clause
+// if the filter is nil or has no predicate.
+func (f *T1Filter) Where() (string, []interface{}) {
+	if f == nil || len(f.conds) == 0 {
+		return "", nil
+	}
+	return " WHERE " + strings.Join(f.conds, " AND "), f.args
+}
+
+// IDEq filters the rows whose id equals to v.
+func (f *T1Filter) IDEq(v int64) *T1Filter {
+	return f.add("id = $%d", v)
+}
+
+// IDIn filters the rows whose id is one of vs.
+func (f *T1Filter) IDIn(vs ...int64) *T1Filter {
+	return f.add("id = ANY($%d)", pq.Array(vs))
+}
+
+// IDLt filters the rows whose id is less than v.
+func (f *T1Filter) IDLt(v int64) *T1Filter {
+	return f.add("id < $%d", v)
+}
+
+// IDGt filters the rows whose id is greater than v.
+func (f *T1Filter) IDGt(v int64) *T1Filter {
+	return f.add("id > $%d", v)
+}
+
+// IEq filters the rows whose i equals to v.
+func (f *T1Filter) IEq(v int) *T1Filter {
+	return f.add("i = $%d", v)
+}
+
+// IIn filters the rows whose i is one of vs.
+func (f *T1Filter) IIn(vs ...int) *T1Filter {
+	return f.add("i = ANY($%d)", pq.Array(vs))
+}
+
+// ILt filters the rows whose i is less than v.
+func (f *T1Filter) ILt(v int) *T1Filter {
+	return f.add("i < $%d", v)
+}
+
+// IGt filters the rows whose i is greater than v.
+func (f *T1Filter) IGt(v int) *T1Filter {
+	return f.add("i > $%d", v)
+}
+
+// StrEq filters the rows whose str equals to v.
+func (f *T1Filter) StrEq(v string) *T1Filter {
+	return f.add("str = $%d", v)
+}
+
+// StrIn filters the rows whose str is one of vs.
+func (f *T1Filter) StrIn(vs ...string) *T1Filter {
+	return f.add("str = ANY($%d)", pq.Array(vs))
+}
+
+// StrLt filters the rows whose str is less than v.
+func (f *T1Filter) StrLt(v string) *T1Filter {
+	return f.add("str < $%d", v)
+}
+
+// StrGt filters the rows whose str is greater than v.
+func (f *T1Filter) StrGt(v string) *T1Filter {
+	return f.add("str > $%d", v)
+}
+
+// NumFloatEq filters the rows whose num_float equals to v.
+func (f *T1Filter) NumFloatEq(v float64) *T1Filter {
+	return f.add("num_float = $%d", v)
+}
+
+// NumFloatIn filters the rows whose num_float is one of vs.
+func (f *T1Filter) NumFloatIn(vs ...float64) *T1Filter {
+	return f.add("num_float = ANY($%d)", pq.Array(vs))
+}
+
+// NumFloatLt filters the rows whose num_float is less than v.
+func (f *T1Filter) NumFloatLt(v float64) *T1Filter {
+	return f.add("num_float < $%d", v)
+}
+
+// NumFloatGt filters the rows whose num_float is greater than v.
+func (f *T1Filter) NumFloatGt(v float64) *T1Filter {
+	return f.add("num_float > $%d", v)
+}
+
+// NullableStrEq filters the rows whose nullable_str equals to v.
+func (f *T1Filter) NullableStrEq(v sql.NullString) *T1Filter {
+	return f.add("nullable_str = $%d", v)
+}
+
+// NullableStrIn filters the rows whose nullable_str is one of vs.
+func (f *T1Filter) NullableStrIn(vs ...sql.NullString) *T1Filter {
+	return f.add("nullable_str = ANY($%d)", pq.Array(vs))
+}
+
+// NullableStrLt filters the rows whose nullable_str is less than v.
+func (f *T1Filter) NullableStrLt(v sql.NullString) *T1Filter {
+	return f.add("nullable_str < $%d", v)
+}
+
+// NullableStrGt filters the rows whose nullable_str is greater than v.
+func (f *T1Filter) NullableStrGt(v sql.NullString) *T1Filter {
+	return f.add("nullable_str > $%d", v)
+}
+
+// NullableStrIsNull filters the rows whose nullable_str is NULL.
+func (f *T1Filter) NullableStrIsNull() *T1Filter {
+	f.conds = append(f.conds, "nullable_str IS NULL")
+	return f
+}
+
+// NullableStrIsNotNull filters the rows whose nullable_str is not NULL.
+func (f *T1Filter) NullableStrIsNotNull() *T1Filter {
+	f.conds = append(f.conds, "nullable_str IS NOT NULL")
+	return f
+}
+
+// TWithTzEq filters the rows whose t_with_tz equals to v.
+func (f *T1Filter) TWithTzEq(v time.Time) *T1Filter {
+	return f.add("t_with_tz = $%d", v)
+}
+
+// TWithTzIn filters the rows whose t_with_tz is one of vs.
+func (f *T1Filter) TWithTzIn(vs ...time.Time) *T1Filter {
+	return f.add("t_with_tz = ANY($%d)", pq.Array(vs))
+}
+
+// TWithTzLt filters the rows whose t_with_tz is less than v.
+func (f *T1Filter) TWithTzLt(v time.Time) *T1Filter {
+	return f.add("t_with_tz < $%d", v)
+}
+
+// TWithTzGt filters the rows whose t_with_tz is greater than v.
+func (f *T1Filter) TWithTzGt(v time.Time) *T1Filter {
+	return f.add("t_with_tz > $%d", v)
+}
+
+// TWithoutTzEq filters the rows whose t_without_tz equals to v.
+func (f *T1Filter) TWithoutTzEq(v time.Time) *T1Filter {
+	return f.add("t_without_tz = $%d", v)
+}
+
+// TWithoutTzIn filters the rows whose t_without_tz is one of vs.
+func (f *T1Filter) TWithoutTzIn(vs ...time.Time) *T1Filter {
+	return f.add("t_without_tz = ANY($%d)", pq.Array(vs))
+}
+
+// TWithoutTzLt filters the rows whose t_without_tz is less than v.
+func (f *T1Filter) TWithoutTzLt(v time.Time) *T1Filter {
+	return f.add("t_without_tz < $%d", v)
+}
+
+// TWithoutTzGt filters the rows whose t_without_tz is greater than v.
+func (f *T1Filter) TWithoutTzGt(v time.Time) *T1Filter {
+	return f.add("t_without_tz > $%d", v)
+}
+
+// NullableTzEq filters the rows whose nullable_tz equals to v.
+func (f *T1Filter) NullableTzEq(v *time.Time) *T1Filter {
+	return f.add("nullable_tz = $%d", v)
+}
+
+// NullableTzIn filters the rows whose nullable_tz is one of vs.
+func (f *T1Filter) NullableTzIn(vs ...*time.Time) *T1Filter {
+	return f.add("nullable_tz = ANY($%d)", pq.Array(vs))
+}
+
+// NullableTzLt filters the rows whose nullable_tz is less than v.
+func (f *T1Filter) NullableTzLt(v *time.Time) *T1Filter {
+	return f.add("nullable_tz < $%d", v)
+}
+
+// NullableTzGt filters the rows whose nullable_tz is greater than v.
+func (f *T1Filter) NullableTzGt(v *time.Time) *T1Filter {
+	return f.add("nullable_tz > $%d", v)
+}
+
+// NullableTzIsNull filters the rows whose nullable_tz is NULL.
+func (f *T1Filter) NullableTzIsNull() *T1Filter {
+	f.conds = append(f.conds, "nullable_tz IS NULL")
+	return f
+}
+
+// NullableTzIsNotNull filters the rows whose nullable_tz is not NULL.
+func (f *T1Filter) NullableTzIsNotNull() *T1Filter {
+	f.conds = append(f.conds, "nullable_tz IS NOT NULL")
+	return f
+}
+
+// FindT1 selects the T1s matching the filter from the database.
+// The result is ordered by the primary key.
+func FindT1(ctx context.Context, db Queryer, f *T1Filter) ([]*T1, error) {
+	where, args := f.Where()
+	rows, err := db.QueryContext(ctx,
+		`SELECT id, i, str, num_float, nullable_str, t_with_tz, t_without_tz, nullable_tz, json_data, xml_data FROM t1`+where+` ORDER BY id`,
+		args...)
+	if err != nil {
+		return nil, errors.WithStack(translateError("t1", err))
+	}
+	defer rows.Close()
+	var rs []*T1
+	for rows.Next() {
+		var r T1
+		if err := rows.Scan(&r.ID, &r.I, &r.Str, &r.NumFloat, &r.NullableStr, &r.TWithTz, &r.TWithoutTz, &r.NullableTz, &r.JSONData, &r.XMLData); err != nil {
+			return nil, errors.WithStack(translateError("t1", err))
+		}
+		rs = append(rs, &r)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.WithStack(translateError("t1", err))
+	}
+	return rs, nil
+}
+
+// CountT1 counts the T1s matching the filter in the database.
+// Pass nil as the filter to count every row.
+func CountT1(ctx context.Context, db Queryer, f *T1Filter) (int64, error) {
+	where, args := f.Where()
+	var n int64
+	err := db.QueryRowContext(ctx,
+		`SELECT count(*) FROM t1`+where,
+		args...).Scan(&n)
+	if err != nil {
+		return 0, errors.WithStack(translateError("t1", err))
+	}
+	return n, nil
+}
+
+// DeleteT1Where deletes the T1s matching the filter from the database,
+// and returns the number of the deleted rows. A filter without predicate is rejected
+// to avoid deleting every row by mistake.
+func DeleteT1Where(ctx context.Context, db Queryer, f *T1Filter) (int64, error) {
+	where, args := f.Where()
+	if where == "" {
+		return 0, errors.New("refusing to delete every row of t1 with an empty filter")
+	}
+	result, err := db.ExecContext(ctx,
+		`DELETE FROM t1`+where,
+		args...)
+	if err != nil {
+		return 0, errors.WithStack(translateError("t1", err))
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.WithStack(translateError("t1", err))
+	}
+	return n, nil
+}
+
 // T2 represents public.t2
 type T2 struct {
 	ID         int64     // id
@@ -87,6 +550,12 @@ type T2 struct {
 	TWithoutTz time.Time // t_without_tz
 }
 
+// T2Pk represents the primary key of public.t2
+type T2Pk struct {
+	ID int64 // id
+	I  int   // i
+}
+
 // Create inserts the T2 to the database.
 //
 // Deprecated: Use CreateContext instead.
@@ -101,351 +570,3741 @@ func GetT2ByPk(db Queryer, pk0 int64, pk1 int) (*T2, error) {
 	return GetT2ByPkContext(context.Background(), db, pk0, pk1)
 }
 
+// Validate checks the T2 against the constraints of t2 which can be checked
+// without the database, and returns *ValidationError listing every failing field.
+func (r *T2) Validate() error {
+	var errs []FieldError
+	if r.Str == "" {
+		errs = append(errs, FieldError{Field: "Str", Column: "str", Message: "must not be empty"})
+	}
+	if len(errs) > 0 {
+		return &ValidationError{Table: "t2", Fields: errs}
+	}
+	return nil
+}
+
 // CreateContext inserts the T2 to the database.
 func (r *T2) CreateContext(ctx context.Context, db Queryer) error {
+	if err := beforeCreate(ctx, r); err != nil {
+		return err
+	}
 	err := db.QueryRowContext(ctx,
-		`INSERT INTO t2 (str, t_with_tz, t_without_tz) VALUES ($1, $2, $3) RETURNING id, i`,
-		&r.Str, &r.TWithTz, &r.TWithoutTz).Scan(&r.ID, &r.I)
+		`INSERT INTO t2 (i, str, t_with_tz, t_without_tz) VALUES ($1, $2, $3, $4) RETURNING id`,
+		&r.I, &r.Str, &r.TWithTz, &r.TWithoutTz).Scan(&r.ID)
 	if err != nil {
-		return errors.WithStack(err)
+		return errors.WithStack(translateError("t2", err))
 	}
-	return nil
+	return afterCreate(ctx, r)
 }
 
 // CreateOnConflictDoNothing inserts the T2 to the database.
 // If a conflict occurs (e.g., unique constraint violation), the insert is skipped without error.
 // Returns true if the row was inserted, false if it was skipped due to conflict.
 func (r *T2) CreateOnConflictDoNothing(ctx context.Context, db Queryer) (bool, error) {
+	if err := beforeCreate(ctx, r); err != nil {
+		return false, err
+	}
 	err := db.QueryRowContext(ctx,
-		`INSERT INTO t2 (str, t_with_tz, t_without_tz) VALUES ($1, $2, $3) ON CONFLICT DO NOTHING RETURNING id, i`,
-		&r.Str, &r.TWithTz, &r.TWithoutTz).Scan(&r.ID, &r.I)
+		`INSERT INTO t2 (i, str, t_with_tz, t_without_tz) VALUES ($1, $2, $3, $4) ON CONFLICT DO NOTHING RETURNING id`,
+		&r.I, &r.Str, &r.TWithTz, &r.TWithoutTz).Scan(&r.ID)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return false, nil
 		}
-		return false, errors.WithStack(err)
+		return false, errors.WithStack(translateError("t2", err))
 	}
 	// Row was successfully inserted
-	return true, nil
+	return true, afterCreate(ctx, r)
 }
 
-// GetT2ByPkContext select the T2 from the database.
-func GetT2ByPkContext(ctx context.Context, db Queryer, pk0 int64, pk1 int) (*T2, error) {
-	var r T2
-	err := db.QueryRowContext(ctx,
-		`SELECT id, i, str, t_with_tz, t_without_tz FROM t2 WHERE id = $1 AND i = $2`,
-		pk0, pk1).Scan(&r.ID, &r.I, &r.Str, &r.TWithTz, &r.TWithoutTz)
+// UpdateContext updates the T2 in the database by the primary key.
+// *NotFoundError is returned if the row is not found.
+func (r *T2) UpdateContext(ctx context.Context, db Queryer) error {
+	if err := beforeUpdate(ctx, r); err != nil {
+		return err
+	}
+	result, err := db.ExecContext(ctx,
+		`UPDATE t2 SET str = $1, t_with_tz = $2, t_without_tz = $3 WHERE id = $4 AND i = $5`,
+		&r.Str, &r.TWithTz, &r.TWithoutTz, &r.ID, &r.I)
 	if err != nil {
-		return nil, errors.WithStack(err)
+		return errors.WithStack(translateError("t2", err))
 	}
-	return &r, nil
-}
-
-// T3 represents public.t3
-type T3 struct {
-	ID int // id
-	I  int // i
-}
-
-// Create inserts the T3 to the database.
-//
-// Deprecated: Use CreateContext instead.
-func (r *T3) Create(db Queryer) error {
-	return r.CreateContext(context.Background(), db)
+	n, err := result.RowsAffected()
+	if err != nil {
+		return errors.WithStack(translateError("t2", err))
+	}
+	if n == 0 {
+		err = sql.ErrNoRows
+		return errors.WithStack(translateError("t2", err))
+	}
+	return afterUpdate(ctx, r)
 }
 
-// GetT3ByPk select the T3 from the database.
-//
-// Deprecated: Use GetT3ByPkContext instead.
-func GetT3ByPk(db Queryer, pk0 int, pk1 int) (*T3, error) {
-	return GetT3ByPkContext(context.Background(), db, pk0, pk1)
+// DeleteContext deletes the T2 from the database by the primary key.
+// *NotFoundError is returned if the row is not found.
+func (r *T2) DeleteContext(ctx context.Context, db Queryer) error {
+	if err := beforeDelete(ctx, r); err != nil {
+		return err
+	}
+	result, err := db.ExecContext(ctx,
+		`DELETE FROM t2 WHERE id = $1 AND i = $2`,
+		&r.ID, &r.I)
+	if err != nil {
+		return errors.WithStack(translateError("t2", err))
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return errors.WithStack(translateError("t2", err))
+	}
+	if n == 0 {
+		err = sql.ErrNoRows
+		return errors.WithStack(translateError("t2", err))
+	}
+	return afterDelete(ctx, r)
 }
 
-// CreateContext inserts the T3 to the database.
-func (r *T3) CreateContext(ctx context.Context, db Queryer) error {
-	_, err := db.ExecContext(ctx,
-		`INSERT INTO t3 (id, i) VALUES ($1, $2)`,
-		&r.ID, &r.I)
+// ReloadContext selects the T2 again by its primary key, and overwrites the fields with the
+// values in the database, e.g. after a trigger or a stored procedure changed the row.
+// *NotFoundError is returned if the row is not found.
+func (r *T2) ReloadContext(ctx context.Context, db Queryer) error {
+	err := db.QueryRowContext(ctx,
+		`SELECT id, i, str, t_with_tz, t_without_tz FROM t2 WHERE id = $1 AND i = $2`,
+		r.ID, r.I).Scan(&r.ID, &r.I, &r.Str, &r.TWithTz, &r.TWithoutTz)
 	if err != nil {
-		return errors.WithStack(err)
+		return errors.WithStack(translateError("t2", err))
 	}
 	return nil
 }
 
-// CreateOnConflictDoNothing inserts the T3 to the database.
-// If a conflict occurs (e.g., unique constraint violation), the insert is skipped without error.
-// Returns true if the row was inserted, false if it was skipped due to conflict.
-func (r *T3) CreateOnConflictDoNothing(ctx context.Context, db Queryer) (bool, error) {
-	result, err := db.ExecContext(ctx,
-		`INSERT INTO t3 (id, i) VALUES ($1, $2) ON CONFLICT DO NOTHING`,
-		&r.ID, &r.I)
+// GetT2ByPkContext select the T2 from the database.
+func GetT2ByPkContext(ctx context.Context, db Queryer, pk0 int64, pk1 int) (*T2, error) {
+	var r T2
+	err := db.QueryRowContext(ctx,
+		`SELECT id, i, str, t_with_tz, t_without_tz FROM t2 WHERE id = $1 AND i = $2`,
+		pk0, pk1).Scan(&r.ID, &r.I, &r.Str, &r.TWithTz, &r.TWithoutTz)
 	if err != nil {
-		return false, errors.WithStack(err)
+		return nil, errors.WithStack(translateError("t2", err))
 	}
-	rowsAffected, err := result.RowsAffected()
+	return &r, nil
+}
+
+// GetT2ByPkForUpdateContext selects the T2 from the database with FOR UPDATE,
+// which locks the row until the end of the transaction. opt decides what happens if the row is locked
+// by another transaction: LockWait waits for it, LockNoWait fails with SQLSTATE 55P03, and
+// LockSkipLocked returns *NotFoundError.
+func GetT2ByPkForUpdateContext(ctx context.Context, db Queryer, pk0 int64, pk1 int, opt LockOption) (*T2, error) {
+	var r T2
+	err := db.QueryRowContext(ctx,
+		`SELECT id, i, str, t_with_tz, t_without_tz FROM t2 WHERE id = $1 AND i = $2 FOR UPDATE`+opt.sql(),
+		pk0, pk1).Scan(&r.ID, &r.I, &r.Str, &r.TWithTz, &r.TWithoutTz)
 	if err != nil {
-		return false, errors.WithStack(err)
+		return nil, errors.WithStack(translateError("t2", err))
 	}
-	return rowsAffected > 0, nil
+	return &r, nil
 }
 
-// GetT3ByPkContext select the T3 from the database.
-func GetT3ByPkContext(ctx context.Context, db Queryer, pk0 int, pk1 int) (*T3, error) {
-	var r T3
+// GetT2ByPkForShareContext selects the T2 from the database with FOR SHARE,
+// which locks the row until the end of the transaction. opt decides what happens if the row is locked
+// by another transaction: LockWait waits for it, LockNoWait fails with SQLSTATE 55P03, and
+// LockSkipLocked returns *NotFoundError.
+func GetT2ByPkForShareContext(ctx context.Context, db Queryer, pk0 int64, pk1 int, opt LockOption) (*T2, error) {
+	var r T2
 	err := db.QueryRowContext(ctx,
-		`SELECT id, i FROM t3 WHERE id = $1 AND i = $2`,
-		pk0, pk1).Scan(&r.ID, &r.I)
+		`SELECT id, i, str, t_with_tz, t_without_tz FROM t2 WHERE id = $1 AND i = $2 FOR SHARE`+opt.sql(),
+		pk0, pk1).Scan(&r.ID, &r.I, &r.Str, &r.TWithTz, &r.TWithoutTz)
 	if err != nil {
-		return nil, errors.WithStack(err)
+		return nil, errors.WithStack(translateError("t2", err))
 	}
 	return &r, nil
 }
 
-// UserAccount represents public.user_account
-type UserAccount struct {
-	ID        int64  // id
-	Email     string // email
-	LastName  string // last_name
-	FirstName string // first_name
-}
-
-// Create inserts the UserAccount to the database.
-//
-// Deprecated: Use CreateContext instead.
-func (r *UserAccount) Create(db Queryer) error {
-	return r.CreateContext(context.Background(), db)
-}
-
-// GetUserAccountByPk select the UserAccount from the database.
-//
-// Deprecated: Use GetUserAccountByPkContext instead.
-func GetUserAccountByPk(db Queryer, pk0 int64) (*UserAccount, error) {
-	return GetUserAccountByPkContext(context.Background(), db, pk0)
-}
-
-// CreateContext inserts the UserAccount to the database.
-func (r *UserAccount) CreateContext(ctx context.Context, db Queryer) error {
+// ExistsT2ByPkContext checks if the T2 exists in the database.
+func ExistsT2ByPkContext(ctx context.Context, db Queryer, pk0 int64, pk1 int) (bool, error) {
+	var exists bool
 	err := db.QueryRowContext(ctx,
-		`INSERT INTO user_account (email, last_name, first_name) VALUES ($1, $2, $3) RETURNING id`,
-		&r.Email, &r.LastName, &r.FirstName).Scan(&r.ID)
+		`SELECT EXISTS (SELECT 1 FROM t2 WHERE id = $1 AND i = $2)`,
+		pk0, pk1).Scan(&exists)
 	if err != nil {
-		return errors.WithStack(err)
+		return false, errors.WithStack(translateError("t2", err))
 	}
-	return nil
+	return exists, nil
 }
 
-// CreateOnConflictDoNothing inserts the UserAccount to the database.
-// If a conflict occurs (e.g., unique constraint violation), the insert is skipped without error.
-// Returns true if the row was inserted, false if it was skipped due to conflict.
-func (r *UserAccount) CreateOnConflictDoNothing(ctx context.Context, db Queryer) (bool, error) {
-	err := db.QueryRowContext(ctx,
-		`INSERT INTO user_account (email, last_name, first_name) VALUES ($1, $2, $3) ON CONFLICT DO NOTHING RETURNING id`,
-		&r.Email, &r.LastName, &r.FirstName).Scan(&r.ID)
+// GetT2ByPksContext select the T2s of the given primary keys from the database.
+// The result is ordered by the primary key, and the keys which do not exist are skipped.
+func GetT2ByPksContext(ctx context.Context, db Queryer, pks []T2Pk) ([]*T2, error) {
+	if len(pks) == 0 {
+		return nil, nil
+	}
+	values := make([]string, 0, len(pks))
+	args := make([]interface{}, 0, len(pks)*2)
+	for _, pk := range pks {
+		args = append(args, pk.ID, pk.I)
+		values = append(values, fmt.Sprintf("($%d::bigint, $%d::integer)", len(args)-1, len(args)))
+	}
+	rows, err := db.QueryContext(ctx,
+		fmt.Sprintf(`SELECT id, i, str, t_with_tz, t_without_tz FROM t2 WHERE (id, i) IN (VALUES %s) ORDER BY id, i`, strings.Join(values, ", ")),
+		args...)
 	if err != nil {
-		if err == sql.ErrNoRows {
-			return false, nil
+		return nil, errors.WithStack(translateError("t2", err))
+	}
+	defer rows.Close()
+	var rs []*T2
+	for rows.Next() {
+		var r T2
+		if err := rows.Scan(&r.ID, &r.I, &r.Str, &r.TWithTz, &r.TWithoutTz); err != nil {
+			return nil, errors.WithStack(translateError("t2", err))
 		}
-		return false, errors.WithStack(err)
+		rs = append(rs, &r)
 	}
-	// Row was successfully inserted
-	return true, nil
+	if err := rows.Err(); err != nil {
+		return nil, errors.WithStack(translateError("t2", err))
+	}
+	return rs, nil
 }
 
-// GetUserAccountByPkContext select the UserAccount from the database.
-func GetUserAccountByPkContext(ctx context.Context, db Queryer, pk0 int64) (*UserAccount, error) {
-	var r UserAccount
-	err := db.QueryRowContext(ctx,
-		`SELECT id, email, last_name, first_name FROM user_account WHERE id = $1`,
-		pk0).Scan(&r.ID, &r.Email, &r.LastName, &r.FirstName)
+// T2ListOptions is the options of ListT2.
+type T2ListOptions struct {
+	// Limit is the maximum number of rows to return. Zero means no limit.
+	Limit int
+	// After is the primary key of the last row of the previous page.
+	// Only the rows after it are returned when it is not nil.
+	After *T2Pk
+}
+
+// ListT2 lists the T2s ordered by the primary key.
+// Pass the primary key of the last row as opts.After to get the next page.
+func ListT2(ctx context.Context, db Queryer, opts T2ListOptions) ([]*T2, error) {
+	q := `SELECT id, i, str, t_with_tz, t_without_tz FROM t2`
+	var args []interface{}
+	if opts.After != nil {
+		q += ` WHERE (id, i) > ($1, $2)`
+		args = append(args, opts.After.ID, opts.After.I)
+	}
+	q += ` ORDER BY id, i`
+	if opts.Limit > 0 {
+		args = append(args, opts.Limit)
+		q += fmt.Sprintf(` LIMIT $%d`, len(args))
+	}
+	rows, err := db.QueryContext(ctx, q, args...)
 	if err != nil {
-		return nil, errors.WithStack(err)
+		return nil, errors.WithStack(translateError("t2", err))
 	}
-	return &r, nil
+	defer rows.Close()
+	var rs []*T2
+	for rows.Next() {
+		var r T2
+		if err := rows.Scan(&r.ID, &r.I, &r.Str, &r.TWithTz, &r.TWithoutTz); err != nil {
+			return nil, errors.WithStack(translateError("t2", err))
+		}
+		rs = append(rs, &r)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.WithStack(translateError("t2", err))
+	}
+	return rs, nil
 }
 
-// UserAccountCompositePk represents public.user_account_composite_pk
-type UserAccountCompositePk struct {
-	ID        int64  // id
-	Email     string // email
-	LastName  string // last_name
-	FirstName string // first_name
+// T2Filter builds a parameterized WHERE clause of t2.
+// The predicates are combined with AND.
+type T2Filter struct {
+	conds []string
+	args  []interface{}
 }
 
-// Create inserts the UserAccountCompositePk to the database.
-//
-// Deprecated: Use CreateContext instead.
-func (r *UserAccountCompositePk) Create(db Queryer) error {
-	return r.CreateContext(context.Background(), db)
+// NewT2Filter creates an empty T2Filter, which matches every row.
+func NewT2Filter() *T2Filter {
+	return &T2Filter{}
 }
 
-// GetUserAccountCompositePkByPk select the UserAccountCompositePk from the database.
-//
-// Deprecated: Use GetUserAccountCompositePkByPkContext instead.
-func GetUserAccountCompositePkByPk(db Queryer, pk0 int64, pk1 string) (*UserAccountCompositePk, error) {
-	return GetUserAccountCompositePkByPkContext(context.Background(), db, pk0, pk1)
+func (f *T2Filter) add(cond string, v interface{}) *T2Filter {
+	f.args = append(f.args, v)
+	f.conds = append(f.conds, fmt.Sprintf(cond, len(f.args)))
+	return f
 }
 
-// CreateContext inserts the UserAccountCompositePk to the database.
-func (r *UserAccountCompositePk) CreateContext(ctx context.Context, db Queryer) error {
-	_, err := db.ExecContext(ctx,
-		`INSERT INTO user_account_composite_pk (id, email, last_name, first_name) VALUES ($1, $2, $3, $4)`,
-		&r.ID, &r.Email, &r.LastName, &r.FirstName)
-	if err != nil {
-		return errors.WithStack(err)
+// Where returns the WHERE clause and its arguments. It returns an empty clause
+// if the filter is nil or has no predicate.
+func (f *T2Filter) Where() (string, []interface{}) {
+	if f == nil || len(f.conds) == 0 {
+		return "", nil
 	}
-	return nil
+	return " WHERE " + strings.Join(f.conds, " AND "), f.args
 }
 
-// CreateOnConflictDoNothing inserts the UserAccountCompositePk to the database.
-// If a conflict occurs (e.g., unique constraint violation), the insert is skipped without error.
-// Returns true if the row was inserted, false if it was skipped due to conflict.
-func (r *UserAccountCompositePk) CreateOnConflictDoNothing(ctx context.Context, db Queryer) (bool, error) {
-	result, err := db.ExecContext(ctx,
-		`INSERT INTO user_account_composite_pk (id, email, last_name, first_name) VALUES ($1, $2, $3, $4) ON CONFLICT DO NOTHING`,
-		&r.ID, &r.Email, &r.LastName, &r.FirstName)
-	if err != nil {
-		return false, errors.WithStack(err)
-	}
-	rowsAffected, err := result.RowsAffected()
-	if err != nil {
-		return false, errors.WithStack(err)
-	}
-	return rowsAffected > 0, nil
+// IDEq filters the rows whose id equals to v.
+func (f *T2Filter) IDEq(v int64) *T2Filter {
+	return f.add("id = $%d", v)
 }
 
-// GetUserAccountCompositePkByPkContext select the UserAccountCompositePk from the database.
-func GetUserAccountCompositePkByPkContext(ctx context.Context, db Queryer, pk0 int64, pk1 string) (*UserAccountCompositePk, error) {
-	var r UserAccountCompositePk
-	err := db.QueryRowContext(ctx,
-		`SELECT id, email, last_name, first_name FROM user_account_composite_pk WHERE id = $1 AND email = $2`,
-		pk0, pk1).Scan(&r.ID, &r.Email, &r.LastName, &r.FirstName)
-	if err != nil {
-		return nil, errors.WithStack(err)
-	}
-	return &r, nil
+// IDIn filters the rows whose id is one of vs.
+func (f *T2Filter) IDIn(vs ...int64) *T2Filter {
+	return f.add("id = ANY($%d)", pq.Array(vs))
 }
 
-// UserAccountUUID represents public.user_account_uuid
-type UserAccountUUID struct {
-	UUID      string // uuid
-	Email     string // email
-	LastName  string // last_name
-	FirstName string // first_name
+// IDLt filters the rows whose id is less than v.
+func (f *T2Filter) IDLt(v int64) *T2Filter {
+	return f.add("id < $%d", v)
 }
 
-// Create inserts the UserAccountUUID to the database.
-//
-// Deprecated: Use CreateContext instead.
-func (r *UserAccountUUID) Create(db Queryer) error {
-	return r.CreateContext(context.Background(), db)
+// IDGt filters the rows whose id is greater than v.
+func (f *T2Filter) IDGt(v int64) *T2Filter {
+	return f.add("id > $%d", v)
 }
 
-// GetUserAccountUUIDByPk select the UserAccountUUID from the database.
-//
-// Deprecated: Use GetUserAccountUUIDByPkContext instead.
-func GetUserAccountUUIDByPk(db Queryer, pk0 string) (*UserAccountUUID, error) {
-	return GetUserAccountUUIDByPkContext(context.Background(), db, pk0)
+// IEq filters the rows whose i equals to v.
+func (f *T2Filter) IEq(v int) *T2Filter {
+	return f.add("i = $%d", v)
 }
 
-// CreateContext inserts the UserAccountUUID to the database.
-func (r *UserAccountUUID) CreateContext(ctx context.Context, db Queryer) error {
-	err := db.QueryRowContext(ctx,
-		`INSERT INTO user_account_uuid (email, last_name, first_name) VALUES ($1, $2, $3) RETURNING uuid`,
-		&r.Email, &r.LastName, &r.FirstName).Scan(&r.UUID)
+// IIn filters the rows whose i is one of vs.
+func (f *T2Filter) IIn(vs ...int) *T2Filter {
+	return f.add("i = ANY($%d)", pq.Array(vs))
+}
+
+// ILt filters the rows whose i is less than v.
+func (f *T2Filter) ILt(v int) *T2Filter {
+	return f.add("i < $%d", v)
+}
+
+// IGt filters the rows whose i is greater than v.
+func (f *T2Filter) IGt(v int) *T2Filter {
+	return f.add("i > $%d", v)
+}
+
+// StrEq filters the rows whose str equals to v.
+func (f *T2Filter) StrEq(v string) *T2Filter {
+	return f.add("str = $%d", v)
+}
+
+// StrIn filters the rows whose str is one of vs.
+func (f *T2Filter) StrIn(vs ...string) *T2Filter {
+	return f.add("str = ANY($%d)", pq.Array(vs))
+}
+
+// StrLt filters the rows whose str is less than v.
+func (f *T2Filter) StrLt(v string) *T2Filter {
+	return f.add("str < $%d", v)
+}
+
+// StrGt filters the rows whose str is greater than v.
+func (f *T2Filter) StrGt(v string) *T2Filter {
+	return f.add("str > $%d", v)
+}
+
+// TWithTzEq filters the rows whose t_with_tz equals to v.
+func (f *T2Filter) TWithTzEq(v time.Time) *T2Filter {
+	return f.add("t_with_tz = $%d", v)
+}
+
+// TWithTzIn filters the rows whose t_with_tz is one of vs.
+func (f *T2Filter) TWithTzIn(vs ...time.Time) *T2Filter {
+	return f.add("t_with_tz = ANY($%d)", pq.Array(vs))
+}
+
+// TWithTzLt filters the rows whose t_with_tz is less than v.
+func (f *T2Filter) TWithTzLt(v time.Time) *T2Filter {
+	return f.add("t_with_tz < $%d", v)
+}
+
+// TWithTzGt filters the rows whose t_with_tz is greater than v.
+func (f *T2Filter) TWithTzGt(v time.Time) *T2Filter {
+	return f.add("t_with_tz > $%d", v)
+}
+
+// TWithoutTzEq filters the rows whose t_without_tz equals to v.
+func (f *T2Filter) TWithoutTzEq(v time.Time) *T2Filter {
+	return f.add("t_without_tz = $%d", v)
+}
+
+// TWithoutTzIn filters the rows whose t_without_tz is one of vs.
+func (f *T2Filter) TWithoutTzIn(vs ...time.Time) *T2Filter {
+	return f.add("t_without_tz = ANY($%d)", pq.Array(vs))
+}
+
+// TWithoutTzLt filters the rows whose t_without_tz is less than v.
+func (f *T2Filter) TWithoutTzLt(v time.Time) *T2Filter {
+	return f.add("t_without_tz < $%d", v)
+}
+
+// TWithoutTzGt filters the rows whose t_without_tz is greater than v.
+func (f *T2Filter) TWithoutTzGt(v time.Time) *T2Filter {
+	return f.add("t_without_tz > $%d", v)
+}
+
+// FindT2 selects the T2s matching the filter from the database.
+// The result is ordered by the primary key.
+func FindT2(ctx context.Context, db Queryer, f *T2Filter) ([]*T2, error) {
+	where, args := f.Where()
+	rows, err := db.QueryContext(ctx,
+		`SELECT id, i, str, t_with_tz, t_without_tz FROM t2`+where+` ORDER BY id, i`,
+		args...)
 	if err != nil {
-		return errors.WithStack(err)
+		return nil, errors.WithStack(translateError("t2", err))
 	}
-	return nil
+	defer rows.Close()
+	var rs []*T2
+	for rows.Next() {
+		var r T2
+		if err := rows.Scan(&r.ID, &r.I, &r.Str, &r.TWithTz, &r.TWithoutTz); err != nil {
+			return nil, errors.WithStack(translateError("t2", err))
+		}
+		rs = append(rs, &r)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.WithStack(translateError("t2", err))
+	}
+	return rs, nil
 }
 
-// CreateOnConflictDoNothing inserts the UserAccountUUID to the database.
-// If a conflict occurs (e.g., unique constraint violation), the insert is skipped without error.
-// Returns true if the row was inserted, false if it was skipped due to conflict.
-func (r *UserAccountUUID) CreateOnConflictDoNothing(ctx context.Context, db Queryer) (bool, error) {
+// CountT2 counts the T2s matching the filter in the database.
+// Pass nil as the filter to count every row.
+func CountT2(ctx context.Context, db Queryer, f *T2Filter) (int64, error) {
+	where, args := f.Where()
+	var n int64
 	err := db.QueryRowContext(ctx,
-		`INSERT INTO user_account_uuid (email, last_name, first_name) VALUES ($1, $2, $3) ON CONFLICT DO NOTHING RETURNING uuid`,
-		&r.Email, &r.LastName, &r.FirstName).Scan(&r.UUID)
+		`SELECT count(*) FROM t2`+where,
+		args...).Scan(&n)
 	if err != nil {
-		if err == sql.ErrNoRows {
-			return false, nil
-		}
-		return false, errors.WithStack(err)
+		return 0, errors.WithStack(translateError("t2", err))
 	}
-	// Row was successfully inserted
-	return true, nil
+	return n, nil
 }
 
-// GetUserAccountUUIDByPkContext select the UserAccountUUID from the database.
-func GetUserAccountUUIDByPkContext(ctx context.Context, db Queryer, pk0 string) (*UserAccountUUID, error) {
-	var r UserAccountUUID
-	err := db.QueryRowContext(ctx,
-		`SELECT uuid, email, last_name, first_name FROM user_account_uuid WHERE uuid = $1`,
-		pk0).Scan(&r.UUID, &r.Email, &r.LastName, &r.FirstName)
+// DeleteT2Where deletes the T2s matching the filter from the database,
+// and returns the number of the deleted rows. A filter without predicate is rejected
+// to avoid deleting every row by mistake.
+func DeleteT2Where(ctx context.Context, db Queryer, f *T2Filter) (int64, error) {
+	where, args := f.Where()
+	if where == "" {
+		return 0, errors.New("refusing to delete every row of t2 with an empty filter")
+	}
+	result, err := db.ExecContext(ctx,
+		`DELETE FROM t2`+where,
+		args...)
 	if err != nil {
-		return nil, errors.WithStack(err)
+		return 0, errors.WithStack(translateError("t2", err))
 	}
-	return &r, nil
+	n, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.WithStack(translateError("t2", err))
+	}
+	return n, nil
 }
 
-// UserAccountUUIDAddress represents public.user_account_uuid_address
-type UserAccountUUIDAddress struct {
-	UUID  string // uuid
-	State string // state
-	City  string // city
-	Line1 string // line1
-	Line2 string // line2
+// T3 represents public.t3
+type T3 struct {
+	ID int // id
+	I  int // i
 }
 
-// Create inserts the UserAccountUUIDAddress to the database.
+// T3Pk represents the primary key of public.t3
+type T3Pk struct {
+	ID int // id
+	I  int // i
+}
+
+// Create inserts the T3 to the database.
 //
 // Deprecated: Use CreateContext instead.
-func (r *UserAccountUUIDAddress) Create(db Queryer) error {
+func (r *T3) Create(db Queryer) error {
 	return r.CreateContext(context.Background(), db)
 }
 
-// GetUserAccountUUIDAddressByPk select the UserAccountUUIDAddress from the database.
+// GetT3ByPk select the T3 from the database.
 //
-// Deprecated: Use GetUserAccountUUIDAddressByPkContext instead.
-func GetUserAccountUUIDAddressByPk(db Queryer, pk0 string) (*UserAccountUUIDAddress, error) {
-	return GetUserAccountUUIDAddressByPkContext(context.Background(), db, pk0)
+// Deprecated: Use GetT3ByPkContext instead.
+func GetT3ByPk(db Queryer, pk0 int, pk1 int) (*T3, error) {
+	return GetT3ByPkContext(context.Background(), db, pk0, pk1)
 }
 
-// CreateContext inserts the UserAccountUUIDAddress to the database.
-func (r *UserAccountUUIDAddress) CreateContext(ctx context.Context, db Queryer) error {
+// Validate checks the T3 against the constraints of t3 which can be checked
+// without the database, and returns *ValidationError listing every failing field.
+func (r *T3) Validate() error {
+	return nil
+}
+
+// CreateContext inserts the T3 to the database.
+func (r *T3) CreateContext(ctx context.Context, db Queryer) error {
+	if err := beforeCreate(ctx, r); err != nil {
+		return err
+	}
 	_, err := db.ExecContext(ctx,
-		`INSERT INTO user_account_uuid_address (uuid, state, city, line1, line2) VALUES ($1, $2, $3, $4, $5)`,
-		&r.UUID, &r.State, &r.City, &r.Line1, &r.Line2)
+		`INSERT INTO t3 (id, i) VALUES ($1, $2)`,
+		&r.ID, &r.I)
 	if err != nil {
-		return errors.WithStack(err)
+		return errors.WithStack(translateError("t3", err))
 	}
-	return nil
+	return afterCreate(ctx, r)
 }
 
-// CreateOnConflictDoNothing inserts the UserAccountUUIDAddress to the database.
+// CreateOnConflictDoNothing inserts the T3 to the database.
 // If a conflict occurs (e.g., unique constraint violation), the insert is skipped without error.
 // Returns true if the row was inserted, false if it was skipped due to conflict.
-func (r *UserAccountUUIDAddress) CreateOnConflictDoNothing(ctx context.Context, db Queryer) (bool, error) {
+func (r *T3) CreateOnConflictDoNothing(ctx context.Context, db Queryer) (bool, error) {
+	if err := beforeCreate(ctx, r); err != nil {
+		return false, err
+	}
 	result, err := db.ExecContext(ctx,
-		`INSERT INTO user_account_uuid_address (uuid, state, city, line1, line2) VALUES ($1, $2, $3, $4, $5) ON CONFLICT DO NOTHING`,
-		&r.UUID, &r.State, &r.City, &r.Line1, &r.Line2)
+		`INSERT INTO t3 (id, i) VALUES ($1, $2) ON CONFLICT DO NOTHING`,
+		&r.ID, &r.I)
 	if err != nil {
-		return false, errors.WithStack(err)
+		return false, errors.WithStack(translateError("t3", err))
 	}
 	rowsAffected, err := result.RowsAffected()
 	if err != nil {
-		return false, errors.WithStack(err)
+		return false, errors.WithStack(translateError("t3", err))
 	}
-	return rowsAffected > 0, nil
+	if rowsAffected == 0 {
+		return false, nil
+	}
+	return true, afterCreate(ctx, r)
 }
 
-// GetUserAccountUUIDAddressByPkContext select the UserAccountUUIDAddress from the database.
-func GetUserAccountUUIDAddressByPkContext(ctx context.Context, db Queryer, pk0 string) (*UserAccountUUIDAddress, error) {
-	var r UserAccountUUIDAddress
+// DeleteContext deletes the T3 from the database by the primary key.
+// *NotFoundError is returned if the row is not found.
+func (r *T3) DeleteContext(ctx context.Context, db Queryer) error {
+	if err := beforeDelete(ctx, r); err != nil {
+		return err
+	}
+	result, err := db.ExecContext(ctx,
+		`DELETE FROM t3 WHERE id = $1 AND i = $2`,
+		&r.ID, &r.I)
+	if err != nil {
+		return errors.WithStack(translateError("t3", err))
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return errors.WithStack(translateError("t3", err))
+	}
+	if n == 0 {
+		err = sql.ErrNoRows
+		return errors.WithStack(translateError("t3", err))
+	}
+	return afterDelete(ctx, r)
+}
+
+// ReloadContext selects the T3 again by its primary key, and overwrites the fields with the
+// values in the database, e.g. after a trigger or a stored procedure changed the row.
+// *NotFoundError is returned if the row is not found.
+func (r *T3) ReloadContext(ctx context.Context, db Queryer) error {
 	err := db.QueryRowContext(ctx,
-		`SELECT uuid, state, city, line1, line2 FROM user_account_uuid_address WHERE uuid = $1`,
-		pk0).Scan(&r.UUID, &r.State, &r.City, &r.Line1, &r.Line2)
+		`SELECT id, i FROM t3 WHERE id = $1 AND i = $2`,
+		r.ID, r.I).Scan(&r.ID, &r.I)
+	if err != nil {
+		return errors.WithStack(translateError("t3", err))
+	}
+	return nil
+}
+
+// GetT3ByPkContext select the T3 from the database.
+func GetT3ByPkContext(ctx context.Context, db Queryer, pk0 int, pk1 int) (*T3, error) {
+	var r T3
+	err := db.QueryRowContext(ctx,
+		`SELECT id, i FROM t3 WHERE id = $1 AND i = $2`,
+		pk0, pk1).Scan(&r.ID, &r.I)
 	if err != nil {
-		return nil, errors.WithStack(err)
+		return nil, errors.WithStack(translateError("t3", err))
 	}
 	return &r, nil
 }
+
+// GetT3ByPkForUpdateContext selects the T3 from the database with FOR UPDATE,
+// which locks the row until the end of the transaction. opt decides what happens if the row is locked
+// by another transaction: LockWait waits for it, LockNoWait fails with SQLSTATE 55P03, and
+// LockSkipLocked returns *NotFoundError.
+func GetT3ByPkForUpdateContext(ctx context.Context, db Queryer, pk0 int, pk1 int, opt LockOption) (*T3, error) {
+	var r T3
+	err := db.QueryRowContext(ctx,
+		`SELECT id, i FROM t3 WHERE id = $1 AND i = $2 FOR UPDATE`+opt.sql(),
+		pk0, pk1).Scan(&r.ID, &r.I)
+	if err != nil {
+		return nil, errors.WithStack(translateError("t3", err))
+	}
+	return &r, nil
+}
+
+// GetT3ByPkForShareContext selects the T3 from the database with FOR SHARE,
+// which locks the row until the end of the transaction. opt decides what happens if the row is locked
+// by another transaction: LockWait waits for it, LockNoWait fails with SQLSTATE 55P03, and
+// LockSkipLocked returns *NotFoundError.
+func GetT3ByPkForShareContext(ctx context.Context, db Queryer, pk0 int, pk1 int, opt LockOption) (*T3, error) {
+	var r T3
+	err := db.QueryRowContext(ctx,
+		`SELECT id, i FROM t3 WHERE id = $1 AND i = $2 FOR SHARE`+opt.sql(),
+		pk0, pk1).Scan(&r.ID, &r.I)
+	if err != nil {
+		return nil, errors.WithStack(translateError("t3", err))
+	}
+	return &r, nil
+}
+
+// ExistsT3ByPkContext checks if the T3 exists in the database.
+func ExistsT3ByPkContext(ctx context.Context, db Queryer, pk0 int, pk1 int) (bool, error) {
+	var exists bool
+	err := db.QueryRowContext(ctx,
+		`SELECT EXISTS (SELECT 1 FROM t3 WHERE id = $1 AND i = $2)`,
+		pk0, pk1).Scan(&exists)
+	if err != nil {
+		return false, errors.WithStack(translateError("t3", err))
+	}
+	return exists, nil
+}
+
+// GetT3ByPksContext select the T3s of the given primary keys from the database.
+// The result is ordered by the primary key, and the keys which do not exist are skipped.
+func GetT3ByPksContext(ctx context.Context, db Queryer, pks []T3Pk) ([]*T3, error) {
+	if len(pks) == 0 {
+		return nil, nil
+	}
+	values := make([]string, 0, len(pks))
+	args := make([]interface{}, 0, len(pks)*2)
+	for _, pk := range pks {
+		args = append(args, pk.ID, pk.I)
+		values = append(values, fmt.Sprintf("($%d::integer, $%d::integer)", len(args)-1, len(args)))
+	}
+	rows, err := db.QueryContext(ctx,
+		fmt.Sprintf(`SELECT id, i FROM t3 WHERE (id, i) IN (VALUES %s) ORDER BY id, i`, strings.Join(values, ", ")),
+		args...)
+	if err != nil {
+		return nil, errors.WithStack(translateError("t3", err))
+	}
+	defer rows.Close()
+	var rs []*T3
+	for rows.Next() {
+		var r T3
+		if err := rows.Scan(&r.ID, &r.I); err != nil {
+			return nil, errors.WithStack(translateError("t3", err))
+		}
+		rs = append(rs, &r)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.WithStack(translateError("t3", err))
+	}
+	return rs, nil
+}
+
+// T3ListOptions is the options of ListT3.
+type T3ListOptions struct {
+	// Limit is the maximum number of rows to return. Zero means no limit.
+	Limit int
+	// After is the primary key of the last row of the previous page.
+	// Only the rows after it are returned when it is not nil.
+	After *T3Pk
+}
+
+// ListT3 lists the T3s ordered by the primary key.
+// Pass the primary key of the last row as opts.After to get the next page.
+func ListT3(ctx context.Context, db Queryer, opts T3ListOptions) ([]*T3, error) {
+	q := `SELECT id, i FROM t3`
+	var args []interface{}
+	if opts.After != nil {
+		q += ` WHERE (id, i) > ($1, $2)`
+		args = append(args, opts.After.ID, opts.After.I)
+	}
+	q += ` ORDER BY id, i`
+	if opts.Limit > 0 {
+		args = append(args, opts.Limit)
+		q += fmt.Sprintf(` LIMIT $%d`, len(args))
+	}
+	rows, err := db.QueryContext(ctx, q, args...)
+	if err != nil {
+		return nil, errors.WithStack(translateError("t3", err))
+	}
+	defer rows.Close()
+	var rs []*T3
+	for rows.Next() {
+		var r T3
+		if err := rows.Scan(&r.ID, &r.I); err != nil {
+			return nil, errors.WithStack(translateError("t3", err))
+		}
+		rs = append(rs, &r)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.WithStack(translateError("t3", err))
+	}
+	return rs, nil
+}
+
+// T3Filter builds a parameterized WHERE clause of t3.
+// The predicates are combined with AND.
+type T3Filter struct {
+	conds []string
+	args  []interface{}
+}
+
+// NewT3Filter creates an empty T3Filter, which matches every row.
+func NewT3Filter() *T3Filter {
+	return &T3Filter{}
+}
+
+func (f *T3Filter) add(cond string, v interface{}) *T3Filter {
+	f.args = append(f.args, v)
+	f.conds = append(f.conds, fmt.Sprintf(cond, len(f.args)))
+	return f
+}
+
+// Where returns the WHERE clause and its arguments. It returns an empty clause
+// if the filter is nil or has no predicate.
+func (f *T3Filter) Where() (string, []interface{}) {
+	if f == nil || len(f.conds) == 0 {
+		return "", nil
+	}
+	return " WHERE " + strings.Join(f.conds, " AND "), f.args
+}
+
+// IDEq filters the rows whose id equals to v.
+func (f *T3Filter) IDEq(v int) *T3Filter {
+	return f.add("id = $%d", v)
+}
+
+// IDIn filters the rows whose id is one of vs.
+func (f *T3Filter) IDIn(vs ...int) *T3Filter {
+	return f.add("id = ANY($%d)", pq.Array(vs))
+}
+
+// IDLt filters the rows whose id is less than v.
+func (f *T3Filter) IDLt(v int) *T3Filter {
+	return f.add("id < $%d", v)
+}
+
+// IDGt filters the rows whose id is greater than v.
+func (f *T3Filter) IDGt(v int) *T3Filter {
+	return f.add("id > $%d", v)
+}
+
+// IEq filters the rows whose i equals to v.
+func (f *T3Filter) IEq(v int) *T3Filter {
+	return f.add("i = $%d", v)
+}
+
+// IIn filters the rows whose i is one of vs.
+func (f *T3Filter) IIn(vs ...int) *T3Filter {
+	return f.add("i = ANY($%d)", pq.Array(vs))
+}
+
+// ILt filters the rows whose i is less than v.
+func (f *T3Filter) ILt(v int) *T3Filter {
+	return f.add("i < $%d", v)
+}
+
+// IGt filters the rows whose i is greater than v.
+func (f *T3Filter) IGt(v int) *T3Filter {
+	return f.add("i > $%d", v)
+}
+
+// FindT3 selects the T3s matching the filter from the database.
+// The result is ordered by the primary key.
+func FindT3(ctx context.Context, db Queryer, f *T3Filter) ([]*T3, error) {
+	where, args := f.Where()
+	rows, err := db.QueryContext(ctx,
+		`SELECT id, i FROM t3`+where+` ORDER BY id, i`,
+		args...)
+	if err != nil {
+		return nil, errors.WithStack(translateError("t3", err))
+	}
+	defer rows.Close()
+	var rs []*T3
+	for rows.Next() {
+		var r T3
+		if err := rows.Scan(&r.ID, &r.I); err != nil {
+			return nil, errors.WithStack(translateError("t3", err))
+		}
+		rs = append(rs, &r)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.WithStack(translateError("t3", err))
+	}
+	return rs, nil
+}
+
+// CountT3 counts the T3s matching the filter in the database.
+// Pass nil as the filter to count every row.
+func CountT3(ctx context.Context, db Queryer, f *T3Filter) (int64, error) {
+	where, args := f.Where()
+	var n int64
+	err := db.QueryRowContext(ctx,
+		`SELECT count(*) FROM t3`+where,
+		args...).Scan(&n)
+	if err != nil {
+		return 0, errors.WithStack(translateError("t3", err))
+	}
+	return n, nil
+}
+
+// DeleteT3Where deletes the T3s matching the filter from the database,
+// and returns the number of the deleted rows. A filter without predicate is rejected
+// to avoid deleting every row by mistake.
+func DeleteT3Where(ctx context.Context, db Queryer, f *T3Filter) (int64, error) {
+	where, args := f.Where()
+	if where == "" {
+		return 0, errors.New("refusing to delete every row of t3 with an empty filter")
+	}
+	result, err := db.ExecContext(ctx,
+		`DELETE FROM t3`+where,
+		args...)
+	if err != nil {
+		return 0, errors.WithStack(translateError("t3", err))
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.WithStack(translateError("t3", err))
+	}
+	return n, nil
+}
+
+// T4 represents public.t4
+type T4 struct {
+	ID            int64     // id
+	Bytes         []byte    // bytes
+	NullableBytes []byte    // nullable_bytes
+	Span          Interval  // span
+	NullableSpan  *Interval // nullable_span
+}
+
+// Create inserts the T4 to the database.
+//
+// Deprecated: Use CreateContext instead.
+func (r *T4) Create(db Queryer) error {
+	return r.CreateContext(context.Background(), db)
+}
+
+// GetT4ByPk select the T4 from the database.
+//
+// Deprecated: Use GetT4ByPkContext instead.
+func GetT4ByPk(db Queryer, pk0 int64) (*T4, error) {
+	return GetT4ByPkContext(context.Background(), db, pk0)
+}
+
+// Validate checks the T4 against the constraints of t4 which can be checked
+// without the database, and returns *ValidationError listing every failing field.
+func (r *T4) Validate() error {
+	return nil
+}
+
+// CreateContext inserts the T4 to the database.
+func (r *T4) CreateContext(ctx context.Context, db Queryer) error {
+	if err := beforeCreate(ctx, r); err != nil {
+		return err
+	}
+	err := db.QueryRowContext(ctx,
+		`INSERT INTO t4 (bytes, nullable_bytes, span, nullable_span) VALUES ($1, $2, $3, $4) RETURNING id`,
+		&r.Bytes, &r.NullableBytes, &r.Span, &r.NullableSpan).Scan(&r.ID)
+	if err != nil {
+		return errors.WithStack(translateError("t4", err))
+	}
+	return afterCreate(ctx, r)
+}
+
+// CreateOnConflictDoNothing inserts the T4 to the database.
+// If a conflict occurs (e.g., unique constraint violation), the insert is skipped without error.
+// Returns true if the row was inserted, false if it was skipped due to conflict.
+func (r *T4) CreateOnConflictDoNothing(ctx context.Context, db Queryer) (bool, error) {
+	if err := beforeCreate(ctx, r); err != nil {
+		return false, err
+	}
+	err := db.QueryRowContext(ctx,
+		`INSERT INTO t4 (bytes, nullable_bytes, span, nullable_span) VALUES ($1, $2, $3, $4) ON CONFLICT DO NOTHING RETURNING id`,
+		&r.Bytes, &r.NullableBytes, &r.Span, &r.NullableSpan).Scan(&r.ID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return false, nil
+		}
+		return false, errors.WithStack(translateError("t4", err))
+	}
+	// Row was successfully inserted
+	return true, afterCreate(ctx, r)
+}
+
+// UpdateContext updates the T4 in the database by the primary key.
+// *NotFoundError is returned if the row is not found.
+func (r *T4) UpdateContext(ctx context.Context, db Queryer) error {
+	if err := beforeUpdate(ctx, r); err != nil {
+		return err
+	}
+	result, err := db.ExecContext(ctx,
+		`UPDATE t4 SET bytes = $1, nullable_bytes = $2, span = $3, nullable_span = $4 WHERE id = $5`,
+		&r.Bytes, &r.NullableBytes, &r.Span, &r.NullableSpan, &r.ID)
+	if err != nil {
+		return errors.WithStack(translateError("t4", err))
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return errors.WithStack(translateError("t4", err))
+	}
+	if n == 0 {
+		err = sql.ErrNoRows
+		return errors.WithStack(translateError("t4", err))
+	}
+	return afterUpdate(ctx, r)
+}
+
+// DeleteContext deletes the T4 from the database by the primary key.
+// *NotFoundError is returned if the row is not found.
+func (r *T4) DeleteContext(ctx context.Context, db Queryer) error {
+	if err := beforeDelete(ctx, r); err != nil {
+		return err
+	}
+	result, err := db.ExecContext(ctx,
+		`DELETE FROM t4 WHERE id = $1`,
+		&r.ID)
+	if err != nil {
+		return errors.WithStack(translateError("t4", err))
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return errors.WithStack(translateError("t4", err))
+	}
+	if n == 0 {
+		err = sql.ErrNoRows
+		return errors.WithStack(translateError("t4", err))
+	}
+	return afterDelete(ctx, r)
+}
+
+// ReloadContext selects the T4 again by its primary key, and overwrites the fields with the
+// values in the database, e.g. after a trigger or a stored procedure changed the row.
+// *NotFoundError is returned if the row is not found.
+func (r *T4) ReloadContext(ctx context.Context, db Queryer) error {
+	err := db.QueryRowContext(ctx,
+		`SELECT id, bytes, nullable_bytes, span, nullable_span FROM t4 WHERE id = $1`,
+		r.ID).Scan(&r.ID, &r.Bytes, &r.NullableBytes, &r.Span, &r.NullableSpan)
+	if err != nil {
+		return errors.WithStack(translateError("t4", err))
+	}
+	return nil
+}
+
+// GetT4ByPkContext select the T4 from the database.
+func GetT4ByPkContext(ctx context.Context, db Queryer, pk0 int64) (*T4, error) {
+	var r T4
+	err := db.QueryRowContext(ctx,
+		`SELECT id, bytes, nullable_bytes, span, nullable_span FROM t4 WHERE id = $1`,
+		pk0).Scan(&r.ID, &r.Bytes, &r.NullableBytes, &r.Span, &r.NullableSpan)
+	if err != nil {
+		return nil, errors.WithStack(translateError("t4", err))
+	}
+	return &r, nil
+}
+
+// GetT4ByPkForUpdateContext selects the T4 from the database with FOR UPDATE,
+// which locks the row until the end of the transaction. opt decides what happens if the row is locked
+// by another transaction: LockWait waits for it, LockNoWait fails with SQLSTATE 55P03, and
+// LockSkipLocked returns *NotFoundError.
+func GetT4ByPkForUpdateContext(ctx context.Context, db Queryer, pk0 int64, opt LockOption) (*T4, error) {
+	var r T4
+	err := db.QueryRowContext(ctx,
+		`SELECT id, bytes, nullable_bytes, span, nullable_span FROM t4 WHERE id = $1 FOR UPDATE`+opt.sql(),
+		pk0).Scan(&r.ID, &r.Bytes, &r.NullableBytes, &r.Span, &r.NullableSpan)
+	if err != nil {
+		return nil, errors.WithStack(translateError("t4", err))
+	}
+	return &r, nil
+}
+
+// GetT4ByPkForShareContext selects the T4 from the database with FOR SHARE,
+// which locks the row until the end of the transaction. opt decides what happens if the row is locked
+// by another transaction: LockWait waits for it, LockNoWait fails with SQLSTATE 55P03, and
+// LockSkipLocked returns *NotFoundError.
+func GetT4ByPkForShareContext(ctx context.Context, db Queryer, pk0 int64, opt LockOption) (*T4, error) {
+	var r T4
+	err := db.QueryRowContext(ctx,
+		`SELECT id, bytes, nullable_bytes, span, nullable_span FROM t4 WHERE id = $1 FOR SHARE`+opt.sql(),
+		pk0).Scan(&r.ID, &r.Bytes, &r.NullableBytes, &r.Span, &r.NullableSpan)
+	if err != nil {
+		return nil, errors.WithStack(translateError("t4", err))
+	}
+	return &r, nil
+}
+
+// ExistsT4ByPkContext checks if the T4 exists in the database.
+func ExistsT4ByPkContext(ctx context.Context, db Queryer, pk0 int64) (bool, error) {
+	var exists bool
+	err := db.QueryRowContext(ctx,
+		`SELECT EXISTS (SELECT 1 FROM t4 WHERE id = $1)`,
+		pk0).Scan(&exists)
+	if err != nil {
+		return false, errors.WithStack(translateError("t4", err))
+	}
+	return exists, nil
+}
+
+// GetT4ByPksContext select the T4s of the given primary keys from the database.
+// The result is ordered by the primary key, and the keys which do not exist are skipped.
+func GetT4ByPksContext(ctx context.Context, db Queryer, pks []int64) ([]*T4, error) {
+	rows, err := db.QueryContext(ctx,
+		`SELECT id, bytes, nullable_bytes, span, nullable_span FROM t4 WHERE id = ANY($1) ORDER BY id`,
+		pq.Array(pks))
+	if err != nil {
+		return nil, errors.WithStack(translateError("t4", err))
+	}
+	defer rows.Close()
+	var rs []*T4
+	for rows.Next() {
+		var r T4
+		if err := rows.Scan(&r.ID, &r.Bytes, &r.NullableBytes, &r.Span, &r.NullableSpan); err != nil {
+			return nil, errors.WithStack(translateError("t4", err))
+		}
+		rs = append(rs, &r)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.WithStack(translateError("t4", err))
+	}
+	return rs, nil
+}
+
+// T4ListOptions is the options of ListT4.
+type T4ListOptions struct {
+	// Limit is the maximum number of rows to return. Zero means no limit.
+	Limit int
+	// After is the primary key of the last row of the previous page.
+	// Only the rows after it are returned when it is not nil.
+	After *int64
+}
+
+// ListT4 lists the T4s ordered by the primary key.
+// Pass the primary key of the last row as opts.After to get the next page.
+func ListT4(ctx context.Context, db Queryer, opts T4ListOptions) ([]*T4, error) {
+	q := `SELECT id, bytes, nullable_bytes, span, nullable_span FROM t4`
+	var args []interface{}
+	if opts.After != nil {
+		q += ` WHERE id > $1`
+		args = append(args, *opts.After)
+	}
+	q += ` ORDER BY id`
+	if opts.Limit > 0 {
+		args = append(args, opts.Limit)
+		q += fmt.Sprintf(` LIMIT $%d`, len(args))
+	}
+	rows, err := db.QueryContext(ctx, q, args...)
+	if err != nil {
+		return nil, errors.WithStack(translateError("t4", err))
+	}
+	defer rows.Close()
+	var rs []*T4
+	for rows.Next() {
+		var r T4
+		if err := rows.Scan(&r.ID, &r.Bytes, &r.NullableBytes, &r.Span, &r.NullableSpan); err != nil {
+			return nil, errors.WithStack(translateError("t4", err))
+		}
+		rs = append(rs, &r)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.WithStack(translateError("t4", err))
+	}
+	return rs, nil
+}
+
+// T4Filter builds a parameterized WHERE clause of t4.
+// The predicates are combined with AND.
+type T4Filter struct {
+	conds []string
+	args  []interface{}
+}
+
+// NewT4Filter creates an empty T4Filter, which matches every row.
+func NewT4Filter() *T4Filter {
+	return &T4Filter{}
+}
+
+func (f *T4Filter) add(cond string, v interface{}) *T4Filter {
+	f.args = append(f.args, v)
+	f.conds = append(f.conds, fmt.Sprintf(cond, len(f.args)))
+	return f
+}
+
+// Where returns the WHERE clause and its arguments. It returns an empty clause
+// if the filter is nil or has no predicate.
+func (f *T4Filter) Where() (string, []interface{}) {
+	if f == nil || len(f.conds) == 0 {
+		return "", nil
+	}
+	return " WHERE " + strings.Join(f.conds, " AND "), f.args
+}
+
+// IDEq filters the rows whose id equals to v.
+func (f *T4Filter) IDEq(v int64) *T4Filter {
+	return f.add("id = $%d", v)
+}
+
+// IDIn filters the rows whose id is one of vs.
+func (f *T4Filter) IDIn(vs ...int64) *T4Filter {
+	return f.add("id = ANY($%d)", pq.Array(vs))
+}
+
+// IDLt filters the rows whose id is less than v.
+func (f *T4Filter) IDLt(v int64) *T4Filter {
+	return f.add("id < $%d", v)
+}
+
+// IDGt filters the rows whose id is greater than v.
+func (f *T4Filter) IDGt(v int64) *T4Filter {
+	return f.add("id > $%d", v)
+}
+
+// BytesEq filters the rows whose bytes equals to v.
+func (f *T4Filter) BytesEq(v []byte) *T4Filter {
+	return f.add("bytes = $%d", v)
+}
+
+// BytesIn filters the rows whose bytes is one of vs.
+func (f *T4Filter) BytesIn(vs ...[]byte) *T4Filter {
+	return f.add("bytes = ANY($%d)", pq.Array(vs))
+}
+
+// NullableBytesEq filters the rows whose nullable_bytes equals to v.
+func (f *T4Filter) NullableBytesEq(v []byte) *T4Filter {
+	return f.add("nullable_bytes = $%d", v)
+}
+
+// NullableBytesIn filters the rows whose nullable_bytes is one of vs.
+func (f *T4Filter) NullableBytesIn(vs ...[]byte) *T4Filter {
+	return f.add("nullable_bytes = ANY($%d)", pq.Array(vs))
+}
+
+// NullableBytesIsNull filters the rows whose nullable_bytes is NULL.
+func (f *T4Filter) NullableBytesIsNull() *T4Filter {
+	f.conds = append(f.conds, "nullable_bytes IS NULL")
+	return f
+}
+
+// NullableBytesIsNotNull filters the rows whose nullable_bytes is not NULL.
+func (f *T4Filter) NullableBytesIsNotNull() *T4Filter {
+	f.conds = append(f.conds, "nullable_bytes IS NOT NULL")
+	return f
+}
+
+// SpanEq filters the rows whose span equals to v.
+func (f *T4Filter) SpanEq(v Interval) *T4Filter {
+	return f.add("span = $%d", v)
+}
+
+// SpanIn filters the rows whose span is one of vs.
+func (f *T4Filter) SpanIn(vs ...Interval) *T4Filter {
+	return f.add("span = ANY($%d)", pq.Array(vs))
+}
+
+// SpanLt filters the rows whose span is less than v.
+func (f *T4Filter) SpanLt(v Interval) *T4Filter {
+	return f.add("span < $%d", v)
+}
+
+// SpanGt filters the rows whose span is greater than v.
+func (f *T4Filter) SpanGt(v Interval) *T4Filter {
+	return f.add("span > $%d", v)
+}
+
+// NullableSpanEq filters the rows whose nullable_span equals to v.
+func (f *T4Filter) NullableSpanEq(v *Interval) *T4Filter {
+	return f.add("nullable_span = $%d", v)
+}
+
+// NullableSpanIn filters the rows whose nullable_span is one of vs.
+func (f *T4Filter) NullableSpanIn(vs ...*Interval) *T4Filter {
+	return f.add("nullable_span = ANY($%d)", pq.Array(vs))
+}
+
+// NullableSpanLt filters the rows whose nullable_span is less than v.
+func (f *T4Filter) NullableSpanLt(v *Interval) *T4Filter {
+	return f.add("nullable_span < $%d", v)
+}
+
+// NullableSpanGt filters the rows whose nullable_span is greater than v.
+func (f *T4Filter) NullableSpanGt(v *Interval) *T4Filter {
+	return f.add("nullable_span > $%d", v)
+}
+
+// NullableSpanIsNull filters the rows whose nullable_span is NULL.
+func (f *T4Filter) NullableSpanIsNull() *T4Filter {
+	f.conds = append(f.conds, "nullable_span IS NULL")
+	return f
+}
+
+// NullableSpanIsNotNull filters the rows whose nullable_span is not NULL.
+func (f *T4Filter) NullableSpanIsNotNull() *T4Filter {
+	f.conds = append(f.conds, "nullable_span IS NOT NULL")
+	return f
+}
+
+// FindT4 selects the T4s matching the filter from the database.
+// The result is ordered by the primary key.
+func FindT4(ctx context.Context, db Queryer, f *T4Filter) ([]*T4, error) {
+	where, args := f.Where()
+	rows, err := db.QueryContext(ctx,
+		`SELECT id, bytes, nullable_bytes, span, nullable_span FROM t4`+where+` ORDER BY id`,
+		args...)
+	if err != nil {
+		return nil, errors.WithStack(translateError("t4", err))
+	}
+	defer rows.Close()
+	var rs []*T4
+	for rows.Next() {
+		var r T4
+		if err := rows.Scan(&r.ID, &r.Bytes, &r.NullableBytes, &r.Span, &r.NullableSpan); err != nil {
+			return nil, errors.WithStack(translateError("t4", err))
+		}
+		rs = append(rs, &r)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.WithStack(translateError("t4", err))
+	}
+	return rs, nil
+}
+
+// CountT4 counts the T4s matching the filter in the database.
+// Pass nil as the filter to count every row.
+func CountT4(ctx context.Context, db Queryer, f *T4Filter) (int64, error) {
+	where, args := f.Where()
+	var n int64
+	err := db.QueryRowContext(ctx,
+		`SELECT count(*) FROM t4`+where,
+		args...).Scan(&n)
+	if err != nil {
+		return 0, errors.WithStack(translateError("t4", err))
+	}
+	return n, nil
+}
+
+// DeleteT4Where deletes the T4s matching the filter from the database,
+// and returns the number of the deleted rows. A filter without predicate is rejected
+// to avoid deleting every row by mistake.
+func DeleteT4Where(ctx context.Context, db Queryer, f *T4Filter) (int64, error) {
+	where, args := f.Where()
+	if where == "" {
+		return 0, errors.New("refusing to delete every row of t4 with an empty filter")
+	}
+	result, err := db.ExecContext(ctx,
+		`DELETE FROM t4`+where,
+		args...)
+	if err != nil {
+		return 0, errors.WithStack(translateError("t4", err))
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.WithStack(translateError("t4", err))
+	}
+	return n, nil
+}
+
+// T5 represents public.t5
+type T5 struct {
+	ID     int64          // id
+	Name   string         // name
+	Status string         // status
+	Score  sql.NullInt64  // score
+	Price  float64        // price
+	Note   sql.NullString // note
+}
+
+// Create inserts the T5 to the database.
+//
+// Deprecated: Use CreateContext instead.
+func (r *T5) Create(db Queryer) error {
+	return r.CreateContext(context.Background(), db)
+}
+
+// GetT5ByPk select the T5 from the database.
+//
+// Deprecated: Use GetT5ByPkContext instead.
+func GetT5ByPk(db Queryer, pk0 int64) (*T5, error) {
+	return GetT5ByPkContext(context.Background(), db, pk0)
+}
+
+// Validate checks the T5 against the constraints of t5 which can be checked
+// without the database, and returns *ValidationError listing every failing field.
+func (r *T5) Validate() error {
+	var errs []FieldError
+	if r.Name == "" {
+		errs = append(errs, FieldError{Field: "Name", Column: "name", Message: "must not be empty"})
+	}
+	if utf8.RuneCountInString(r.Name) > 16 {
+		errs = append(errs, FieldError{Field: "Name", Column: "name", Message: "must be at most 16 characters"})
+	}
+	if !(r.Price > 0) {
+		errs = append(errs, FieldError{Field: "Price", Column: "price", Constraint: "t5_price_check", Message: "must be > 0"})
+	}
+	if r.Score.Valid && !(r.Score.Int64 >= 0) {
+		errs = append(errs, FieldError{Field: "Score", Column: "score", Constraint: "t5_score_check", Message: "must be >= 0"})
+	}
+	if r.Score.Valid && !(r.Score.Int64 <= 100) {
+		errs = append(errs, FieldError{Field: "Score", Column: "score", Constraint: "t5_score_check", Message: "must be <= 100"})
+	}
+	if !(r.Status == "active" || r.Status == "inactive") {
+		errs = append(errs, FieldError{Field: "Status", Column: "status", Constraint: "t5_status_check", Message: "must be one of 'active', 'inactive'"})
+	}
+	if len(errs) > 0 {
+		return &ValidationError{Table: "t5", Fields: errs}
+	}
+	return nil
+}
+
+// CreateContext inserts the T5 to the database.
+func (r *T5) CreateContext(ctx context.Context, db Queryer) error {
+	if err := beforeCreate(ctx, r); err != nil {
+		return err
+	}
+	err := db.QueryRowContext(ctx,
+		`INSERT INTO t5 (name, status, score, price, note) VALUES ($1, $2, $3, $4, $5) RETURNING id`,
+		&r.Name, &r.Status, &r.Score, &r.Price, &r.Note).Scan(&r.ID)
+	if err != nil {
+		return errors.WithStack(translateError("t5", err))
+	}
+	return afterCreate(ctx, r)
+}
+
+// CreateOnConflictDoNothing inserts the T5 to the database.
+// If a conflict occurs (e.g., unique constraint violation), the insert is skipped without error.
+// Returns true if the row was inserted, false if it was skipped due to conflict.
+func (r *T5) CreateOnConflictDoNothing(ctx context.Context, db Queryer) (bool, error) {
+	if err := beforeCreate(ctx, r); err != nil {
+		return false, err
+	}
+	err := db.QueryRowContext(ctx,
+		`INSERT INTO t5 (name, status, score, price, note) VALUES ($1, $2, $3, $4, $5) ON CONFLICT DO NOTHING RETURNING id`,
+		&r.Name, &r.Status, &r.Score, &r.Price, &r.Note).Scan(&r.ID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return false, nil
+		}
+		return false, errors.WithStack(translateError("t5", err))
+	}
+	// Row was successfully inserted
+	return true, afterCreate(ctx, r)
+}
+
+// UpdateContext updates the T5 in the database by the primary key.
+// *NotFoundError is returned if the row is not found.
+func (r *T5) UpdateContext(ctx context.Context, db Queryer) error {
+	if err := beforeUpdate(ctx, r); err != nil {
+		return err
+	}
+	result, err := db.ExecContext(ctx,
+		`UPDATE t5 SET name = $1, status = $2, score = $3, price = $4, note = $5 WHERE id = $6`,
+		&r.Name, &r.Status, &r.Score, &r.Price, &r.Note, &r.ID)
+	if err != nil {
+		return errors.WithStack(translateError("t5", err))
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return errors.WithStack(translateError("t5", err))
+	}
+	if n == 0 {
+		err = sql.ErrNoRows
+		return errors.WithStack(translateError("t5", err))
+	}
+	return afterUpdate(ctx, r)
+}
+
+// DeleteContext deletes the T5 from the database by the primary key.
+// *NotFoundError is returned if the row is not found.
+func (r *T5) DeleteContext(ctx context.Context, db Queryer) error {
+	if err := beforeDelete(ctx, r); err != nil {
+		return err
+	}
+	result, err := db.ExecContext(ctx,
+		`DELETE FROM t5 WHERE id = $1`,
+		&r.ID)
+	if err != nil {
+		return errors.WithStack(translateError("t5", err))
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return errors.WithStack(translateError("t5", err))
+	}
+	if n == 0 {
+		err = sql.ErrNoRows
+		return errors.WithStack(translateError("t5", err))
+	}
+	return afterDelete(ctx, r)
+}
+
+// ReloadContext selects the T5 again by its primary key, and overwrites the fields with the
+// values in the database, e.g. after a trigger or a stored procedure changed the row.
+// *NotFoundError is returned if the row is not found.
+func (r *T5) ReloadContext(ctx context.Context, db Queryer) error {
+	err := db.QueryRowContext(ctx,
+		`SELECT id, name, status, score, price, note FROM t5 WHERE id = $1`,
+		r.ID).Scan(&r.ID, &r.Name, &r.Status, &r.Score, &r.Price, &r.Note)
+	if err != nil {
+		return errors.WithStack(translateError("t5", err))
+	}
+	return nil
+}
+
+// GetT5ByPkContext select the T5 from the database.
+func GetT5ByPkContext(ctx context.Context, db Queryer, pk0 int64) (*T5, error) {
+	var r T5
+	err := db.QueryRowContext(ctx,
+		`SELECT id, name, status, score, price, note FROM t5 WHERE id = $1`,
+		pk0).Scan(&r.ID, &r.Name, &r.Status, &r.Score, &r.Price, &r.Note)
+	if err != nil {
+		return nil, errors.WithStack(translateError("t5", err))
+	}
+	return &r, nil
+}
+
+// GetT5ByPkForUpdateContext selects the T5 from the database with FOR UPDATE,
+// which locks the row until the end of the transaction. opt decides what happens if the row is locked
+// by another transaction: LockWait waits for it, LockNoWait fails with SQLSTATE 55P03, and
+// LockSkipLocked returns *NotFoundError.
+func GetT5ByPkForUpdateContext(ctx context.Context, db Queryer, pk0 int64, opt LockOption) (*T5, error) {
+	var r T5
+	err := db.QueryRowContext(ctx,
+		`SELECT id, name, status, score, price, note FROM t5 WHERE id = $1 FOR UPDATE`+opt.sql(),
+		pk0).Scan(&r.ID, &r.Name, &r.Status, &r.Score, &r.Price, &r.Note)
+	if err != nil {
+		return nil, errors.WithStack(translateError("t5", err))
+	}
+	return &r, nil
+}
+
+// GetT5ByPkForShareContext selects the T5 from the database with FOR SHARE,
+// which locks the row until the end of the transaction. opt decides what happens if the row is locked
+// by another transaction: LockWait waits for it, LockNoWait fails with SQLSTATE 55P03, and
+// LockSkipLocked returns *NotFoundError.
+func GetT5ByPkForShareContext(ctx context.Context, db Queryer, pk0 int64, opt LockOption) (*T5, error) {
+	var r T5
+	err := db.QueryRowContext(ctx,
+		`SELECT id, name, status, score, price, note FROM t5 WHERE id = $1 FOR SHARE`+opt.sql(),
+		pk0).Scan(&r.ID, &r.Name, &r.Status, &r.Score, &r.Price, &r.Note)
+	if err != nil {
+		return nil, errors.WithStack(translateError("t5", err))
+	}
+	return &r, nil
+}
+
+// ExistsT5ByPkContext checks if the T5 exists in the database.
+func ExistsT5ByPkContext(ctx context.Context, db Queryer, pk0 int64) (bool, error) {
+	var exists bool
+	err := db.QueryRowContext(ctx,
+		`SELECT EXISTS (SELECT 1 FROM t5 WHERE id = $1)`,
+		pk0).Scan(&exists)
+	if err != nil {
+		return false, errors.WithStack(translateError("t5", err))
+	}
+	return exists, nil
+}
+
+// GetT5ByPksContext select the T5s of the given primary keys from the database.
+// The result is ordered by the primary key, and the keys which do not exist are skipped.
+func GetT5ByPksContext(ctx context.Context, db Queryer, pks []int64) ([]*T5, error) {
+	rows, err := db.QueryContext(ctx,
+		`SELECT id, name, status, score, price, note FROM t5 WHERE id = ANY($1) ORDER BY id`,
+		pq.Array(pks))
+	if err != nil {
+		return nil, errors.WithStack(translateError("t5", err))
+	}
+	defer rows.Close()
+	var rs []*T5
+	for rows.Next() {
+		var r T5
+		if err := rows.Scan(&r.ID, &r.Name, &r.Status, &r.Score, &r.Price, &r.Note); err != nil {
+			return nil, errors.WithStack(translateError("t5", err))
+		}
+		rs = append(rs, &r)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.WithStack(translateError("t5", err))
+	}
+	return rs, nil
+}
+
+// T5ListOptions is the options of ListT5.
+type T5ListOptions struct {
+	// Limit is the maximum number of rows to return. Zero means no limit.
+	Limit int
+	// After is the primary key of the last row of the previous page.
+	// Only the rows after it are returned when it is not nil.
+	After *int64
+}
+
+// ListT5 lists the T5s ordered by the primary key.
+// Pass the primary key of the last row as opts.After to get the next page.
+func ListT5(ctx context.Context, db Queryer, opts T5ListOptions) ([]*T5, error) {
+	q := `SELECT id, name, status, score, price, note FROM t5`
+	var args []interface{}
+	if opts.After != nil {
+		q += ` WHERE id > $1`
+		args = append(args, *opts.After)
+	}
+	q += ` ORDER BY id`
+	if opts.Limit > 0 {
+		args = append(args, opts.Limit)
+		q += fmt.Sprintf(` LIMIT $%d`, len(args))
+	}
+	rows, err := db.QueryContext(ctx, q, args...)
+	if err != nil {
+		return nil, errors.WithStack(translateError("t5", err))
+	}
+	defer rows.Close()
+	var rs []*T5
+	for rows.Next() {
+		var r T5
+		if err := rows.Scan(&r.ID, &r.Name, &r.Status, &r.Score, &r.Price, &r.Note); err != nil {
+			return nil, errors.WithStack(translateError("t5", err))
+		}
+		rs = append(rs, &r)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.WithStack(translateError("t5", err))
+	}
+	return rs, nil
+}
+
+// T5Filter builds a parameterized WHERE clause of t5.
+// The predicates are combined with AND.
+type T5Filter struct {
+	conds []string
+	args  []interface{}
+}
+
+// NewT5Filter creates an empty T5Filter, which matches every row.
+func NewT5Filter() *T5Filter {
+	return &T5Filter{}
+}
+
+func (f *T5Filter) add(cond string, v interface{}) *T5Filter {
+	f.args = append(f.args, v)
+	f.conds = append(f.conds, fmt.Sprintf(cond, len(f.args)))
+	return f
+}
+
+// Where returns the WHERE clause and its arguments. It returns an empty clause
+// if the filter is nil or has no predicate.
+func (f *T5Filter) Where() (string, []interface{}) {
+	if f == nil || len(f.conds) == 0 {
+		return "", nil
+	}
+	return " WHERE " + strings.Join(f.conds, " AND "), f.args
+}
+
+// IDEq filters the rows whose id equals to v.
+func (f *T5Filter) IDEq(v int64) *T5Filter {
+	return f.add("id = $%d", v)
+}
+
+// IDIn filters the rows whose id is one of vs.
+func (f *T5Filter) IDIn(vs ...int64) *T5Filter {
+	return f.add("id = ANY($%d)", pq.Array(vs))
+}
+
+// IDLt filters the rows whose id is less than v.
+func (f *T5Filter) IDLt(v int64) *T5Filter {
+	return f.add("id < $%d", v)
+}
+
+// IDGt filters the rows whose id is greater than v.
+func (f *T5Filter) IDGt(v int64) *T5Filter {
+	return f.add("id > $%d", v)
+}
+
+// NameEq filters the rows whose name equals to v.
+func (f *T5Filter) NameEq(v string) *T5Filter {
+	return f.add("name = $%d", v)
+}
+
+// NameIn filters the rows whose name is one of vs.
+func (f *T5Filter) NameIn(vs ...string) *T5Filter {
+	return f.add("name = ANY($%d)", pq.Array(vs))
+}
+
+// NameLt filters the rows whose name is less than v.
+func (f *T5Filter) NameLt(v string) *T5Filter {
+	return f.add("name < $%d", v)
+}
+
+// NameGt filters the rows whose name is greater than v.
+func (f *T5Filter) NameGt(v string) *T5Filter {
+	return f.add("name > $%d", v)
+}
+
+// StatusEq filters the rows whose status equals to v.
+func (f *T5Filter) StatusEq(v string) *T5Filter {
+	return f.add("status = $%d", v)
+}
+
+// StatusIn filters the rows whose status is one of vs.
+func (f *T5Filter) StatusIn(vs ...string) *T5Filter {
+	return f.add("status = ANY($%d)", pq.Array(vs))
+}
+
+// StatusLt filters the rows whose status is less than v.
+func (f *T5Filter) StatusLt(v string) *T5Filter {
+	return f.add("status < $%d", v)
+}
+
+// StatusGt filters the rows whose status is greater than v.
+func (f *T5Filter) StatusGt(v string) *T5Filter {
+	return f.add("status > $%d", v)
+}
+
+// ScoreEq filters the rows whose score equals to v.
+func (f *T5Filter) ScoreEq(v sql.NullInt64) *T5Filter {
+	return f.add("score = $%d", v)
+}
+
+// ScoreIn filters the rows whose score is one of vs.
+func (f *T5Filter) ScoreIn(vs ...sql.NullInt64) *T5Filter {
+	return f.add("score = ANY($%d)", pq.Array(vs))
+}
+
+// ScoreLt filters the rows whose score is less than v.
+func (f *T5Filter) ScoreLt(v sql.NullInt64) *T5Filter {
+	return f.add("score < $%d", v)
+}
+
+// ScoreGt filters the rows whose score is greater than v.
+func (f *T5Filter) ScoreGt(v sql.NullInt64) *T5Filter {
+	return f.add("score > $%d", v)
+}
+
+// ScoreIsNull filters the rows whose score is NULL.
+func (f *T5Filter) ScoreIsNull() *T5Filter {
+	f.conds = append(f.conds, "score IS NULL")
+	return f
+}
+
+// ScoreIsNotNull filters the rows whose score is not NULL.
+func (f *T5Filter) ScoreIsNotNull() *T5Filter {
+	f.conds = append(f.conds, "score IS NOT NULL")
+	return f
+}
+
+// PriceEq filters the rows whose price equals to v.
+func (f *T5Filter) PriceEq(v float64) *T5Filter {
+	return f.add("price = $%d", v)
+}
+
+// PriceIn filters the rows whose price is one of vs.
+func (f *T5Filter) PriceIn(vs ...float64) *T5Filter {
+	return f.add("price = ANY($%d)", pq.Array(vs))
+}
+
+// PriceLt filters the rows whose price is less than v.
+func (f *T5Filter) PriceLt(v float64) *T5Filter {
+	return f.add("price < $%d", v)
+}
+
+// PriceGt filters the rows whose price is greater than v.
+func (f *T5Filter) PriceGt(v float64) *T5Filter {
+	return f.add("price > $%d", v)
+}
+
+// NoteEq filters the rows whose note equals to v.
+func (f *T5Filter) NoteEq(v sql.NullString) *T5Filter {
+	return f.add("note = $%d", v)
+}
+
+// NoteIn filters the rows whose note is one of vs.
+func (f *T5Filter) NoteIn(vs ...sql.NullString) *T5Filter {
+	return f.add("note = ANY($%d)", pq.Array(vs))
+}
+
+// NoteLt filters the rows whose note is less than v.
+func (f *T5Filter) NoteLt(v sql.NullString) *T5Filter {
+	return f.add("note < $%d", v)
+}
+
+// NoteGt filters the rows whose note is greater than v.
+func (f *T5Filter) NoteGt(v sql.NullString) *T5Filter {
+	return f.add("note > $%d", v)
+}
+
+// NoteIsNull filters the rows whose note is NULL.
+func (f *T5Filter) NoteIsNull() *T5Filter {
+	f.conds = append(f.conds, "note IS NULL")
+	return f
+}
+
+// NoteIsNotNull filters the rows whose note is not NULL.
+func (f *T5Filter) NoteIsNotNull() *T5Filter {
+	f.conds = append(f.conds, "note IS NOT NULL")
+	return f
+}
+
+// FindT5 selects the T5s matching the filter from the database.
+// The result is ordered by the primary key.
+func FindT5(ctx context.Context, db Queryer, f *T5Filter) ([]*T5, error) {
+	where, args := f.Where()
+	rows, err := db.QueryContext(ctx,
+		`SELECT id, name, status, score, price, note FROM t5`+where+` ORDER BY id`,
+		args...)
+	if err != nil {
+		return nil, errors.WithStack(translateError("t5", err))
+	}
+	defer rows.Close()
+	var rs []*T5
+	for rows.Next() {
+		var r T5
+		if err := rows.Scan(&r.ID, &r.Name, &r.Status, &r.Score, &r.Price, &r.Note); err != nil {
+			return nil, errors.WithStack(translateError("t5", err))
+		}
+		rs = append(rs, &r)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.WithStack(translateError("t5", err))
+	}
+	return rs, nil
+}
+
+// CountT5 counts the T5s matching the filter in the database.
+// Pass nil as the filter to count every row.
+func CountT5(ctx context.Context, db Queryer, f *T5Filter) (int64, error) {
+	where, args := f.Where()
+	var n int64
+	err := db.QueryRowContext(ctx,
+		`SELECT count(*) FROM t5`+where,
+		args...).Scan(&n)
+	if err != nil {
+		return 0, errors.WithStack(translateError("t5", err))
+	}
+	return n, nil
+}
+
+// DeleteT5Where deletes the T5s matching the filter from the database,
+// and returns the number of the deleted rows. A filter without predicate is rejected
+// to avoid deleting every row by mistake.
+func DeleteT5Where(ctx context.Context, db Queryer, f *T5Filter) (int64, error) {
+	where, args := f.Where()
+	if where == "" {
+		return 0, errors.New("refusing to delete every row of t5 with an empty filter")
+	}
+	result, err := db.ExecContext(ctx,
+		`DELETE FROM t5`+where,
+		args...)
+	if err != nil {
+		return 0, errors.WithStack(translateError("t5", err))
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.WithStack(translateError("t5", err))
+	}
+	return n, nil
+}
+
+// UserAccount represents public.user_account
+type UserAccount struct {
+	ID        int64  // id
+	Email     string // email
+	LastName  string // last_name
+	FirstName string // first_name
+}
+
+// Create inserts the UserAccount to the database.
+//
+// Deprecated: Use CreateContext instead.
+func (r *UserAccount) Create(db Queryer) error {
+	return r.CreateContext(context.Background(), db)
+}
+
+// GetUserAccountByPk select the UserAccount from the database.
+//
+// Deprecated: Use GetUserAccountByPkContext instead.
+func GetUserAccountByPk(db Queryer, pk0 int64) (*UserAccount, error) {
+	return GetUserAccountByPkContext(context.Background(), db, pk0)
+}
+
+// Validate checks the UserAccount against the constraints of user_account which can be checked
+// without the database, and returns *ValidationError listing every failing field.
+func (r *UserAccount) Validate() error {
+	var errs []FieldError
+	if r.Email == "" {
+		errs = append(errs, FieldError{Field: "Email", Column: "email", Message: "must not be empty"})
+	}
+	if r.LastName == "" {
+		errs = append(errs, FieldError{Field: "LastName", Column: "last_name", Message: "must not be empty"})
+	}
+	if r.FirstName == "" {
+		errs = append(errs, FieldError{Field: "FirstName", Column: "first_name", Message: "must not be empty"})
+	}
+	if len(errs) > 0 {
+		return &ValidationError{Table: "user_account", Fields: errs}
+	}
+	return nil
+}
+
+// CreateContext inserts the UserAccount to the database.
+func (r *UserAccount) CreateContext(ctx context.Context, db Queryer) error {
+	if err := beforeCreate(ctx, r); err != nil {
+		return err
+	}
+	err := db.QueryRowContext(ctx,
+		`INSERT INTO user_account (email, last_name, first_name) VALUES ($1, $2, $3) RETURNING id`,
+		&r.Email, &r.LastName, &r.FirstName).Scan(&r.ID)
+	if err != nil {
+		return errors.WithStack(translateError("user_account", err))
+	}
+	return afterCreate(ctx, r)
+}
+
+// CreateOnConflictDoNothing inserts the UserAccount to the database.
+// If a conflict occurs (e.g., unique constraint violation), the insert is skipped without error.
+// Returns true if the row was inserted, false if it was skipped due to conflict.
+func (r *UserAccount) CreateOnConflictDoNothing(ctx context.Context, db Queryer) (bool, error) {
+	if err := beforeCreate(ctx, r); err != nil {
+		return false, err
+	}
+	err := db.QueryRowContext(ctx,
+		`INSERT INTO user_account (email, last_name, first_name) VALUES ($1, $2, $3) ON CONFLICT DO NOTHING RETURNING id`,
+		&r.Email, &r.LastName, &r.FirstName).Scan(&r.ID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return false, nil
+		}
+		return false, errors.WithStack(translateError("user_account", err))
+	}
+	// Row was successfully inserted
+	return true, afterCreate(ctx, r)
+}
+
+// UpdateContext updates the UserAccount in the database by the primary key.
+// *NotFoundError is returned if the row is not found.
+func (r *UserAccount) UpdateContext(ctx context.Context, db Queryer) error {
+	if err := beforeUpdate(ctx, r); err != nil {
+		return err
+	}
+	result, err := db.ExecContext(ctx,
+		`UPDATE user_account SET email = $1, last_name = $2, first_name = $3 WHERE id = $4`,
+		&r.Email, &r.LastName, &r.FirstName, &r.ID)
+	if err != nil {
+		return errors.WithStack(translateError("user_account", err))
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return errors.WithStack(translateError("user_account", err))
+	}
+	if n == 0 {
+		err = sql.ErrNoRows
+		return errors.WithStack(translateError("user_account", err))
+	}
+	return afterUpdate(ctx, r)
+}
+
+// DeleteContext deletes the UserAccount from the database by the primary key.
+// *NotFoundError is returned if the row is not found.
+func (r *UserAccount) DeleteContext(ctx context.Context, db Queryer) error {
+	if err := beforeDelete(ctx, r); err != nil {
+		return err
+	}
+	result, err := db.ExecContext(ctx,
+		`DELETE FROM user_account WHERE id = $1`,
+		&r.ID)
+	if err != nil {
+		return errors.WithStack(translateError("user_account", err))
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return errors.WithStack(translateError("user_account", err))
+	}
+	if n == 0 {
+		err = sql.ErrNoRows
+		return errors.WithStack(translateError("user_account", err))
+	}
+	return afterDelete(ctx, r)
+}
+
+// ReloadContext selects the UserAccount again by its primary key, and overwrites the fields with the
+// values in the database, e.g. after a trigger or a stored procedure changed the row.
+// *NotFoundError is returned if the row is not found.
+func (r *UserAccount) ReloadContext(ctx context.Context, db Queryer) error {
+	err := db.QueryRowContext(ctx,
+		`SELECT id, email, last_name, first_name FROM user_account WHERE id = $1`,
+		r.ID).Scan(&r.ID, &r.Email, &r.LastName, &r.FirstName)
+	if err != nil {
+		return errors.WithStack(translateError("user_account", err))
+	}
+	return nil
+}
+
+// GetUserAccountByPkContext select the UserAccount from the database.
+func GetUserAccountByPkContext(ctx context.Context, db Queryer, pk0 int64) (*UserAccount, error) {
+	var r UserAccount
+	err := db.QueryRowContext(ctx,
+		`SELECT id, email, last_name, first_name FROM user_account WHERE id = $1`,
+		pk0).Scan(&r.ID, &r.Email, &r.LastName, &r.FirstName)
+	if err != nil {
+		return nil, errors.WithStack(translateError("user_account", err))
+	}
+	return &r, nil
+}
+
+// GetUserAccountByPkForUpdateContext selects the UserAccount from the database with FOR UPDATE,
+// which locks the row until the end of the transaction. opt decides what happens if the row is locked
+// by another transaction: LockWait waits for it, LockNoWait fails with SQLSTATE 55P03, and
+// LockSkipLocked returns *NotFoundError.
+func GetUserAccountByPkForUpdateContext(ctx context.Context, db Queryer, pk0 int64, opt LockOption) (*UserAccount, error) {
+	var r UserAccount
+	err := db.QueryRowContext(ctx,
+		`SELECT id, email, last_name, first_name FROM user_account WHERE id = $1 FOR UPDATE`+opt.sql(),
+		pk0).Scan(&r.ID, &r.Email, &r.LastName, &r.FirstName)
+	if err != nil {
+		return nil, errors.WithStack(translateError("user_account", err))
+	}
+	return &r, nil
+}
+
+// GetUserAccountByPkForShareContext selects the UserAccount from the database with FOR SHARE,
+// which locks the row until the end of the transaction. opt decides what happens if the row is locked
+// by another transaction: LockWait waits for it, LockNoWait fails with SQLSTATE 55P03, and
+// LockSkipLocked returns *NotFoundError.
+func GetUserAccountByPkForShareContext(ctx context.Context, db Queryer, pk0 int64, opt LockOption) (*UserAccount, error) {
+	var r UserAccount
+	err := db.QueryRowContext(ctx,
+		`SELECT id, email, last_name, first_name FROM user_account WHERE id = $1 FOR SHARE`+opt.sql(),
+		pk0).Scan(&r.ID, &r.Email, &r.LastName, &r.FirstName)
+	if err != nil {
+		return nil, errors.WithStack(translateError("user_account", err))
+	}
+	return &r, nil
+}
+
+// ExistsUserAccountByPkContext checks if the UserAccount exists in the database.
+func ExistsUserAccountByPkContext(ctx context.Context, db Queryer, pk0 int64) (bool, error) {
+	var exists bool
+	err := db.QueryRowContext(ctx,
+		`SELECT EXISTS (SELECT 1 FROM user_account WHERE id = $1)`,
+		pk0).Scan(&exists)
+	if err != nil {
+		return false, errors.WithStack(translateError("user_account", err))
+	}
+	return exists, nil
+}
+
+// GetUserAccountByPksContext select the UserAccounts of the given primary keys from the database.
+// The result is ordered by the primary key, and the keys which do not exist are skipped.
+func GetUserAccountByPksContext(ctx context.Context, db Queryer, pks []int64) ([]*UserAccount, error) {
+	rows, err := db.QueryContext(ctx,
+		`SELECT id, email, last_name, first_name FROM user_account WHERE id = ANY($1) ORDER BY id`,
+		pq.Array(pks))
+	if err != nil {
+		return nil, errors.WithStack(translateError("user_account", err))
+	}
+	defer rows.Close()
+	var rs []*UserAccount
+	for rows.Next() {
+		var r UserAccount
+		if err := rows.Scan(&r.ID, &r.Email, &r.LastName, &r.FirstName); err != nil {
+			return nil, errors.WithStack(translateError("user_account", err))
+		}
+		rs = append(rs, &r)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.WithStack(translateError("user_account", err))
+	}
+	return rs, nil
+}
+
+// UserAccountListOptions is the options of ListUserAccount.
+type UserAccountListOptions struct {
+	// Limit is the maximum number of rows to return. Zero means no limit.
+	Limit int
+	// After is the primary key of the last row of the previous page.
+	// Only the rows after it are returned when it is not nil.
+	After *int64
+}
+
+// ListUserAccount lists the UserAccounts ordered by the primary key.
+// Pass the primary key of the last row as opts.After to get the next page.
+func ListUserAccount(ctx context.Context, db Queryer, opts UserAccountListOptions) ([]*UserAccount, error) {
+	q := `SELECT id, email, last_name, first_name FROM user_account`
+	var args []interface{}
+	if opts.After != nil {
+		q += ` WHERE id > $1`
+		args = append(args, *opts.After)
+	}
+	q += ` ORDER BY id`
+	if opts.Limit > 0 {
+		args = append(args, opts.Limit)
+		q += fmt.Sprintf(` LIMIT $%d`, len(args))
+	}
+	rows, err := db.QueryContext(ctx, q, args...)
+	if err != nil {
+		return nil, errors.WithStack(translateError("user_account", err))
+	}
+	defer rows.Close()
+	var rs []*UserAccount
+	for rows.Next() {
+		var r UserAccount
+		if err := rows.Scan(&r.ID, &r.Email, &r.LastName, &r.FirstName); err != nil {
+			return nil, errors.WithStack(translateError("user_account", err))
+		}
+		rs = append(rs, &r)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.WithStack(translateError("user_account", err))
+	}
+	return rs, nil
+}
+
+// UserAccountFilter builds a parameterized WHERE clause of user_account.
+// The predicates are combined with AND.
+type UserAccountFilter struct {
+	conds []string
+	args  []interface{}
+}
+
+// NewUserAccountFilter creates an empty UserAccountFilter, which matches every row.
+func NewUserAccountFilter() *UserAccountFilter {
+	return &UserAccountFilter{}
+}
+
+func (f *UserAccountFilter) add(cond string, v interface{}) *UserAccountFilter {
+	f.args = append(f.args, v)
+	f.conds = append(f.conds, fmt.Sprintf(cond, len(f.args)))
+	return f
+}
+
+// Where returns the WHERE clause and its arguments. It returns an empty clause
+// if the filter is nil or has no predicate.
+func (f *UserAccountFilter) Where() (string, []interface{}) {
+	if f == nil || len(f.conds) == 0 {
+		return "", nil
+	}
+	return " WHERE " + strings.Join(f.conds, " AND "), f.args
+}
+
+// IDEq filters the rows whose id equals to v.
+func (f *UserAccountFilter) IDEq(v int64) *UserAccountFilter {
+	return f.add("id = $%d", v)
+}
+
+// IDIn filters the rows whose id is one of vs.
+func (f *UserAccountFilter) IDIn(vs ...int64) *UserAccountFilter {
+	return f.add("id = ANY($%d)", pq.Array(vs))
+}
+
+// IDLt filters the rows whose id is less than v.
+func (f *UserAccountFilter) IDLt(v int64) *UserAccountFilter {
+	return f.add("id < $%d", v)
+}
+
+// IDGt filters the rows whose id is greater than v.
+func (f *UserAccountFilter) IDGt(v int64) *UserAccountFilter {
+	return f.add("id > $%d", v)
+}
+
+// EmailEq filters the rows whose email equals to v.
+func (f *UserAccountFilter) EmailEq(v string) *UserAccountFilter {
+	return f.add("email = $%d", v)
+}
+
+// EmailIn filters the rows whose email is one of vs.
+func (f *UserAccountFilter) EmailIn(vs ...string) *UserAccountFilter {
+	return f.add("email = ANY($%d)", pq.Array(vs))
+}
+
+// EmailLt filters the rows whose email is less than v.
+func (f *UserAccountFilter) EmailLt(v string) *UserAccountFilter {
+	return f.add("email < $%d", v)
+}
+
+// EmailGt filters the rows whose email is greater than v.
+func (f *UserAccountFilter) EmailGt(v string) *UserAccountFilter {
+	return f.add("email > $%d", v)
+}
+
+// LastNameEq filters the rows whose last_name equals to v.
+func (f *UserAccountFilter) LastNameEq(v string) *UserAccountFilter {
+	return f.add("last_name = $%d", v)
+}
+
+// LastNameIn filters the rows whose last_name is one of vs.
+func (f *UserAccountFilter) LastNameIn(vs ...string) *UserAccountFilter {
+	return f.add("last_name = ANY($%d)", pq.Array(vs))
+}
+
+// LastNameLt filters the rows whose last_name is less than v.
+func (f *UserAccountFilter) LastNameLt(v string) *UserAccountFilter {
+	return f.add("last_name < $%d", v)
+}
+
+// LastNameGt filters the rows whose last_name is greater than v.
+func (f *UserAccountFilter) LastNameGt(v string) *UserAccountFilter {
+	return f.add("last_name > $%d", v)
+}
+
+// FirstNameEq filters the rows whose first_name equals to v.
+func (f *UserAccountFilter) FirstNameEq(v string) *UserAccountFilter {
+	return f.add("first_name = $%d", v)
+}
+
+// FirstNameIn filters the rows whose first_name is one of vs.
+func (f *UserAccountFilter) FirstNameIn(vs ...string) *UserAccountFilter {
+	return f.add("first_name = ANY($%d)", pq.Array(vs))
+}
+
+// FirstNameLt filters the rows whose first_name is less than v.
+func (f *UserAccountFilter) FirstNameLt(v string) *UserAccountFilter {
+	return f.add("first_name < $%d", v)
+}
+
+// FirstNameGt filters the rows whose first_name is greater than v.
+func (f *UserAccountFilter) FirstNameGt(v string) *UserAccountFilter {
+	return f.add("first_name > $%d", v)
+}
+
+// FindUserAccount selects the UserAccounts matching the filter from the database.
+// The result is ordered by the primary key.
+func FindUserAccount(ctx context.Context, db Queryer, f *UserAccountFilter) ([]*UserAccount, error) {
+	where, args := f.Where()
+	rows, err := db.QueryContext(ctx,
+		`SELECT id, email, last_name, first_name FROM user_account`+where+` ORDER BY id`,
+		args...)
+	if err != nil {
+		return nil, errors.WithStack(translateError("user_account", err))
+	}
+	defer rows.Close()
+	var rs []*UserAccount
+	for rows.Next() {
+		var r UserAccount
+		if err := rows.Scan(&r.ID, &r.Email, &r.LastName, &r.FirstName); err != nil {
+			return nil, errors.WithStack(translateError("user_account", err))
+		}
+		rs = append(rs, &r)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.WithStack(translateError("user_account", err))
+	}
+	return rs, nil
+}
+
+// CountUserAccount counts the UserAccounts matching the filter in the database.
+// Pass nil as the filter to count every row.
+func CountUserAccount(ctx context.Context, db Queryer, f *UserAccountFilter) (int64, error) {
+	where, args := f.Where()
+	var n int64
+	err := db.QueryRowContext(ctx,
+		`SELECT count(*) FROM user_account`+where,
+		args...).Scan(&n)
+	if err != nil {
+		return 0, errors.WithStack(translateError("user_account", err))
+	}
+	return n, nil
+}
+
+// DeleteUserAccountWhere deletes the UserAccounts matching the filter from the database,
+// and returns the number of the deleted rows. A filter without predicate is rejected
+// to avoid deleting every row by mistake.
+func DeleteUserAccountWhere(ctx context.Context, db Queryer, f *UserAccountFilter) (int64, error) {
+	where, args := f.Where()
+	if where == "" {
+		return 0, errors.New("refusing to delete every row of user_account with an empty filter")
+	}
+	result, err := db.ExecContext(ctx,
+		`DELETE FROM user_account`+where,
+		args...)
+	if err != nil {
+		return 0, errors.WithStack(translateError("user_account", err))
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.WithStack(translateError("user_account", err))
+	}
+	return n, nil
+}
+
+// UserAccountCompositePk represents public.user_account_composite_pk
+type UserAccountCompositePk struct {
+	ID        int64  // id
+	Email     string // email
+	LastName  string // last_name
+	FirstName string // first_name
+}
+
+// UserAccountCompositePkPk represents the primary key of public.user_account_composite_pk
+type UserAccountCompositePkPk struct {
+	ID    int64  // id
+	Email string // email
+}
+
+// Create inserts the UserAccountCompositePk to the database.
+//
+// Deprecated: Use CreateContext instead.
+func (r *UserAccountCompositePk) Create(db Queryer) error {
+	return r.CreateContext(context.Background(), db)
+}
+
+// GetUserAccountCompositePkByPk select the UserAccountCompositePk from the database.
+//
+// Deprecated: Use GetUserAccountCompositePkByPkContext instead.
+func GetUserAccountCompositePkByPk(db Queryer, pk0 int64, pk1 string) (*UserAccountCompositePk, error) {
+	return GetUserAccountCompositePkByPkContext(context.Background(), db, pk0, pk1)
+}
+
+// Validate checks the UserAccountCompositePk against the constraints of user_account_composite_pk which can be checked
+// without the database, and returns *ValidationError listing every failing field.
+func (r *UserAccountCompositePk) Validate() error {
+	var errs []FieldError
+	if r.Email == "" {
+		errs = append(errs, FieldError{Field: "Email", Column: "email", Message: "must not be empty"})
+	}
+	if r.LastName == "" {
+		errs = append(errs, FieldError{Field: "LastName", Column: "last_name", Message: "must not be empty"})
+	}
+	if r.FirstName == "" {
+		errs = append(errs, FieldError{Field: "FirstName", Column: "first_name", Message: "must not be empty"})
+	}
+	if len(errs) > 0 {
+		return &ValidationError{Table: "user_account_composite_pk", Fields: errs}
+	}
+	return nil
+}
+
+// CreateContext inserts the UserAccountCompositePk to the database.
+func (r *UserAccountCompositePk) CreateContext(ctx context.Context, db Queryer) error {
+	if err := beforeCreate(ctx, r); err != nil {
+		return err
+	}
+	_, err := db.ExecContext(ctx,
+		`INSERT INTO user_account_composite_pk (id, email, last_name, first_name) VALUES ($1, $2, $3, $4)`,
+		&r.ID, &r.Email, &r.LastName, &r.FirstName)
+	if err != nil {
+		return errors.WithStack(translateError("user_account_composite_pk", err))
+	}
+	return afterCreate(ctx, r)
+}
+
+// CreateOnConflictDoNothing inserts the UserAccountCompositePk to the database.
+// If a conflict occurs (e.g., unique constraint violation), the insert is skipped without error.
+// Returns true if the row was inserted, false if it was skipped due to conflict.
+func (r *UserAccountCompositePk) CreateOnConflictDoNothing(ctx context.Context, db Queryer) (bool, error) {
+	if err := beforeCreate(ctx, r); err != nil {
+		return false, err
+	}
+	result, err := db.ExecContext(ctx,
+		`INSERT INTO user_account_composite_pk (id, email, last_name, first_name) VALUES ($1, $2, $3, $4) ON CONFLICT DO NOTHING`,
+		&r.ID, &r.Email, &r.LastName, &r.FirstName)
+	if err != nil {
+		return false, errors.WithStack(translateError("user_account_composite_pk", err))
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, errors.WithStack(translateError("user_account_composite_pk", err))
+	}
+	if rowsAffected == 0 {
+		return false, nil
+	}
+	return true, afterCreate(ctx, r)
+}
+
+// UpdateContext updates the UserAccountCompositePk in the database by the primary key.
+// *NotFoundError is returned if the row is not found.
+func (r *UserAccountCompositePk) UpdateContext(ctx context.Context, db Queryer) error {
+	if err := beforeUpdate(ctx, r); err != nil {
+		return err
+	}
+	result, err := db.ExecContext(ctx,
+		`UPDATE user_account_composite_pk SET last_name = $1, first_name = $2 WHERE id = $3 AND email = $4`,
+		&r.LastName, &r.FirstName, &r.ID, &r.Email)
+	if err != nil {
+		return errors.WithStack(translateError("user_account_composite_pk", err))
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return errors.WithStack(translateError("user_account_composite_pk", err))
+	}
+	if n == 0 {
+		err = sql.ErrNoRows
+		return errors.WithStack(translateError("user_account_composite_pk", err))
+	}
+	return afterUpdate(ctx, r)
+}
+
+// DeleteContext deletes the UserAccountCompositePk from the database by the primary key.
+// *NotFoundError is returned if the row is not found.
+func (r *UserAccountCompositePk) DeleteContext(ctx context.Context, db Queryer) error {
+	if err := beforeDelete(ctx, r); err != nil {
+		return err
+	}
+	result, err := db.ExecContext(ctx,
+		`DELETE FROM user_account_composite_pk WHERE id = $1 AND email = $2`,
+		&r.ID, &r.Email)
+	if err != nil {
+		return errors.WithStack(translateError("user_account_composite_pk", err))
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return errors.WithStack(translateError("user_account_composite_pk", err))
+	}
+	if n == 0 {
+		err = sql.ErrNoRows
+		return errors.WithStack(translateError("user_account_composite_pk", err))
+	}
+	return afterDelete(ctx, r)
+}
+
+// ReloadContext selects the UserAccountCompositePk again by its primary key, and overwrites the fields with the
+// values in the database, e.g. after a trigger or a stored procedure changed the row.
+// *NotFoundError is returned if the row is not found.
+func (r *UserAccountCompositePk) ReloadContext(ctx context.Context, db Queryer) error {
+	err := db.QueryRowContext(ctx,
+		`SELECT id, email, last_name, first_name FROM user_account_composite_pk WHERE id = $1 AND email = $2`,
+		r.ID, r.Email).Scan(&r.ID, &r.Email, &r.LastName, &r.FirstName)
+	if err != nil {
+		return errors.WithStack(translateError("user_account_composite_pk", err))
+	}
+	return nil
+}
+
+// GetUserAccountCompositePkByPkContext select the UserAccountCompositePk from the database.
+func GetUserAccountCompositePkByPkContext(ctx context.Context, db Queryer, pk0 int64, pk1 string) (*UserAccountCompositePk, error) {
+	var r UserAccountCompositePk
+	err := db.QueryRowContext(ctx,
+		`SELECT id, email, last_name, first_name FROM user_account_composite_pk WHERE id = $1 AND email = $2`,
+		pk0, pk1).Scan(&r.ID, &r.Email, &r.LastName, &r.FirstName)
+	if err != nil {
+		return nil, errors.WithStack(translateError("user_account_composite_pk", err))
+	}
+	return &r, nil
+}
+
+// GetUserAccountCompositePkByPkForUpdateContext selects the UserAccountCompositePk from the database with FOR UPDATE,
+// which locks the row until the end of the transaction. opt decides what happens if the row is locked
+// by another transaction: LockWait waits for it, LockNoWait fails with SQLSTATE 55P03, and
+// LockSkipLocked returns *NotFoundError.
+func GetUserAccountCompositePkByPkForUpdateContext(ctx context.Context, db Queryer, pk0 int64, pk1 string, opt LockOption) (*UserAccountCompositePk, error) {
+	var r UserAccountCompositePk
+	err := db.QueryRowContext(ctx,
+		`SELECT id, email, last_name, first_name FROM user_account_composite_pk WHERE id = $1 AND email = $2 FOR UPDATE`+opt.sql(),
+		pk0, pk1).Scan(&r.ID, &r.Email, &r.LastName, &r.FirstName)
+	if err != nil {
+		return nil, errors.WithStack(translateError("user_account_composite_pk", err))
+	}
+	return &r, nil
+}
+
+// GetUserAccountCompositePkByPkForShareContext selects the UserAccountCompositePk from the database with FOR SHARE,
+// which locks the row until the end of the transaction. opt decides what happens if the row is locked
+// by another transaction: LockWait waits for it, LockNoWait fails with SQLSTATE 55P03, and
+// LockSkipLocked returns *NotFoundError.
+func GetUserAccountCompositePkByPkForShareContext(ctx context.Context, db Queryer, pk0 int64, pk1 string, opt LockOption) (*UserAccountCompositePk, error) {
+	var r UserAccountCompositePk
+	err := db.QueryRowContext(ctx,
+		`SELECT id, email, last_name, first_name FROM user_account_composite_pk WHERE id = $1 AND email = $2 FOR SHARE`+opt.sql(),
+		pk0, pk1).Scan(&r.ID, &r.Email, &r.LastName, &r.FirstName)
+	if err != nil {
+		return nil, errors.WithStack(translateError("user_account_composite_pk", err))
+	}
+	return &r, nil
+}
+
+// ExistsUserAccountCompositePkByPkContext checks if the UserAccountCompositePk exists in the database.
+func ExistsUserAccountCompositePkByPkContext(ctx context.Context, db Queryer, pk0 int64, pk1 string) (bool, error) {
+	var exists bool
+	err := db.QueryRowContext(ctx,
+		`SELECT EXISTS (SELECT 1 FROM user_account_composite_pk WHERE id = $1 AND email = $2)`,
+		pk0, pk1).Scan(&exists)
+	if err != nil {
+		return false, errors.WithStack(translateError("user_account_composite_pk", err))
+	}
+	return exists, nil
+}
+
+// GetUserAccountCompositePkByPksContext select the UserAccountCompositePks of the given primary keys from the database.
+// The result is ordered by the primary key, and the keys which do not exist are skipped.
+func GetUserAccountCompositePkByPksContext(ctx context.Context, db Queryer, pks []UserAccountCompositePkPk) ([]*UserAccountCompositePk, error) {
+	if len(pks) == 0 {
+		return nil, nil
+	}
+	values := make([]string, 0, len(pks))
+	args := make([]interface{}, 0, len(pks)*2)
+	for _, pk := range pks {
+		args = append(args, pk.ID, pk.Email)
+		values = append(values, fmt.Sprintf("($%d::bigint, $%d::text)", len(args)-1, len(args)))
+	}
+	rows, err := db.QueryContext(ctx,
+		fmt.Sprintf(`SELECT id, email, last_name, first_name FROM user_account_composite_pk WHERE (id, email) IN (VALUES %s) ORDER BY id, email`, strings.Join(values, ", ")),
+		args...)
+	if err != nil {
+		return nil, errors.WithStack(translateError("user_account_composite_pk", err))
+	}
+	defer rows.Close()
+	var rs []*UserAccountCompositePk
+	for rows.Next() {
+		var r UserAccountCompositePk
+		if err := rows.Scan(&r.ID, &r.Email, &r.LastName, &r.FirstName); err != nil {
+			return nil, errors.WithStack(translateError("user_account_composite_pk", err))
+		}
+		rs = append(rs, &r)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.WithStack(translateError("user_account_composite_pk", err))
+	}
+	return rs, nil
+}
+
+// UserAccountCompositePkListOptions is the options of ListUserAccountCompositePk.
+type UserAccountCompositePkListOptions struct {
+	// Limit is the maximum number of rows to return. Zero means no limit.
+	Limit int
+	// After is the primary key of the last row of the previous page.
+	// Only the rows after it are returned when it is not nil.
+	After *UserAccountCompositePkPk
+}
+
+// ListUserAccountCompositePk lists the UserAccountCompositePks ordered by the primary key.
+// Pass the primary key of the last row as opts.After to get the next page.
+func ListUserAccountCompositePk(ctx context.Context, db Queryer, opts UserAccountCompositePkListOptions) ([]*UserAccountCompositePk, error) {
+	q := `SELECT id, email, last_name, first_name FROM user_account_composite_pk`
+	var args []interface{}
+	if opts.After != nil {
+		q += ` WHERE (id, email) > ($1, $2)`
+		args = append(args, opts.After.ID, opts.After.Email)
+	}
+	q += ` ORDER BY id, email`
+	if opts.Limit > 0 {
+		args = append(args, opts.Limit)
+		q += fmt.Sprintf(` LIMIT $%d`, len(args))
+	}
+	rows, err := db.QueryContext(ctx, q, args...)
+	if err != nil {
+		return nil, errors.WithStack(translateError("user_account_composite_pk", err))
+	}
+	defer rows.Close()
+	var rs []*UserAccountCompositePk
+	for rows.Next() {
+		var r UserAccountCompositePk
+		if err := rows.Scan(&r.ID, &r.Email, &r.LastName, &r.FirstName); err != nil {
+			return nil, errors.WithStack(translateError("user_account_composite_pk", err))
+		}
+		rs = append(rs, &r)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.WithStack(translateError("user_account_composite_pk", err))
+	}
+	return rs, nil
+}
+
+// UserAccountCompositePkFilter builds a parameterized WHERE clause of user_account_composite_pk.
+// The predicates are combined with AND.
+type UserAccountCompositePkFilter struct {
+	conds []string
+	args  []interface{}
+}
+
+// NewUserAccountCompositePkFilter creates an empty UserAccountCompositePkFilter, which matches every row.
+func NewUserAccountCompositePkFilter() *UserAccountCompositePkFilter {
+	return &UserAccountCompositePkFilter{}
+}
+
+func (f *UserAccountCompositePkFilter) add(cond string, v interface{}) *UserAccountCompositePkFilter {
+	f.args = append(f.args, v)
+	f.conds = append(f.conds, fmt.Sprintf(cond, len(f.args)))
+	return f
+}
+
+// Where returns the WHERE clause and its arguments. It returns an empty clause
+// if the filter is nil or has no predicate.
+func (f *UserAccountCompositePkFilter) Where() (string, []interface{}) {
+	if f == nil || len(f.conds) == 0 {
+		return "", nil
+	}
+	return " WHERE " + strings.Join(f.conds, " AND "), f.args
+}
+
+// IDEq filters the rows whose id equals to v.
+func (f *UserAccountCompositePkFilter) IDEq(v int64) *UserAccountCompositePkFilter {
+	return f.add("id = $%d", v)
+}
+
+// IDIn filters the rows whose id is one of vs.
+func (f *UserAccountCompositePkFilter) IDIn(vs ...int64) *UserAccountCompositePkFilter {
+	return f.add("id = ANY($%d)", pq.Array(vs))
+}
+
+// IDLt filters the rows whose id is less than v.
+func (f *UserAccountCompositePkFilter) IDLt(v int64) *UserAccountCompositePkFilter {
+	return f.add("id < $%d", v)
+}
+
+// IDGt filters the rows whose id is greater than v.
+func (f *UserAccountCompositePkFilter) IDGt(v int64) *UserAccountCompositePkFilter {
+	return f.add("id > $%d", v)
+}
+
+// EmailEq filters the rows whose email equals to v.
+func (f *UserAccountCompositePkFilter) EmailEq(v string) *UserAccountCompositePkFilter {
+	return f.add("email = $%d", v)
+}
+
+// EmailIn filters the rows whose email is one of vs.
+func (f *UserAccountCompositePkFilter) EmailIn(vs ...string) *UserAccountCompositePkFilter {
+	return f.add("email = ANY($%d)", pq.Array(vs))
+}
+
+// EmailLt filters the rows whose email is less than v.
+func (f *UserAccountCompositePkFilter) EmailLt(v string) *UserAccountCompositePkFilter {
+	return f.add("email < $%d", v)
+}
+
+// EmailGt filters the rows whose email is greater than v.
+func (f *UserAccountCompositePkFilter) EmailGt(v string) *UserAccountCompositePkFilter {
+	return f.add("email > $%d", v)
+}
+
+// LastNameEq filters the rows whose last_name equals to v.
+func (f *UserAccountCompositePkFilter) LastNameEq(v string) *UserAccountCompositePkFilter {
+	return f.add("last_name = $%d", v)
+}
+
+// LastNameIn filters the rows whose last_name is one of vs.
+func (f *UserAccountCompositePkFilter) LastNameIn(vs ...string) *UserAccountCompositePkFilter {
+	return f.add("last_name = ANY($%d)", pq.Array(vs))
+}
+
+// LastNameLt filters the rows whose last_name is less than v.
+func (f *UserAccountCompositePkFilter) LastNameLt(v string) *UserAccountCompositePkFilter {
+	return f.add("last_name < $%d", v)
+}
+
+// LastNameGt filters the rows whose last_name is greater than v.
+func (f *UserAccountCompositePkFilter) LastNameGt(v string) *UserAccountCompositePkFilter {
+	return f.add("last_name > $%d", v)
+}
+
+// FirstNameEq filters the rows whose first_name equals to v.
+func (f *UserAccountCompositePkFilter) FirstNameEq(v string) *UserAccountCompositePkFilter {
+	return f.add("first_name = $%d", v)
+}
+
+// FirstNameIn filters the rows whose first_name is one of vs.
+func (f *UserAccountCompositePkFilter) FirstNameIn(vs ...string) *UserAccountCompositePkFilter {
+	return f.add("first_name = ANY($%d)", pq.Array(vs))
+}
+
+// FirstNameLt filters the rows whose first_name is less than v.
+func (f *UserAccountCompositePkFilter) FirstNameLt(v string) *UserAccountCompositePkFilter {
+	return f.add("first_name < $%d", v)
+}
+
+// FirstNameGt filters the rows whose first_name is greater than v.
+func (f *UserAccountCompositePkFilter) FirstNameGt(v string) *UserAccountCompositePkFilter {
+	return f.add("first_name > $%d", v)
+}
+
+// FindUserAccountCompositePk selects the UserAccountCompositePks matching the filter from the database.
+// The result is ordered by the primary key.
+func FindUserAccountCompositePk(ctx context.Context, db Queryer, f *UserAccountCompositePkFilter) ([]*UserAccountCompositePk, error) {
+	where, args := f.Where()
+	rows, err := db.QueryContext(ctx,
+		`SELECT id, email, last_name, first_name FROM user_account_composite_pk`+where+` ORDER BY id, email`,
+		args...)
+	if err != nil {
+		return nil, errors.WithStack(translateError("user_account_composite_pk", err))
+	}
+	defer rows.Close()
+	var rs []*UserAccountCompositePk
+	for rows.Next() {
+		var r UserAccountCompositePk
+		if err := rows.Scan(&r.ID, &r.Email, &r.LastName, &r.FirstName); err != nil {
+			return nil, errors.WithStack(translateError("user_account_composite_pk", err))
+		}
+		rs = append(rs, &r)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.WithStack(translateError("user_account_composite_pk", err))
+	}
+	return rs, nil
+}
+
+// CountUserAccountCompositePk counts the UserAccountCompositePks matching the filter in the database.
+// Pass nil as the filter to count every row.
+func CountUserAccountCompositePk(ctx context.Context, db Queryer, f *UserAccountCompositePkFilter) (int64, error) {
+	where, args := f.Where()
+	var n int64
+	err := db.QueryRowContext(ctx,
+		`SELECT count(*) FROM user_account_composite_pk`+where,
+		args...).Scan(&n)
+	if err != nil {
+		return 0, errors.WithStack(translateError("user_account_composite_pk", err))
+	}
+	return n, nil
+}
+
+// DeleteUserAccountCompositePkWhere deletes the UserAccountCompositePks matching the filter from the database,
+// and returns the number of the deleted rows. A filter without predicate is rejected
+// to avoid deleting every row by mistake.
+func DeleteUserAccountCompositePkWhere(ctx context.Context, db Queryer, f *UserAccountCompositePkFilter) (int64, error) {
+	where, args := f.Where()
+	if where == "" {
+		return 0, errors.New("refusing to delete every row of user_account_composite_pk with an empty filter")
+	}
+	result, err := db.ExecContext(ctx,
+		`DELETE FROM user_account_composite_pk`+where,
+		args...)
+	if err != nil {
+		return 0, errors.WithStack(translateError("user_account_composite_pk", err))
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.WithStack(translateError("user_account_composite_pk", err))
+	}
+	return n, nil
+}
+
+// UserAccountUUID represents public.user_account_uuid
+type UserAccountUUID struct {
+	UUID      string // uuid
+	Email     string // email
+	LastName  string // last_name
+	FirstName string // first_name
+}
+
+// Create inserts the UserAccountUUID to the database.
+//
+// Deprecated: Use CreateContext instead.
+func (r *UserAccountUUID) Create(db Queryer) error {
+	return r.CreateContext(context.Background(), db)
+}
+
+// GetUserAccountUUIDByPk select the UserAccountUUID from the database.
+//
+// Deprecated: Use GetUserAccountUUIDByPkContext instead.
+func GetUserAccountUUIDByPk(db Queryer, pk0 string) (*UserAccountUUID, error) {
+	return GetUserAccountUUIDByPkContext(context.Background(), db, pk0)
+}
+
+// Validate checks the UserAccountUUID against the constraints of user_account_uuid which can be checked
+// without the database, and returns *ValidationError listing every failing field.
+func (r *UserAccountUUID) Validate() error {
+	var errs []FieldError
+	if r.Email == "" {
+		errs = append(errs, FieldError{Field: "Email", Column: "email", Message: "must not be empty"})
+	}
+	if r.LastName == "" {
+		errs = append(errs, FieldError{Field: "LastName", Column: "last_name", Message: "must not be empty"})
+	}
+	if r.FirstName == "" {
+		errs = append(errs, FieldError{Field: "FirstName", Column: "first_name", Message: "must not be empty"})
+	}
+	if len(errs) > 0 {
+		return &ValidationError{Table: "user_account_uuid", Fields: errs}
+	}
+	return nil
+}
+
+// CreateContext inserts the UserAccountUUID to the database.
+func (r *UserAccountUUID) CreateContext(ctx context.Context, db Queryer) error {
+	if err := beforeCreate(ctx, r); err != nil {
+		return err
+	}
+	err := db.QueryRowContext(ctx,
+		`INSERT INTO user_account_uuid (email, last_name, first_name) VALUES ($1, $2, $3) RETURNING uuid`,
+		&r.Email, &r.LastName, &r.FirstName).Scan(&r.UUID)
+	if err != nil {
+		return errors.WithStack(translateError("user_account_uuid", err))
+	}
+	return afterCreate(ctx, r)
+}
+
+// CreateOnConflictDoNothing inserts the UserAccountUUID to the database.
+// If a conflict occurs (e.g., unique constraint violation), the insert is skipped without error.
+// Returns true if the row was inserted, false if it was skipped due to conflict.
+func (r *UserAccountUUID) CreateOnConflictDoNothing(ctx context.Context, db Queryer) (bool, error) {
+	if err := beforeCreate(ctx, r); err != nil {
+		return false, err
+	}
+	err := db.QueryRowContext(ctx,
+		`INSERT INTO user_account_uuid (email, last_name, first_name) VALUES ($1, $2, $3) ON CONFLICT DO NOTHING RETURNING uuid`,
+		&r.Email, &r.LastName, &r.FirstName).Scan(&r.UUID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return false, nil
+		}
+		return false, errors.WithStack(translateError("user_account_uuid", err))
+	}
+	// Row was successfully inserted
+	return true, afterCreate(ctx, r)
+}
+
+// UpdateContext updates the UserAccountUUID in the database by the primary key.
+// *NotFoundError is returned if the row is not found.
+func (r *UserAccountUUID) UpdateContext(ctx context.Context, db Queryer) error {
+	if err := beforeUpdate(ctx, r); err != nil {
+		return err
+	}
+	result, err := db.ExecContext(ctx,
+		`UPDATE user_account_uuid SET email = $1, last_name = $2, first_name = $3 WHERE uuid = $4`,
+		&r.Email, &r.LastName, &r.FirstName, &r.UUID)
+	if err != nil {
+		return errors.WithStack(translateError("user_account_uuid", err))
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return errors.WithStack(translateError("user_account_uuid", err))
+	}
+	if n == 0 {
+		err = sql.ErrNoRows
+		return errors.WithStack(translateError("user_account_uuid", err))
+	}
+	return afterUpdate(ctx, r)
+}
+
+// DeleteContext deletes the UserAccountUUID from the database by the primary key.
+// *NotFoundError is returned if the row is not found.
+func (r *UserAccountUUID) DeleteContext(ctx context.Context, db Queryer) error {
+	if err := beforeDelete(ctx, r); err != nil {
+		return err
+	}
+	result, err := db.ExecContext(ctx,
+		`DELETE FROM user_account_uuid WHERE uuid = $1`,
+		&r.UUID)
+	if err != nil {
+		return errors.WithStack(translateError("user_account_uuid", err))
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return errors.WithStack(translateError("user_account_uuid", err))
+	}
+	if n == 0 {
+		err = sql.ErrNoRows
+		return errors.WithStack(translateError("user_account_uuid", err))
+	}
+	return afterDelete(ctx, r)
+}
+
+// ReloadContext selects the UserAccountUUID again by its primary key, and overwrites the fields with the
+// values in the database, e.g. after a trigger or a stored procedure changed the row.
+// *NotFoundError is returned if the row is not found.
+func (r *UserAccountUUID) ReloadContext(ctx context.Context, db Queryer) error {
+	err := db.QueryRowContext(ctx,
+		`SELECT uuid, email, last_name, first_name FROM user_account_uuid WHERE uuid = $1`,
+		r.UUID).Scan(&r.UUID, &r.Email, &r.LastName, &r.FirstName)
+	if err != nil {
+		return errors.WithStack(translateError("user_account_uuid", err))
+	}
+	return nil
+}
+
+// GetUserAccountUUIDByPkContext select the UserAccountUUID from the database.
+func GetUserAccountUUIDByPkContext(ctx context.Context, db Queryer, pk0 string) (*UserAccountUUID, error) {
+	var r UserAccountUUID
+	err := db.QueryRowContext(ctx,
+		`SELECT uuid, email, last_name, first_name FROM user_account_uuid WHERE uuid = $1`,
+		pk0).Scan(&r.UUID, &r.Email, &r.LastName, &r.FirstName)
+	if err != nil {
+		return nil, errors.WithStack(translateError("user_account_uuid", err))
+	}
+	return &r, nil
+}
+
+// GetUserAccountUUIDByPkForUpdateContext selects the UserAccountUUID from the database with FOR UPDATE,
+// which locks the row until the end of the transaction. opt decides what happens if the row is locked
+// by another transaction: LockWait waits for it, LockNoWait fails with SQLSTATE 55P03, and
+// LockSkipLocked returns *NotFoundError.
+func GetUserAccountUUIDByPkForUpdateContext(ctx context.Context, db Queryer, pk0 string, opt LockOption) (*UserAccountUUID, error) {
+	var r UserAccountUUID
+	err := db.QueryRowContext(ctx,
+		`SELECT uuid, email, last_name, first_name FROM user_account_uuid WHERE uuid = $1 FOR UPDATE`+opt.sql(),
+		pk0).Scan(&r.UUID, &r.Email, &r.LastName, &r.FirstName)
+	if err != nil {
+		return nil, errors.WithStack(translateError("user_account_uuid", err))
+	}
+	return &r, nil
+}
+
+// GetUserAccountUUIDByPkForShareContext selects the UserAccountUUID from the database with FOR SHARE,
+// which locks the row until the end of the transaction. opt decides what happens if the row is locked
+// by another transaction: LockWait waits for it, LockNoWait fails with SQLSTATE 55P03, and
+// LockSkipLocked returns *NotFoundError.
+func GetUserAccountUUIDByPkForShareContext(ctx context.Context, db Queryer, pk0 string, opt LockOption) (*UserAccountUUID, error) {
+	var r UserAccountUUID
+	err := db.QueryRowContext(ctx,
+		`SELECT uuid, email, last_name, first_name FROM user_account_uuid WHERE uuid = $1 FOR SHARE`+opt.sql(),
+		pk0).Scan(&r.UUID, &r.Email, &r.LastName, &r.FirstName)
+	if err != nil {
+		return nil, errors.WithStack(translateError("user_account_uuid", err))
+	}
+	return &r, nil
+}
+
+// ExistsUserAccountUUIDByPkContext checks if the UserAccountUUID exists in the database.
+func ExistsUserAccountUUIDByPkContext(ctx context.Context, db Queryer, pk0 string) (bool, error) {
+	var exists bool
+	err := db.QueryRowContext(ctx,
+		`SELECT EXISTS (SELECT 1 FROM user_account_uuid WHERE uuid = $1)`,
+		pk0).Scan(&exists)
+	if err != nil {
+		return false, errors.WithStack(translateError("user_account_uuid", err))
+	}
+	return exists, nil
+}
+
+// GetUserAccountUUIDByPksContext select the UserAccountUUIDs of the given primary keys from the database.
+// The result is ordered by the primary key, and the keys which do not exist are skipped.
+func GetUserAccountUUIDByPksContext(ctx context.Context, db Queryer, pks []string) ([]*UserAccountUUID, error) {
+	rows, err := db.QueryContext(ctx,
+		`SELECT uuid, email, last_name, first_name FROM user_account_uuid WHERE uuid = ANY($1) ORDER BY uuid`,
+		pq.Array(pks))
+	if err != nil {
+		return nil, errors.WithStack(translateError("user_account_uuid", err))
+	}
+	defer rows.Close()
+	var rs []*UserAccountUUID
+	for rows.Next() {
+		var r UserAccountUUID
+		if err := rows.Scan(&r.UUID, &r.Email, &r.LastName, &r.FirstName); err != nil {
+			return nil, errors.WithStack(translateError("user_account_uuid", err))
+		}
+		rs = append(rs, &r)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.WithStack(translateError("user_account_uuid", err))
+	}
+	return rs, nil
+}
+
+// UserAccountUUIDListOptions is the options of ListUserAccountUUID.
+type UserAccountUUIDListOptions struct {
+	// Limit is the maximum number of rows to return. Zero means no limit.
+	Limit int
+	// After is the primary key of the last row of the previous page.
+	// Only the rows after it are returned when it is not nil.
+	After *string
+}
+
+// ListUserAccountUUID lists the UserAccountUUIDs ordered by the primary key.
+// Pass the primary key of the last row as opts.After to get the next page.
+func ListUserAccountUUID(ctx context.Context, db Queryer, opts UserAccountUUIDListOptions) ([]*UserAccountUUID, error) {
+	q := `SELECT uuid, email, last_name, first_name FROM user_account_uuid`
+	var args []interface{}
+	if opts.After != nil {
+		q += ` WHERE uuid > $1`
+		args = append(args, *opts.After)
+	}
+	q += ` ORDER BY uuid`
+	if opts.Limit > 0 {
+		args = append(args, opts.Limit)
+		q += fmt.Sprintf(` LIMIT $%d`, len(args))
+	}
+	rows, err := db.QueryContext(ctx, q, args...)
+	if err != nil {
+		return nil, errors.WithStack(translateError("user_account_uuid", err))
+	}
+	defer rows.Close()
+	var rs []*UserAccountUUID
+	for rows.Next() {
+		var r UserAccountUUID
+		if err := rows.Scan(&r.UUID, &r.Email, &r.LastName, &r.FirstName); err != nil {
+			return nil, errors.WithStack(translateError("user_account_uuid", err))
+		}
+		rs = append(rs, &r)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.WithStack(translateError("user_account_uuid", err))
+	}
+	return rs, nil
+}
+
+// UserAccountUUIDFilter builds a parameterized WHERE clause of user_account_uuid.
+// The predicates are combined with AND.
+type UserAccountUUIDFilter struct {
+	conds []string
+	args  []interface{}
+}
+
+// NewUserAccountUUIDFilter creates an empty UserAccountUUIDFilter, which matches every row.
+func NewUserAccountUUIDFilter() *UserAccountUUIDFilter {
+	return &UserAccountUUIDFilter{}
+}
+
+func (f *UserAccountUUIDFilter) add(cond string, v interface{}) *UserAccountUUIDFilter {
+	f.args = append(f.args, v)
+	f.conds = append(f.conds, fmt.Sprintf(cond, len(f.args)))
+	return f
+}
+
+// Where returns the WHERE clause and its arguments. It returns an empty clause
+// if the filter is nil or has no predicate.
+func (f *UserAccountUUIDFilter) Where() (string, []interface{}) {
+	if f == nil || len(f.conds) == 0 {
+		return "", nil
+	}
+	return " WHERE " + strings.Join(f.conds, " AND "), f.args
+}
+
+// UUIDEq filters the rows whose uuid equals to v.
+func (f *UserAccountUUIDFilter) UUIDEq(v string) *UserAccountUUIDFilter {
+	return f.add("uuid = $%d", v)
+}
+
+// UUIDIn filters the rows whose uuid is one of vs.
+func (f *UserAccountUUIDFilter) UUIDIn(vs ...string) *UserAccountUUIDFilter {
+	return f.add("uuid = ANY($%d)", pq.Array(vs))
+}
+
+// EmailEq filters the rows whose email equals to v.
+func (f *UserAccountUUIDFilter) EmailEq(v string) *UserAccountUUIDFilter {
+	return f.add("email = $%d", v)
+}
+
+// EmailIn filters the rows whose email is one of vs.
+func (f *UserAccountUUIDFilter) EmailIn(vs ...string) *UserAccountUUIDFilter {
+	return f.add("email = ANY($%d)", pq.Array(vs))
+}
+
+// EmailLt filters the rows whose email is less than v.
+func (f *UserAccountUUIDFilter) EmailLt(v string) *UserAccountUUIDFilter {
+	return f.add("email < $%d", v)
+}
+
+// EmailGt filters the rows whose email is greater than v.
+func (f *UserAccountUUIDFilter) EmailGt(v string) *UserAccountUUIDFilter {
+	return f.add("email > $%d", v)
+}
+
+// LastNameEq filters the rows whose last_name equals to v.
+func (f *UserAccountUUIDFilter) LastNameEq(v string) *UserAccountUUIDFilter {
+	return f.add("last_name = $%d", v)
+}
+
+// LastNameIn filters the rows whose last_name is one of vs.
+func (f *UserAccountUUIDFilter) LastNameIn(vs ...string) *UserAccountUUIDFilter {
+	return f.add("last_name = ANY($%d)", pq.Array(vs))
+}
+
+// LastNameLt filters the rows whose last_name is less than v.
+func (f *UserAccountUUIDFilter) LastNameLt(v string) *UserAccountUUIDFilter {
+	return f.add("last_name < $%d", v)
+}
+
+// LastNameGt filters the rows whose last_name is greater than v.
+func (f *UserAccountUUIDFilter) LastNameGt(v string) *UserAccountUUIDFilter {
+	return f.add("last_name > $%d", v)
+}
+
+// FirstNameEq filters the rows whose first_name equals to v.
+func (f *UserAccountUUIDFilter) FirstNameEq(v string) *UserAccountUUIDFilter {
+	return f.add("first_name = $%d", v)
+}
+
+// FirstNameIn filters the rows whose first_name is one of vs.
+func (f *UserAccountUUIDFilter) FirstNameIn(vs ...string) *UserAccountUUIDFilter {
+	return f.add("first_name = ANY($%d)", pq.Array(vs))
+}
+
+// FirstNameLt filters the rows whose first_name is less than v.
+func (f *UserAccountUUIDFilter) FirstNameLt(v string) *UserAccountUUIDFilter {
+	return f.add("first_name < $%d", v)
+}
+
+// FirstNameGt filters the rows whose first_name is greater than v.
+func (f *UserAccountUUIDFilter) FirstNameGt(v string) *UserAccountUUIDFilter {
+	return f.add("first_name > $%d", v)
+}
+
+// FindUserAccountUUID selects the UserAccountUUIDs matching the filter from the database.
+// The result is ordered by the primary key.
+func FindUserAccountUUID(ctx context.Context, db Queryer, f *UserAccountUUIDFilter) ([]*UserAccountUUID, error) {
+	where, args := f.Where()
+	rows, err := db.QueryContext(ctx,
+		`SELECT uuid, email, last_name, first_name FROM user_account_uuid`+where+` ORDER BY uuid`,
+		args...)
+	if err != nil {
+		return nil, errors.WithStack(translateError("user_account_uuid", err))
+	}
+	defer rows.Close()
+	var rs []*UserAccountUUID
+	for rows.Next() {
+		var r UserAccountUUID
+		if err := rows.Scan(&r.UUID, &r.Email, &r.LastName, &r.FirstName); err != nil {
+			return nil, errors.WithStack(translateError("user_account_uuid", err))
+		}
+		rs = append(rs, &r)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.WithStack(translateError("user_account_uuid", err))
+	}
+	return rs, nil
+}
+
+// CountUserAccountUUID counts the UserAccountUUIDs matching the filter in the database.
+// Pass nil as the filter to count every row.
+func CountUserAccountUUID(ctx context.Context, db Queryer, f *UserAccountUUIDFilter) (int64, error) {
+	where, args := f.Where()
+	var n int64
+	err := db.QueryRowContext(ctx,
+		`SELECT count(*) FROM user_account_uuid`+where,
+		args...).Scan(&n)
+	if err != nil {
+		return 0, errors.WithStack(translateError("user_account_uuid", err))
+	}
+	return n, nil
+}
+
+// DeleteUserAccountUUIDWhere deletes the UserAccountUUIDs matching the filter from the database,
+// and returns the number of the deleted rows. A filter without predicate is rejected
+// to avoid deleting every row by mistake.
+func DeleteUserAccountUUIDWhere(ctx context.Context, db Queryer, f *UserAccountUUIDFilter) (int64, error) {
+	where, args := f.Where()
+	if where == "" {
+		return 0, errors.New("refusing to delete every row of user_account_uuid with an empty filter")
+	}
+	result, err := db.ExecContext(ctx,
+		`DELETE FROM user_account_uuid`+where,
+		args...)
+	if err != nil {
+		return 0, errors.WithStack(translateError("user_account_uuid", err))
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.WithStack(translateError("user_account_uuid", err))
+	}
+	return n, nil
+}
+
+// UserAccountUUIDAddress represents public.user_account_uuid_address
+type UserAccountUUIDAddress struct {
+	UUID  string // uuid
+	State string // state
+	City  string // city
+	Line1 string // line1
+	Line2 string // line2
+}
+
+// Create inserts the UserAccountUUIDAddress to the database.
+//
+// Deprecated: Use CreateContext instead.
+func (r *UserAccountUUIDAddress) Create(db Queryer) error {
+	return r.CreateContext(context.Background(), db)
+}
+
+// GetUserAccountUUIDAddressByPk select the UserAccountUUIDAddress from the database.
+//
+// Deprecated: Use GetUserAccountUUIDAddressByPkContext instead.
+func GetUserAccountUUIDAddressByPk(db Queryer, pk0 string) (*UserAccountUUIDAddress, error) {
+	return GetUserAccountUUIDAddressByPkContext(context.Background(), db, pk0)
+}
+
+// Validate checks the UserAccountUUIDAddress against the constraints of user_account_uuid_address which can be checked
+// without the database, and returns *ValidationError listing every failing field.
+func (r *UserAccountUUIDAddress) Validate() error {
+	var errs []FieldError
+	if r.State == "" {
+		errs = append(errs, FieldError{Field: "State", Column: "state", Message: "must not be empty"})
+	}
+	if r.City == "" {
+		errs = append(errs, FieldError{Field: "City", Column: "city", Message: "must not be empty"})
+	}
+	if r.Line1 == "" {
+		errs = append(errs, FieldError{Field: "Line1", Column: "line1", Message: "must not be empty"})
+	}
+	if r.Line2 == "" {
+		errs = append(errs, FieldError{Field: "Line2", Column: "line2", Message: "must not be empty"})
+	}
+	if len(errs) > 0 {
+		return &ValidationError{Table: "user_account_uuid_address", Fields: errs}
+	}
+	return nil
+}
+
+// CreateContext inserts the UserAccountUUIDAddress to the database.
+func (r *UserAccountUUIDAddress) CreateContext(ctx context.Context, db Queryer) error {
+	if err := beforeCreate(ctx, r); err != nil {
+		return err
+	}
+	_, err := db.ExecContext(ctx,
+		`INSERT INTO user_account_uuid_address (uuid, state, city, line1, line2) VALUES ($1, $2, $3, $4, $5)`,
+		&r.UUID, &r.State, &r.City, &r.Line1, &r.Line2)
+	if err != nil {
+		return errors.WithStack(translateError("user_account_uuid_address", err))
+	}
+	return afterCreate(ctx, r)
+}
+
+// CreateOnConflictDoNothing inserts the UserAccountUUIDAddress to the database.
+// If a conflict occurs (e.g., unique constraint violation), the insert is skipped without error.
+// Returns true if the row was inserted, false if it was skipped due to conflict.
+func (r *UserAccountUUIDAddress) CreateOnConflictDoNothing(ctx context.Context, db Queryer) (bool, error) {
+	if err := beforeCreate(ctx, r); err != nil {
+		return false, err
+	}
+	result, err := db.ExecContext(ctx,
+		`INSERT INTO user_account_uuid_address (uuid, state, city, line1, line2) VALUES ($1, $2, $3, $4, $5) ON CONFLICT DO NOTHING`,
+		&r.UUID, &r.State, &r.City, &r.Line1, &r.Line2)
+	if err != nil {
+		return false, errors.WithStack(translateError("user_account_uuid_address", err))
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, errors.WithStack(translateError("user_account_uuid_address", err))
+	}
+	if rowsAffected == 0 {
+		return false, nil
+	}
+	return true, afterCreate(ctx, r)
+}
+
+// UpdateContext updates the UserAccountUUIDAddress in the database by the primary key.
+// *NotFoundError is returned if the row is not found.
+func (r *UserAccountUUIDAddress) UpdateContext(ctx context.Context, db Queryer) error {
+	if err := beforeUpdate(ctx, r); err != nil {
+		return err
+	}
+	result, err := db.ExecContext(ctx,
+		`UPDATE user_account_uuid_address SET state = $1, city = $2, line1 = $3, line2 = $4 WHERE uuid = $5`,
+		&r.State, &r.City, &r.Line1, &r.Line2, &r.UUID)
+	if err != nil {
+		return errors.WithStack(translateError("user_account_uuid_address", err))
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return errors.WithStack(translateError("user_account_uuid_address", err))
+	}
+	if n == 0 {
+		err = sql.ErrNoRows
+		return errors.WithStack(translateError("user_account_uuid_address", err))
+	}
+	return afterUpdate(ctx, r)
+}
+
+// DeleteContext deletes the UserAccountUUIDAddress from the database by the primary key.
+// *NotFoundError is returned if the row is not found.
+func (r *UserAccountUUIDAddress) DeleteContext(ctx context.Context, db Queryer) error {
+	if err := beforeDelete(ctx, r); err != nil {
+		return err
+	}
+	result, err := db.ExecContext(ctx,
+		`DELETE FROM user_account_uuid_address WHERE uuid = $1`,
+		&r.UUID)
+	if err != nil {
+		return errors.WithStack(translateError("user_account_uuid_address", err))
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return errors.WithStack(translateError("user_account_uuid_address", err))
+	}
+	if n == 0 {
+		err = sql.ErrNoRows
+		return errors.WithStack(translateError("user_account_uuid_address", err))
+	}
+	return afterDelete(ctx, r)
+}
+
+// ReloadContext selects the UserAccountUUIDAddress again by its primary key, and overwrites the fields with the
+// values in the database, e.g. after a trigger or a stored procedure changed the row.
+// *NotFoundError is returned if the row is not found.
+func (r *UserAccountUUIDAddress) ReloadContext(ctx context.Context, db Queryer) error {
+	err := db.QueryRowContext(ctx,
+		`SELECT uuid, state, city, line1, line2 FROM user_account_uuid_address WHERE uuid = $1`,
+		r.UUID).Scan(&r.UUID, &r.State, &r.City, &r.Line1, &r.Line2)
+	if err != nil {
+		return errors.WithStack(translateError("user_account_uuid_address", err))
+	}
+	return nil
+}
+
+// GetUserAccountUUIDAddressByPkContext select the UserAccountUUIDAddress from the database.
+func GetUserAccountUUIDAddressByPkContext(ctx context.Context, db Queryer, pk0 string) (*UserAccountUUIDAddress, error) {
+	var r UserAccountUUIDAddress
+	err := db.QueryRowContext(ctx,
+		`SELECT uuid, state, city, line1, line2 FROM user_account_uuid_address WHERE uuid = $1`,
+		pk0).Scan(&r.UUID, &r.State, &r.City, &r.Line1, &r.Line2)
+	if err != nil {
+		return nil, errors.WithStack(translateError("user_account_uuid_address", err))
+	}
+	return &r, nil
+}
+
+// GetUserAccountUUIDAddressByPkForUpdateContext selects the UserAccountUUIDAddress from the database with FOR UPDATE,
+// which locks the row until the end of the transaction. opt decides what happens if the row is locked
+// by another transaction: LockWait waits for it, LockNoWait fails with SQLSTATE 55P03, and
+// LockSkipLocked returns *NotFoundError.
+func GetUserAccountUUIDAddressByPkForUpdateContext(ctx context.Context, db Queryer, pk0 string, opt LockOption) (*UserAccountUUIDAddress, error) {
+	var r UserAccountUUIDAddress
+	err := db.QueryRowContext(ctx,
+		`SELECT uuid, state, city, line1, line2 FROM user_account_uuid_address WHERE uuid = $1 FOR UPDATE`+opt.sql(),
+		pk0).Scan(&r.UUID, &r.State, &r.City, &r.Line1, &r.Line2)
+	if err != nil {
+		return nil, errors.WithStack(translateError("user_account_uuid_address", err))
+	}
+	return &r, nil
+}
+
+// GetUserAccountUUIDAddressByPkForShareContext selects the UserAccountUUIDAddress from the database with FOR SHARE,
+// which locks the row until the end of the transaction. opt decides what happens if the row is locked
+// by another transaction: LockWait waits for it, LockNoWait fails with SQLSTATE 55P03, and
+// LockSkipLocked returns *NotFoundError.
+func GetUserAccountUUIDAddressByPkForShareContext(ctx context.Context, db Queryer, pk0 string, opt LockOption) (*UserAccountUUIDAddress, error) {
+	var r UserAccountUUIDAddress
+	err := db.QueryRowContext(ctx,
+		`SELECT uuid, state, city, line1, line2 FROM user_account_uuid_address WHERE uuid = $1 FOR SHARE`+opt.sql(),
+		pk0).Scan(&r.UUID, &r.State, &r.City, &r.Line1, &r.Line2)
+	if err != nil {
+		return nil, errors.WithStack(translateError("user_account_uuid_address", err))
+	}
+	return &r, nil
+}
+
+// ExistsUserAccountUUIDAddressByPkContext checks if the UserAccountUUIDAddress exists in the database.
+func ExistsUserAccountUUIDAddressByPkContext(ctx context.Context, db Queryer, pk0 string) (bool, error) {
+	var exists bool
+	err := db.QueryRowContext(ctx,
+		`SELECT EXISTS (SELECT 1 FROM user_account_uuid_address WHERE uuid = $1)`,
+		pk0).Scan(&exists)
+	if err != nil {
+		return false, errors.WithStack(translateError("user_account_uuid_address", err))
+	}
+	return exists, nil
+}
+
+// GetUserAccountUUIDAddressByPksContext select the UserAccountUUIDAddresss of the given primary keys from the database.
+// The result is ordered by the primary key, and the keys which do not exist are skipped.
+func GetUserAccountUUIDAddressByPksContext(ctx context.Context, db Queryer, pks []string) ([]*UserAccountUUIDAddress, error) {
+	rows, err := db.QueryContext(ctx,
+		`SELECT uuid, state, city, line1, line2 FROM user_account_uuid_address WHERE uuid = ANY($1) ORDER BY uuid`,
+		pq.Array(pks))
+	if err != nil {
+		return nil, errors.WithStack(translateError("user_account_uuid_address", err))
+	}
+	defer rows.Close()
+	var rs []*UserAccountUUIDAddress
+	for rows.Next() {
+		var r UserAccountUUIDAddress
+		if err := rows.Scan(&r.UUID, &r.State, &r.City, &r.Line1, &r.Line2); err != nil {
+			return nil, errors.WithStack(translateError("user_account_uuid_address", err))
+		}
+		rs = append(rs, &r)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.WithStack(translateError("user_account_uuid_address", err))
+	}
+	return rs, nil
+}
+
+// UserAccountUUIDAddressListOptions is the options of ListUserAccountUUIDAddress.
+type UserAccountUUIDAddressListOptions struct {
+	// Limit is the maximum number of rows to return. Zero means no limit.
+	Limit int
+	// After is the primary key of the last row of the previous page.
+	// Only the rows after it are returned when it is not nil.
+	After *string
+}
+
+// ListUserAccountUUIDAddress lists the UserAccountUUIDAddresss ordered by the primary key.
+// Pass the primary key of the last row as opts.After to get the next page.
+func ListUserAccountUUIDAddress(ctx context.Context, db Queryer, opts UserAccountUUIDAddressListOptions) ([]*UserAccountUUIDAddress, error) {
+	q := `SELECT uuid, state, city, line1, line2 FROM user_account_uuid_address`
+	var args []interface{}
+	if opts.After != nil {
+		q += ` WHERE uuid > $1`
+		args = append(args, *opts.After)
+	}
+	q += ` ORDER BY uuid`
+	if opts.Limit > 0 {
+		args = append(args, opts.Limit)
+		q += fmt.Sprintf(` LIMIT $%d`, len(args))
+	}
+	rows, err := db.QueryContext(ctx, q, args...)
+	if err != nil {
+		return nil, errors.WithStack(translateError("user_account_uuid_address", err))
+	}
+	defer rows.Close()
+	var rs []*UserAccountUUIDAddress
+	for rows.Next() {
+		var r UserAccountUUIDAddress
+		if err := rows.Scan(&r.UUID, &r.State, &r.City, &r.Line1, &r.Line2); err != nil {
+			return nil, errors.WithStack(translateError("user_account_uuid_address", err))
+		}
+		rs = append(rs, &r)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.WithStack(translateError("user_account_uuid_address", err))
+	}
+	return rs, nil
+}
+
+// UserAccountUUIDAddressFilter builds a parameterized WHERE clause of user_account_uuid_address.
+// The predicates are combined with AND.
+type UserAccountUUIDAddressFilter struct {
+	conds []string
+	args  []interface{}
+}
+
+// NewUserAccountUUIDAddressFilter creates an empty UserAccountUUIDAddressFilter, which matches every row.
+func NewUserAccountUUIDAddressFilter() *UserAccountUUIDAddressFilter {
+	return &UserAccountUUIDAddressFilter{}
+}
+
+func (f *UserAccountUUIDAddressFilter) add(cond string, v interface{}) *UserAccountUUIDAddressFilter {
+	f.args = append(f.args, v)
+	f.conds = append(f.conds, fmt.Sprintf(cond, len(f.args)))
+	return f
+}
+
+// Where returns the WHERE clause and its arguments. It returns an empty clause
+// if the filter is nil or has no predicate.
+func (f *UserAccountUUIDAddressFilter) Where() (string, []interface{}) {
+	if f == nil || len(f.conds) == 0 {
+		return "", nil
+	}
+	return " WHERE " + strings.Join(f.conds, " AND "), f.args
+}
+
+// UUIDEq filters the rows whose uuid equals to v.
+func (f *UserAccountUUIDAddressFilter) UUIDEq(v string) *UserAccountUUIDAddressFilter {
+	return f.add("uuid = $%d", v)
+}
+
+// UUIDIn filters the rows whose uuid is one of vs.
+func (f *UserAccountUUIDAddressFilter) UUIDIn(vs ...string) *UserAccountUUIDAddressFilter {
+	return f.add("uuid = ANY($%d)", pq.Array(vs))
+}
+
+// StateEq filters the rows whose state equals to v.
+func (f *UserAccountUUIDAddressFilter) StateEq(v string) *UserAccountUUIDAddressFilter {
+	return f.add("state = $%d", v)
+}
+
+// StateIn filters the rows whose state is one of vs.
+func (f *UserAccountUUIDAddressFilter) StateIn(vs ...string) *UserAccountUUIDAddressFilter {
+	return f.add("state = ANY($%d)", pq.Array(vs))
+}
+
+// StateLt filters the rows whose state is less than v.
+func (f *UserAccountUUIDAddressFilter) StateLt(v string) *UserAccountUUIDAddressFilter {
+	return f.add("state < $%d", v)
+}
+
+// StateGt filters the rows whose state is greater than v.
+func (f *UserAccountUUIDAddressFilter) StateGt(v string) *UserAccountUUIDAddressFilter {
+	return f.add("state > $%d", v)
+}
+
+// CityEq filters the rows whose city equals to v.
+func (f *UserAccountUUIDAddressFilter) CityEq(v string) *UserAccountUUIDAddressFilter {
+	return f.add("city = $%d", v)
+}
+
+// CityIn filters the rows whose city is one of vs.
+func (f *UserAccountUUIDAddressFilter) CityIn(vs ...string) *UserAccountUUIDAddressFilter {
+	return f.add("city = ANY($%d)", pq.Array(vs))
+}
+
+// CityLt filters the rows whose city is less than v.
+func (f *UserAccountUUIDAddressFilter) CityLt(v string) *UserAccountUUIDAddressFilter {
+	return f.add("city < $%d", v)
+}
+
+// CityGt filters the rows whose city is greater than v.
+func (f *UserAccountUUIDAddressFilter) CityGt(v string) *UserAccountUUIDAddressFilter {
+	return f.add("city > $%d", v)
+}
+
+// Line1Eq filters the rows whose line1 equals to v.
+func (f *UserAccountUUIDAddressFilter) Line1Eq(v string) *UserAccountUUIDAddressFilter {
+	return f.add("line1 = $%d", v)
+}
+
+// Line1In filters the rows whose line1 is one of vs.
+func (f *UserAccountUUIDAddressFilter) Line1In(vs ...string) *UserAccountUUIDAddressFilter {
+	return f.add("line1 = ANY($%d)", pq.Array(vs))
+}
+
+// Line1Lt filters the rows whose line1 is less than v.
+func (f *UserAccountUUIDAddressFilter) Line1Lt(v string) *UserAccountUUIDAddressFilter {
+	return f.add("line1 < $%d", v)
+}
+
+// Line1Gt filters the rows whose line1 is greater than v.
+func (f *UserAccountUUIDAddressFilter) Line1Gt(v string) *UserAccountUUIDAddressFilter {
+	return f.add("line1 > $%d", v)
+}
+
+// Line2Eq filters the rows whose line2 equals to v.
+func (f *UserAccountUUIDAddressFilter) Line2Eq(v string) *UserAccountUUIDAddressFilter {
+	return f.add("line2 = $%d", v)
+}
+
+// Line2In filters the rows whose line2 is one of vs.
+func (f *UserAccountUUIDAddressFilter) Line2In(vs ...string) *UserAccountUUIDAddressFilter {
+	return f.add("line2 = ANY($%d)", pq.Array(vs))
+}
+
+// Line2Lt filters the rows whose line2 is less than v.
+func (f *UserAccountUUIDAddressFilter) Line2Lt(v string) *UserAccountUUIDAddressFilter {
+	return f.add("line2 < $%d", v)
+}
+
+// Line2Gt filters the rows whose line2 is greater than v.
+func (f *UserAccountUUIDAddressFilter) Line2Gt(v string) *UserAccountUUIDAddressFilter {
+	return f.add("line2 > $%d", v)
+}
+
+// FindUserAccountUUIDAddress selects the UserAccountUUIDAddresss matching the filter from the database.
+// The result is ordered by the primary key.
+func FindUserAccountUUIDAddress(ctx context.Context, db Queryer, f *UserAccountUUIDAddressFilter) ([]*UserAccountUUIDAddress, error) {
+	where, args := f.Where()
+	rows, err := db.QueryContext(ctx,
+		`SELECT uuid, state, city, line1, line2 FROM user_account_uuid_address`+where+` ORDER BY uuid`,
+		args...)
+	if err != nil {
+		return nil, errors.WithStack(translateError("user_account_uuid_address", err))
+	}
+	defer rows.Close()
+	var rs []*UserAccountUUIDAddress
+	for rows.Next() {
+		var r UserAccountUUIDAddress
+		if err := rows.Scan(&r.UUID, &r.State, &r.City, &r.Line1, &r.Line2); err != nil {
+			return nil, errors.WithStack(translateError("user_account_uuid_address", err))
+		}
+		rs = append(rs, &r)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.WithStack(translateError("user_account_uuid_address", err))
+	}
+	return rs, nil
+}
+
+// CountUserAccountUUIDAddress counts the UserAccountUUIDAddresss matching the filter in the database.
+// Pass nil as the filter to count every row.
+func CountUserAccountUUIDAddress(ctx context.Context, db Queryer, f *UserAccountUUIDAddressFilter) (int64, error) {
+	where, args := f.Where()
+	var n int64
+	err := db.QueryRowContext(ctx,
+		`SELECT count(*) FROM user_account_uuid_address`+where,
+		args...).Scan(&n)
+	if err != nil {
+		return 0, errors.WithStack(translateError("user_account_uuid_address", err))
+	}
+	return n, nil
+}
+
+// DeleteUserAccountUUIDAddressWhere deletes the UserAccountUUIDAddresss matching the filter from the database,
+// and returns the number of the deleted rows. A filter without predicate is rejected
+// to avoid deleting every row by mistake.
+func DeleteUserAccountUUIDAddressWhere(ctx context.Context, db Queryer, f *UserAccountUUIDAddressFilter) (int64, error) {
+	where, args := f.Where()
+	if where == "" {
+		return 0, errors.New("refusing to delete every row of user_account_uuid_address with an empty filter")
+	}
+	result, err := db.ExecContext(ctx,
+		`DELETE FROM user_account_uuid_address`+where,
+		args...)
+	if err != nil {
+		return 0, errors.WithStack(translateError("user_account_uuid_address", err))
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.WithStack(translateError("user_account_uuid_address", err))
+	}
+	return n, nil
+}
+
+var (
+	// ErrNotFound is matched by errors.Is when no row is found.
+	ErrNotFound = errors.New("not found")
+	// ErrUniqueViolation is matched by errors.Is on a unique constraint violation.
+	ErrUniqueViolation = errors.New("unique violation")
+	// ErrForeignKeyViolation is matched by errors.Is on a foreign key constraint violation.
+	ErrForeignKeyViolation = errors.New("foreign key violation")
+	// ErrNotNullViolation is matched by errors.Is on a not-null constraint violation.
+	ErrNotNullViolation = errors.New("not null violation")
+	// ErrCheckViolation is matched by errors.Is on a check constraint violation.
+	ErrCheckViolation = errors.New("check violation")
+	// ErrExclusionViolation is matched by errors.Is on an exclusion constraint violation.
+	ErrExclusionViolation = errors.New("exclusion violation")
+	// ErrValidation is matched by errors.Is when Validate fails.
+	ErrValidation = errors.New("validation failed")
+)
+
+// NotFoundError is returned when no row is found in the table.
+type NotFoundError struct {
+	Table string
+	Err   error
+}
+
+func (e *NotFoundError) Error() string { return e.Err.Error() }
+
+// Is reports whether the target is ErrNotFound.
+func (e *NotFoundError) Is(target error) bool { return target == ErrNotFound }
+
+// Unwrap returns the original error, which is sql.ErrNoRows.
+func (e *NotFoundError) Unwrap() error { return e.Err }
+
+// Cause returns the original error for errors.Cause of github.com/pkg/errors.
+func (e *NotFoundError) Cause() error { return e.Err }
+
+// ConstraintError is returned when a statement violates a constraint.
+// Kind is one of ErrUniqueViolation, ErrForeignKeyViolation, ErrNotNullViolation,
+// ErrCheckViolation and ErrExclusionViolation.
+type ConstraintError struct {
+	Kind       error
+	Table      string
+	Column     string
+	Constraint string
+	Err        error
+}
+
+func (e *ConstraintError) Error() string { return e.Err.Error() }
+
+// Is reports whether the target is the Kind of the violation.
+func (e *ConstraintError) Is(target error) bool { return target == e.Kind }
+
+// Unwrap returns the original error, which is *pq.Error.
+func (e *ConstraintError) Unwrap() error { return e.Err }
+
+// Cause returns the original error for errors.Cause of github.com/pkg/errors.
+func (e *ConstraintError) Cause() error { return e.Err }
+
+// FieldError is a field which fails Validate. Constraint is the name of the
+// check constraint, or empty for NOT NULL and the length of the column.
+type FieldError struct {
+	Field      string
+	Column     string
+	Constraint string
+	Message    string
+}
+
+// ValidationError is returned by Validate, listing every failing field.
+type ValidationError struct {
+	Table  string
+	Fields []FieldError
+}
+
+func (e *ValidationError) Error() string {
+	msgs := make([]string, 0, len(e.Fields))
+	for _, f := range e.Fields {
+		msgs = append(msgs, f.Column+" "+f.Message)
+	}
+	return "invalid " + e.Table + ": " + strings.Join(msgs, ", ")
+}
+
+// Is reports whether the target is ErrValidation.
+func (e *ValidationError) Is(target error) bool { return target == ErrValidation }
+
+// LockOption decides what the row lock of GetXByPkForUpdateContext and
+// GetXByPkForShareContext does when the row is locked by another transaction.
+type LockOption int
+
+const (
+	// LockWait waits for the other transaction to end.
+	LockWait LockOption = iota
+	// LockNoWait fails immediately with SQLSTATE 55P03, lock_not_available.
+	LockNoWait
+	// LockSkipLocked skips the row, which is reported as not found.
+	LockSkipLocked
+)
+
+func (o LockOption) sql() string {
+	switch o {
+	case LockNoWait:
+		return " NOWAIT"
+	case LockSkipLocked:
+		return " SKIP LOCKED"
+	}
+	return ""
+}
+
+// BeforeCreateHook is implemented by the structs which run logic before they are inserted.
+type BeforeCreateHook interface {
+	BeforeCreate(ctx context.Context) error
+}
+
+// AfterCreateHook is implemented by the structs which run logic after they are inserted.
+type AfterCreateHook interface {
+	AfterCreate(ctx context.Context) error
+}
+
+// BeforeUpdateHook is implemented by the structs which run logic before they are updated.
+type BeforeUpdateHook interface {
+	BeforeUpdate(ctx context.Context) error
+}
+
+// AfterUpdateHook is implemented by the structs which run logic after they are updated.
+type AfterUpdateHook interface {
+	AfterUpdate(ctx context.Context) error
+}
+
+// BeforeDeleteHook is implemented by the structs which run logic before they are deleted.
+type BeforeDeleteHook interface {
+	BeforeDelete(ctx context.Context) error
+}
+
+// AfterDeleteHook is implemented by the structs which run logic after they are deleted.
+type AfterDeleteHook interface {
+	AfterDelete(ctx context.Context) error
+}
+
+func beforeCreate(ctx context.Context, v interface{}) error {
+	if h, ok := v.(BeforeCreateHook); ok {
+		return h.BeforeCreate(ctx)
+	}
+	return nil
+}
+
+func afterCreate(ctx context.Context, v interface{}) error {
+	if h, ok := v.(AfterCreateHook); ok {
+		return h.AfterCreate(ctx)
+	}
+	return nil
+}
+
+func beforeUpdate(ctx context.Context, v interface{}) error {
+	if h, ok := v.(BeforeUpdateHook); ok {
+		return h.BeforeUpdate(ctx)
+	}
+	return nil
+}
+
+func afterUpdate(ctx context.Context, v interface{}) error {
+	if h, ok := v.(AfterUpdateHook); ok {
+		return h.AfterUpdate(ctx)
+	}
+	return nil
+}
+
+func beforeDelete(ctx context.Context, v interface{}) error {
+	if h, ok := v.(BeforeDeleteHook); ok {
+		return h.BeforeDelete(ctx)
+	}
+	return nil
+}
+
+func afterDelete(ctx context.Context, v interface{}) error {
+	if h, ok := v.(AfterDeleteHook); ok {
+		return h.AfterDelete(ctx)
+	}
+	return nil
+}
+
+// translateError converts sql.ErrNoRows and the SQLSTATE of the constraint
+// violations into NotFoundError and ConstraintError. The other errors are
+// returned as they are.
+func translateError(table string, err error) error {
+	if err == sql.ErrNoRows {
+		return &NotFoundError{Table: table, Err: err}
+	}
+	pqErr, ok := err.(*pq.Error)
+	if !ok {
+		return err
+	}
+	var kind error
+	switch pqErr.Code {
+	case "23505":
+		kind = ErrUniqueViolation
+	case "23503":
+		kind = ErrForeignKeyViolation
+	case "23502":
+		kind = ErrNotNullViolation
+	case "23514":
+		kind = ErrCheckViolation
+	case "23P01":
+		kind = ErrExclusionViolation
+	default:
+		return err
+	}
+	return &ConstraintError{
+		Kind:       kind,
+		Table:      pqErr.Table,
+		Column:     pqErr.Column,
+		Constraint: pqErr.Constraint,
+		Err:        err,
+	}
+}
+
+// Interval represents a PostgreSQL interval. The months and days are kept apart
+// from the time, since their length in time depends on the date they are added to.
+// Scan parses the output of the default IntervalStyle, postgres.
+type Interval struct {
+	Months       int32
+	Days         int32
+	Microseconds int64
+}
+
+// Duration returns the interval as time.Duration, assuming 30 days per month
+// and 24 hours per day as justify_interval does.
+func (i Interval) Duration() time.Duration {
+	return time.Duration(i.Months)*30*24*time.Hour +
+		time.Duration(i.Days)*24*time.Hour +
+		time.Duration(i.Microseconds)*time.Microsecond
+}
+
+// String returns the interval in the input format of PostgreSQL.
+func (i Interval) String() string {
+	us := i.Microseconds
+	sign := ""
+	if us < 0 {
+		sign = "-"
+		us = -us
+	}
+	return fmt.Sprintf("%d mons %d days %s%02d:%02d:%02d.%06d", i.Months, i.Days,
+		sign, us/3600000000, us/60000000%60, us/1000000%60, us%1000000)
+}
+
+// Value implements driver.Valuer.
+func (i Interval) Value() (driver.Value, error) {
+	return i.String(), nil
+}
+
+// Scan implements sql.Scanner.
+func (i *Interval) Scan(src interface{}) error {
+	var s string
+	switch v := src.(type) {
+	case string:
+		s = v
+	case []byte:
+		s = string(v)
+	default:
+		return fmt.Errorf("cannot scan %T into Interval", src)
+	}
+	var iv Interval
+	fields := strings.Fields(s)
+	for n := 0; n < len(fields); n++ {
+		if strings.Contains(fields[n], ":") {
+			us, err := parseIntervalTime(fields[n])
+			if err != nil {
+				return fmt.Errorf("invalid interval %q: %w", s, err)
+			}
+			iv.Microseconds = us
+			continue
+		}
+		if n+1 == len(fields) {
+			return fmt.Errorf("invalid interval %q", s)
+		}
+		v, err := strconv.ParseInt(fields[n], 10, 32)
+		if err != nil {
+			return fmt.Errorf("invalid interval %q: %w", s, err)
+		}
+		n++
+		switch strings.TrimSuffix(fields[n], "s") {
+		case "year":
+			iv.Months += int32(v) * 12
+		case "mon":
+			iv.Months += int32(v)
+		case "day":
+			iv.Days += int32(v)
+		default:
+			return fmt.Errorf("invalid interval %q", s)
+		}
+	}
+	*i = iv
+	return nil
+}
+
+// parseIntervalTime parses the time part of an interval, e.g. -04:05:06.789,
+// into microseconds. The hours may exceed 24.
+func parseIntervalTime(s string) (int64, error) {
+	neg := strings.HasPrefix(s, "-")
+	hms := strings.Split(strings.TrimLeft(s, "+-"), ":")
+	if len(hms) != 3 {
+		return 0, fmt.Errorf("invalid time %q", s)
+	}
+	sec, frac, _ := strings.Cut(hms[2], ".")
+	if len(frac) > 6 {
+		return 0, fmt.Errorf("invalid time %q", s)
+	}
+	units := []int64{1, 60, 60, 1000000}
+	var us int64
+	for n, p := range []string{hms[0], hms[1], sec, frac + "000000"[len(frac):]} {
+		v, err := strconv.ParseInt(p, 10, 64)
+		if err != nil {
+			return 0, err
+		}
+		us = us*units[n] + v
+	}
+	if neg {
+		us = -us
+	}
+	return us, nil
+}
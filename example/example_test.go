@@ -1,11 +1,17 @@
 package dgwexample
 
 import (
+	"bytes"
 	"context"
 	"database/sql"
+	"errors"
 	"os"
+	"strings"
 	"testing"
 	"time"
+
+	"github.com/lib/pq"
+	pkgerrors "github.com/pkg/errors"
 )
 
 func testPgSetup(t *testing.T) (*sql.DB, func()) {
@@ -55,3 +61,276 @@ func TestT1(t *testing.T) {
 	}
 	t.Logf("%+v", target)
 }
+
+func TestT1UpdateDelete(t *testing.T) {
+	conn, cleanup := testPgSetup(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	now := time.Now()
+	t1 := T1{
+		I:          200,
+		JSONData:   []byte("{}"),
+		XMLData:    []byte("<test/>"),
+		Str:        "before",
+		TWithTz:    now,
+		TWithoutTz: now,
+	}
+	if err := t1.CreateContext(ctx, conn); err != nil {
+		t.Fatal(err)
+	}
+	t1.Str = "after"
+	if err := t1.UpdateContext(ctx, conn); err != nil {
+		t.Fatal(err)
+	}
+	target, err := GetT1ByPkContext(ctx, conn, t1.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if target.Str != "after" {
+		t.Errorf("want after, got %s", target.Str)
+	}
+	target.Str = "stale"
+	if err := target.ReloadContext(ctx, conn); err != nil {
+		t.Fatal(err)
+	}
+	if target.Str != "after" {
+		t.Errorf("want after, got %s", target.Str)
+	}
+	if err := t1.DeleteContext(ctx, conn); err != nil {
+		t.Fatal(err)
+	}
+	if err := t1.DeleteContext(ctx, conn); !errors.Is(err, ErrNotFound) {
+		t.Errorf("want ErrNotFound, got %v", err)
+	}
+	if err := t1.UpdateContext(ctx, conn); !errors.Is(err, ErrNotFound) {
+		t.Errorf("want ErrNotFound, got %v", err)
+	}
+	if err := t1.ReloadContext(ctx, conn); !errors.Is(err, ErrNotFound) {
+		t.Errorf("want ErrNotFound, got %v", err)
+	}
+}
+
+func TestUserAccountHooks(t *testing.T) {
+	conn, cleanup := testPgSetup(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	u := UserAccount{Email: " Foo@Example.COM ", LastName: "foo", FirstName: "bar"}
+	if err := u.CreateContext(ctx, conn); err != nil {
+		t.Fatal(err)
+	}
+	target, err := GetUserAccountByPkContext(ctx, conn, u.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if target.Email != "foo@example.com" {
+		t.Errorf("want foo@example.com, got %s", target.Email)
+	}
+
+	empty := UserAccount{Email: " ", LastName: "foo", FirstName: "bar"}
+	if err := empty.CreateContext(ctx, conn); err == nil {
+		t.Error("want the error of BeforeCreate")
+	}
+	if empty.ID != 0 {
+		t.Errorf("want not inserted, got %d", empty.ID)
+	}
+}
+
+func TestUserAccountForUpdate(t *testing.T) {
+	conn, cleanup := testPgSetup(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	u := UserAccount{Email: "lock@example.com", LastName: "foo", FirstName: "bar"}
+	if err := u.CreateContext(ctx, conn); err != nil {
+		t.Fatal(err)
+	}
+	tx1, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer tx1.Rollback()
+	if _, err := GetUserAccountByPkForUpdateContext(ctx, tx1, u.ID, LockWait); err != nil {
+		t.Fatal(err)
+	}
+	tx2, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer tx2.Rollback()
+	if _, err := GetUserAccountByPkForUpdateContext(ctx, tx2, u.ID, LockSkipLocked); !errors.Is(err, ErrNotFound) {
+		t.Errorf("want ErrNotFound, got %v", err)
+	}
+	var pqErr *pq.Error
+	if _, err := GetUserAccountByPkForShareContext(ctx, tx2, u.ID, LockNoWait); !errors.As(err, &pqErr) || pqErr.Code != "55P03" {
+		t.Errorf("want lock_not_available, got %v", err)
+	}
+}
+
+func TestT2GetByPks(t *testing.T) {
+	conn, cleanup := testPgSetup(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	now := time.Now()
+	t2 := T2{I: 1, Str: "test", TWithTz: now, TWithoutTz: now}
+	if err := t2.CreateContext(ctx, conn); err != nil {
+		t.Fatal(err)
+	}
+	pk := T2Pk{ID: t2.ID, I: t2.I}
+	rs, err := GetT2ByPksContext(ctx, conn, []T2Pk{pk, pk, {ID: t2.ID, I: 2}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rs) != 1 {
+		t.Errorf("want the row once for the repeated key, got %d", len(rs))
+	}
+}
+
+func TestTranslateError(t *testing.T) {
+	err := pkgerrors.WithStack(translateError("t1", sql.ErrNoRows))
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("want ErrNotFound, got %v", err)
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		t.Errorf("want sql.ErrNoRows, got %v", err)
+	}
+	if pkgerrors.Cause(err) != sql.ErrNoRows {
+		t.Errorf("want sql.ErrNoRows as the cause, got %v", pkgerrors.Cause(err))
+	}
+	var nf *NotFoundError
+	if !errors.As(err, &nf) || nf.Table != "t1" {
+		t.Errorf("want NotFoundError of t1, got %v", err)
+	}
+
+	pqErr := &pq.Error{Code: "23505", Table: "t1", Constraint: "t1_i_key"}
+	err = pkgerrors.WithStack(translateError("t1", pqErr))
+	if !errors.Is(err, ErrUniqueViolation) {
+		t.Errorf("want ErrUniqueViolation, got %v", err)
+	}
+	if errors.Is(err, ErrForeignKeyViolation) {
+		t.Errorf("want no ErrForeignKeyViolation, got %v", err)
+	}
+	var ce *ConstraintError
+	if !errors.As(err, &ce) || ce.Constraint != "t1_i_key" || ce.Table != "t1" {
+		t.Errorf("want ConstraintError of t1_i_key, got %v", err)
+	}
+	if pkgerrors.Cause(err) != pqErr {
+		t.Errorf("want *pq.Error as the cause, got %v", pkgerrors.Cause(err))
+	}
+
+	other := errors.New("other")
+	if translateError("t1", other) != other {
+		t.Errorf("want the other errors as they are")
+	}
+}
+
+func TestT4(t *testing.T) {
+	conn, cleanup := testPgSetup(t)
+	defer cleanup()
+
+	t4 := T4{
+		Bytes:        []byte{0x00, 0x01, 0xfe, 0xff},
+		Span:         Interval{Months: 14, Days: 3, Microseconds: -(4*3600+5*60+6)*1000000 - 789},
+		NullableSpan: &Interval{Days: -1, Microseconds: 100 * 3600 * 1000000},
+	}
+	if err := t4.CreateContext(context.Background(), conn); err != nil {
+		t.Fatal(err)
+	}
+	target, err := GetT4ByPkContext(context.Background(), conn, t4.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(target.Bytes, t4.Bytes) {
+		t.Errorf("want %v, got %v", t4.Bytes, target.Bytes)
+	}
+	if target.NullableBytes != nil {
+		t.Errorf("want nil, got %v", target.NullableBytes)
+	}
+	if target.Span != t4.Span {
+		t.Errorf("want %+v, got %+v", t4.Span, target.Span)
+	}
+	if target.NullableSpan == nil || *target.NullableSpan != *t4.NullableSpan {
+		t.Errorf("want %+v, got %+v", t4.NullableSpan, target.NullableSpan)
+	}
+}
+
+func TestInterval(t *testing.T) {
+	tests := []struct {
+		src  string
+		want Interval
+	}{
+		{"00:00:00", Interval{}},
+		{"1 day", Interval{Days: 1}},
+		{"1 year 2 mons 3 days 04:05:06.789", Interval{Months: 14, Days: 3, Microseconds: (4*3600+5*60+6)*1000000 + 789000}},
+		{"-1 years -2 mons +3 days -04:05:06", Interval{Months: -14, Days: 3, Microseconds: -(4*3600 + 5*60 + 6) * 1000000}},
+		{"-1 days +100:00:00.000001", Interval{Days: -1, Microseconds: 100*3600*1000000 + 1}},
+	}
+	for _, tt := range tests {
+		var iv Interval
+		if err := iv.Scan([]byte(tt.src)); err != nil {
+			t.Fatal(err)
+		}
+		if iv != tt.want {
+			t.Errorf("%s: want %+v, got %+v", tt.src, tt.want, iv)
+		}
+		v, err := iv.Value()
+		if err != nil {
+			t.Fatal(err)
+		}
+		var got Interval
+		if err := got.Scan(v); err != nil {
+			t.Fatal(err)
+		}
+		if got != iv {
+			t.Errorf("%s: want %+v after Value, got %+v", tt.src, iv, got)
+		}
+	}
+
+	for _, src := range []string{"1", "1 week", "1 day 04:05", "a day"} {
+		var iv Interval
+		if err := iv.Scan(src); err == nil {
+			t.Errorf("%s: want error", src)
+		}
+	}
+
+	if d := (Interval{Months: 1, Days: 1, Microseconds: 1}).Duration(); d != 31*24*time.Hour+time.Microsecond {
+		t.Errorf("want 31 days and 1 microsecond, got %s", d)
+	}
+}
+
+func TestT5Validate(t *testing.T) {
+	t5 := T5{Name: "name", Status: "active", Price: 1}
+	if err := t5.Validate(); err != nil {
+		t.Errorf("want no error, got %v", err)
+	}
+
+	t5 = T5{
+		Name:   "more than sixteen characters",
+		Status: "deleted",
+		Score:  sql.NullInt64{Int64: 101, Valid: true},
+	}
+	err := t5.Validate()
+	if !errors.Is(err, ErrValidation) {
+		t.Fatalf("want ErrValidation, got %v", err)
+	}
+	var ve *ValidationError
+	if !errors.As(err, &ve) {
+		t.Fatalf("want ValidationError, got %v", err)
+	}
+	var fields []string
+	for _, f := range ve.Fields {
+		fields = append(fields, f.Field+"/"+f.Constraint)
+	}
+	want := []string{"Name/", "Price/t5_price_check", "Score/t5_score_check", "Status/t5_status_check"}
+	if strings.Join(fields, ",") != strings.Join(want, ",") {
+		t.Errorf("want %v, got %v", want, fields)
+	}
+
+	// NULL passes the check constraints
+	t5 = T5{Name: "name", Status: "inactive", Price: 1, Score: sql.NullInt64{Int64: -1}}
+	if err := t5.Validate(); err != nil {
+		t.Errorf("want no error, got %v", err)
+	}
+}
@@ -1,4 +1,4 @@
 package dgwexample
 
-//go:generate dgw postgres://dgw_test@localhost/dgw_test?sslmode=disable --typemap=./typemap.toml --schema=public --package=dgwexample --output=customstruct.go --template=./custom.tmpl
+//go:generate dgw postgres://dgw_test@localhost/dgw_test?sslmode=disable --typemap=./typemap.toml --schema=public --package=dgwexample --output=customstruct.go --template=./custom.tmpl --no-helper
 //go:generate dgw postgres://dgw_test@localhost/dgw_test?sslmode=disable --typemap=./typemap.toml --schema=public --package=dgwexample --output=defaultstruct.go --no-interface
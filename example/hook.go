@@ -0,0 +1,21 @@
+package dgwexample
+
+import (
+	"context"
+	"errors"
+	"strings"
+)
+
+// BeforeCreate normalizes the email, and rejects the empty one.
+func (r *UserAccount) BeforeCreate(ctx context.Context) error {
+	r.Email = strings.ToLower(strings.TrimSpace(r.Email))
+	if r.Email == "" {
+		return errors.New("email is required")
+	}
+	return nil
+}
+
+// BeforeUpdate normalizes the email as BeforeCreate does.
+func (r *UserAccount) BeforeUpdate(ctx context.Context) error {
+	return r.BeforeCreate(ctx)
+}
@@ -2,6 +2,7 @@ package main
 
 import (
 	"fmt"
+	"strings"
 	"text/template"
 )
 
@@ -14,9 +15,116 @@ var tmplFuncMap = template.FuncMap{
 	"createSelectByPkFuncParams":         createSelectByPkFuncParams,
 	"createSelectByPkSQLParams":          createSelectByPkSQLParams,
 	"createSelectByPkScan":               createSelectByPkScan,
+	"createExistsByPkSQL":                createExistsByPkSQL,
+	"createSelectByPksSQL":               createSelectByPksSQL,
+	"createSelectByPksValues":            createSelectByPksValues,
+	"createSelectByPksArgs":              createSelectByPksArgs,
+	"createPkType":                       createPkType,
+	"createListSQL":                      createListSQL,
+	"createListAfterSQL":                 createListAfterSQL,
+	"createListAfterParams":              createListAfterParams,
+	"createOrderByPk":                    createOrderByPk,
+	"isComparableColumn":                 isComparableColumn,
+	"isOrderedColumn":                    isOrderedColumn,
+	"wrapError":                          wrapError,
+	"dbExec":                             dbExec,
+	"dbQuery":                            dbQuery,
+	"dbQueryRow":                         dbQueryRow,
+	"errNoRows":                          errNoRows,
+	"arrayParam":                         arrayParam,
+	"createCopyColumns":                  createCopyColumns,
+	"createCopyValues":                   createCopyValues,
+	"setCopyNow":                         setCopyNow,
+	"insertDefaultFields":                insertDefaultFields,
+	"hasAutoGenColumn":                   hasAutoGenColumn,
+	"updateFields":                       updateFields,
+	"createUpdateSQL":                    createUpdateSQL,
+	"createUpdateParams":                 createUpdateParams,
+	"createUpdateScan":                   createUpdateScan,
+	"createDeleteSQL":                    createDeleteSQL,
+	"createDeleteParams":                 createDeleteParams,
+	"createReloadParams":                 createReloadParams,
+	"tracksChanges":                      tracksChanges,
+	"changeFields":                       changeFields,
+	"isChangedField":                     isChangedField,
+	"cloneField":                         cloneField,
+	"createUpdateChangedWhere":           createUpdateChangedWhere,
+	"createDeleteScan":                   createDeleteScan,
+	"softDeleteCond":                     softDeleteCond,
+	"andSoftDeleteCond":                  andSoftDeleteCond,
+	"softDeleteVariants":                 softDeleteVariants,
+	"createInsertOverridingSQL":          createInsertOverridingSQL,
+	"createInsertOverridingParams":       createInsertOverridingParams,
+	"createInsertOverridingScan":         createInsertOverridingScan,
+	"createInsertColumnList":             createInsertColumnList,
+	"createInsertArgList":                createInsertArgList,
+	"createReturningColumnList":          createReturningColumnList,
+	"isZeroField":                        isZeroField,
+	"createValidations":                  createValidations,
+	"indexLookupFields":                  indexLookupFields,
+	"setNow":                             setNow,
+	"dbNowFields":                        dbNowFields,
+	"rowLocks":                           func() []rowLock { return rowLocks },
 }
 
-func createSelectByPkSQL(st *Struct) string {
+// rowLock is the locking clause of the variants of GetXByPkContext
+type rowLock struct {
+	Name string
+	SQL  string
+}
+
+var rowLocks = []rowLock{
+	{Name: "ForUpdate", SQL: "FOR UPDATE"},
+	{Name: "ForShare", SQL: "FOR SHARE"},
+}
+
+// incomparableTypes are the types which have no equality operator in PostgreSQL
+var incomparableTypes = []string{"json", "xml", "point", "line", "lseg", "box", "path", "polygon", "circle"}
+
+// orderedTypes are the types which are sensible to compare with "<" and ">"
+var orderedTypes = []string{
+	"smallint", "integer", "bigint", "smallserial", "serial", "bigserial",
+	"real", "double precision", "numeric", "money",
+	"character", "character varying", "text",
+	"date", "interval",
+	"time with time zone", "time without time zone",
+	"timestamp with time zone", "timestamp without time zone",
+}
+
+// softDeleteCond returns the condition excluding the soft-deleted rows, whose
+// column is qualified with the alias if any. It returns empty if the struct
+// has no soft delete column or includeDeleted is true.
+func softDeleteCond(st *Struct, alias string, includeDeleted ...bool) string {
+	if st.SoftDelete == nil || (len(includeDeleted) > 0 && includeDeleted[0]) {
+		return ""
+	}
+	if alias != "" {
+		alias += "."
+	}
+	return alias + st.SoftDelete.Column.Name + " IS NULL"
+}
+
+// andSoftDeleteCond returns softDeleteCond prefixed with " AND ", or empty.
+func andSoftDeleteCond(st *Struct, includeDeleted ...bool) string {
+	if cond := softDeleteCond(st, "", includeDeleted...); cond != "" {
+		return " AND " + cond
+	}
+	return ""
+}
+
+// softDeleteVariants returns the includeDeleted arguments of the variants of
+// the functions selecting by the primary key, which are generated with the
+// IncludeDeleted suffix for the structs having the soft delete column.
+func softDeleteVariants(st *Struct) []bool {
+	if st.SoftDelete == nil {
+		return []bool{false}
+	}
+	return []bool{false, true}
+}
+
+// createSelectByPkSQL returns the SELECT statement by the primary key, which
+// excludes the soft-deleted row unless includeDeleted is true.
+func createSelectByPkSQL(st *Struct, includeDeleted ...bool) string {
 	var sql string
 	var colNames []string
 	var pkNames []string
@@ -26,9 +134,23 @@ func createSelectByPkSQL(st *Struct) string {
 		}
 		colNames = append(colNames, c.Name)
 	}
-	sql = "SELECT " + flatten(colNames, ", ") + " FROM " + st.Table.Name + " WHERE "
+	sql = "SELECT " + flatten(colNames, ", ") + " FROM " + st.Table.Name + " WHERE " + pkCondition(pkNames) +
+		andSoftDeleteCond(st, includeDeleted...)
+	return sql
+}
+
+// pkCondition returns the condition matching the primary key columns with
+// the placeholders, e.g. "id = $1 AND i = $2".
+func pkCondition(pkNames []string) string {
+	return pkConditionFrom(pkNames, 1)
+}
+
+// pkConditionFrom returns the condition of pkCondition whose placeholders
+// start from n.
+func pkConditionFrom(pkNames []string, n int) string {
+	var sql string
 	for i, c := range pkNames {
-		placeHolder := i + 1
+		placeHolder := i + n
 		if i == 0 {
 			sql = sql + c + fmt.Sprintf(" = $%d", placeHolder)
 		} else {
@@ -38,6 +160,15 @@ func createSelectByPkSQL(st *Struct) string {
 	return sql
 }
 
+func createExistsByPkSQL(st *Struct, includeDeleted ...bool) string {
+	var pkNames []string
+	for _, c := range st.Table.PrimaryKeys {
+		pkNames = append(pkNames, c.Name)
+	}
+	return "SELECT EXISTS (SELECT 1 FROM " + st.Table.Name + " WHERE " + pkCondition(pkNames) +
+		andSoftDeleteCond(st, includeDeleted...) + ")"
+}
+
 func createSelectByPkScan(st *Struct) string {
 	var s []string
 	for _, f := range st.Fields {
@@ -66,25 +197,386 @@ func createSelectByPkFuncParams(st *Struct) string {
 	return flatten(fs, ", ")
 }
 
-func createInsertScan(st *Struct) string {
+// createPkType returns the Go type of the primary key. A table with a
+// composite primary key uses the generated <Struct>Pk struct.
+func createPkType(st *Struct) string {
+	if len(st.Table.PrimaryKeys) > 1 {
+		return st.Name + "Pk"
+	}
+	for _, f := range st.Fields {
+		if f.Column.IsPrimaryKey {
+			return f.Type
+		}
+	}
+	return ""
+}
+
+// createSelectByPksSQL returns the query selecting rows by a list of primary
+// keys. A single column key is passed as an array with "= ANY($1)", while a
+// composite key is joined with a VALUES list which is left as "%s" to be
+// filled with the placeholders built by createSelectByPksValues.
+func createSelectByPksSQL(st *Struct, includeDeleted ...bool) string {
+	var pkNames []string
+	for _, c := range st.Table.PrimaryKeys {
+		pkNames = append(pkNames, c.Name)
+	}
+	if len(pkNames) == 1 {
+		var colNames []string
+		for _, c := range st.Table.Columns {
+			colNames = append(colNames, c.Name)
+		}
+		return "SELECT " + flatten(colNames, ", ") + " FROM " + st.Table.Name +
+			" WHERE " + pkNames[0] + " = ANY($1)" + andSoftDeleteCond(st, includeDeleted...) + " ORDER BY " + pkNames[0]
+	}
+
+	var colNames []string
+	for _, c := range st.Table.Columns {
+		colNames = append(colNames, c.Name)
+	}
+	// IN rather than JOIN, so that the duplicate keys select the row once as
+	// = ANY does for the single column key
+	return "SELECT " + flatten(colNames, ", ") + " FROM " + st.Table.Name +
+		" WHERE (" + flatten(pkNames, ", ") + ") IN (VALUES %s)" + andSoftDeleteCond(st, includeDeleted...) +
+		" ORDER BY " + flatten(pkNames, ", ")
+}
+
+// createSelectByPksValues returns the fmt.Sprintf arguments building a row of
+// the VALUES list from the args appended so far.
+func createSelectByPksValues(st *Struct) string {
+	var phs, idx []string
+	n := len(st.Table.PrimaryKeys)
+	for i, c := range st.Table.PrimaryKeys {
+		phs = append(phs, "$%d::"+c.DataType)
+		if i == n-1 {
+			idx = append(idx, "len(args)")
+		} else {
+			idx = append(idx, fmt.Sprintf("len(args)-%d", n-1-i))
+		}
+	}
+	return `"(` + flatten(phs, ", ") + `)", ` + flatten(idx, ", ")
+}
+
+// createSelectByPksArgs returns the fields of the composite primary key pk.
+func createSelectByPksArgs(st *Struct) string {
 	var fs []string
 	for _, f := range st.Fields {
-		if f.Column.IsPrimaryKey && st.Table.AutoGenPk {
-			fs = append(fs, "&r."+f.Name)
+		if f.Column.IsPrimaryKey {
+			fs = append(fs, "pk."+f.Name)
 		}
 	}
 	return flatten(fs, ", ")
 }
 
-func createInsertParams(st *Struct) string {
+// createListSQL returns the query listing all rows without the keyset
+// condition and the ordering, which are appended by the generated code.
+func createListSQL(st *Struct) string {
+	var colNames []string
+	for _, c := range st.Table.Columns {
+		colNames = append(colNames, c.Name)
+	}
+	return "SELECT " + flatten(colNames, ", ") + " FROM " + st.Table.Name
+}
+
+// createListAfterSQL returns the keyset condition selecting the rows after
+// the given primary key, e.g. "(id, i) > ($1, $2)".
+func createListAfterSQL(st *Struct) string {
+	var pkNames []string
+	for _, c := range st.Table.PrimaryKeys {
+		pkNames = append(pkNames, c.Name)
+	}
+	if len(pkNames) == 1 {
+		return pkNames[0] + " > $1"
+	}
+	return "(" + flatten(pkNames, ", ") + ") > (" + placeholders(pkNames) + ")"
+}
+
+// createListAfterParams returns the arguments of the keyset condition.
+func createListAfterParams(st *Struct) string {
+	if len(st.Table.PrimaryKeys) == 1 {
+		return "*opts.After"
+	}
 	var fs []string
 	for _, f := range st.Fields {
-		if f.Column.IsPrimaryKey && st.Table.AutoGenPk {
-			continue
-		} else {
-			fs = append(fs, "&r."+f.Name)
+		if f.Column.IsPrimaryKey {
+			fs = append(fs, "opts.After."+f.Name)
+		}
+	}
+	return flatten(fs, ", ")
+}
+
+// createOrderByPk returns the primary key columns used as ORDER BY.
+func createOrderByPk(st *Struct) string {
+	var pkNames []string
+	for _, c := range st.Table.PrimaryKeys {
+		pkNames = append(pkNames, c.Name)
+	}
+	return flatten(pkNames, ", ")
+}
+
+// indexLookupFields returns the fields which are the leading column of the
+// btree or hash indexes, so that looking up the rows by the field can use the
+// index. The partial indexes, the expression indexes and the single column
+// primary key, which is looked up by GetTByPkContext, are skipped.
+func indexLookupFields(st *Struct) []*StructField {
+	var fs []*StructField
+	for _, f := range st.Fields {
+		for _, idx := range st.Table.Indexes {
+			if idx.Method != "btree" && idx.Method != "hash" || idx.Predicate != "" {
+				continue
+			}
+			if idx.IsPrimary && len(idx.Columns) == 1 {
+				continue
+			}
+			if idx.Columns[0] == f.Column.Name && isComparableColumn(f.Column) {
+				fs = append(fs, f)
+				break
+			}
+		}
+	}
+	return fs
+}
+
+// isComparableColumn returns true if the column can be filtered with "=".
+func isComparableColumn(c *PgColumn) bool {
+	return !contains(c.DataType, incomparableTypes)
+}
+
+// isOrderedColumn returns true if the column can be filtered with "<" and ">".
+func isOrderedColumn(c *PgColumn) bool {
+	return contains(c.DataType, orderedTypes)
+}
+
+// wrapError returns the expression wrapping err in the configured style. op
+// is the operation which is put in the message by the "std" style.
+func wrapError(st *Struct, op string) string {
+	e := fmt.Sprintf("translateError(%q, err)", st.Table.Name)
+	switch st.Config.ErrorWrap {
+	case ErrorWrapStd:
+		return fmt.Sprintf(`fmt.Errorf("%s %s: %%w", %s)`, op, st.Table.Name, e)
+	case ErrorWrapNone:
+		return e
+	default:
+		return "errors.WithStack(" + e + ")"
+	}
+}
+
+// dbExec returns the method of the Queryer executing a statement.
+func dbExec(st *Struct) string {
+	if st.Config.Driver == DriverPgx {
+		return "Exec"
+	}
+	return "ExecContext"
+}
+
+// dbQuery returns the method of the Queryer selecting rows.
+func dbQuery(st *Struct) string {
+	if st.Config.Driver == DriverPgx {
+		return "Query"
+	}
+	return "QueryContext"
+}
+
+// dbQueryRow returns the method of the Queryer selecting a row.
+func dbQueryRow(st *Struct) string {
+	if st.Config.Driver == DriverPgx {
+		return "QueryRow"
+	}
+	return "QueryRowContext"
+}
+
+// errNoRows returns the error returned by the driver when no row is found.
+func errNoRows(st *Struct) string {
+	if st.Config.Driver == DriverPgx {
+		return "pgx.ErrNoRows"
+	}
+	return "sql.ErrNoRows"
+}
+
+// arrayParam returns the expression passing the slice v as an array
+// parameter. pgx encodes slices by itself, while lib/pq needs pq.Array.
+func arrayParam(st *Struct, v string) string {
+	if st.Config.Driver == DriverPgx {
+		return v
+	}
+	return "pq.Array(" + v + ")"
+}
+
+// copyNowFields returns the timestamp columns of the database clock which
+// COPY sets to Now() of the Go clock, since it cannot evaluate now(). The
+// columns whose types cannot hold time.Time are left to their defaults.
+func copyNowFields(st *Struct) []*StructField {
+	var fs []*StructField
+	for _, f := range dbNowFields(st, TimestampCreated, TimestampUpdated) {
+		if nowAssignment(f) != "" {
+			fs = append(fs, f)
+		}
+	}
+	return fs
+}
+
+// copyFields returns the fields loaded by COPY, which are the columns sent by
+// INSERT and the timestamp columns of the database clock.
+func copyFields(st *Struct) []*StructField {
+	return append(insertFields(st), copyNowFields(st)...)
+}
+
+// createCopyColumns returns the quoted names of the columns loaded by COPY.
+func createCopyColumns(st *Struct) string {
+	var colNames []string
+	for _, f := range copyFields(st) {
+		colNames = append(colNames, fmt.Sprintf("%q", f.Column.Name))
+	}
+	return flatten(colNames, ", ")
+}
+
+// createCopyValues returns the fields loaded by COPY.
+func createCopyValues(st *Struct) string {
+	var fs []string
+	for _, f := range copyFields(st) {
+		fs = append(fs, "r."+f.Name)
+	}
+	return flatten(fs, ", ")
+}
+
+// hasDefault returns true if the column has a default value. The expression
+// of the generated column is not its default.
+func hasDefault(c *PgColumn) bool {
+	return c.Generated == "" && c.DefaultValue.String != ""
+}
+
+// isInsertColumn returns true if the column is sent by INSERT. The serial
+// and identity columns, the generated columns, the timestamp columns of the
+// database clock and, with --defaults=omit, the columns with defaults are
+// assigned by the database.
+func isInsertColumn(st *Struct, f *StructField) bool {
+	c := f.Column
+	if c.AutoGen || isDBNowField(st, f) {
+		return false
+	}
+	if st.Config.Defaults == DefaultsOmit && hasDefault(c) {
+		return false
+	}
+	return c.Generated == ""
+}
+
+// insertDefaultFields returns the fields of the columns with defaults which
+// are left out of INSERT when they are zero, with --defaults=zero.
+func insertDefaultFields(st *Struct) []*StructField {
+	if st.Config.Defaults != DefaultsZero {
+		return nil
+	}
+	var fs []*StructField
+	for _, f := range insertFields(st) {
+		if hasDefault(f.Column) {
+			fs = append(fs, f)
+		}
+	}
+	return fs
+}
+
+// insertRequiredFields returns the fields always sent by INSERT.
+func insertRequiredFields(st *Struct) []*StructField {
+	var fs []*StructField
+	for _, f := range insertFields(st) {
+		if st.Config.Defaults != DefaultsZero || !hasDefault(f.Column) {
+			fs = append(fs, f)
+		}
+	}
+	return fs
+}
+
+// createInsertColumnList returns the quoted names of the columns always sent
+// by INSERT.
+func createInsertColumnList(st *Struct) string {
+	var colNames []string
+	for _, f := range insertRequiredFields(st) {
+		colNames = append(colNames, fmt.Sprintf("%q", f.Column.Name))
+	}
+	return flatten(colNames, ", ")
+}
+
+// createInsertArgList returns the arguments of the columns always sent by
+// INSERT.
+func createInsertArgList(st *Struct) string {
+	var fs []string
+	for _, f := range insertRequiredFields(st) {
+		fs = append(fs, "&r."+f.Name)
+	}
+	return flatten(fs, ", ")
+}
+
+// createReturningColumnList returns the quoted names of the columns always
+// scanned back by RETURNING.
+func createReturningColumnList(st *Struct) string {
+	var colNames []string
+	for _, f := range returningFields(st) {
+		colNames = append(colNames, fmt.Sprintf("%q", f.Column.Name))
+	}
+	return flatten(colNames, ", ")
+}
+
+// isZeroField returns the Go expression which is true when the field has the
+// zero value of its type.
+func isZeroField(f *StructField) string {
+	v := "r." + f.Name
+	switch {
+	case f.Type == "string":
+		return v + ` == ""`
+	case f.Type == "bool":
+		return "!" + v
+	case contains(f.Type, goBasicTypes):
+		return v + " == 0"
+	case strings.HasPrefix(f.Type, "*"):
+		return v + " == nil"
+	case strings.HasPrefix(f.Type, "[]"), strings.HasPrefix(f.Type, "map["):
+		return "len(" + v + ") == 0"
+	case f.Type == "time.Time", f.Type == "decimal.Decimal":
+		return v + ".IsZero()"
+	case f.Type == "Interval":
+		return v + " == (Interval{})"
+	case strings.HasPrefix(f.Type, "sql.Null"), f.Type == "decimal.NullDecimal",
+		strings.HasPrefix(f.Type, "pgtype.") && !strings.HasPrefix(f.Type, "pgtype.FlatArray") && f.Type != "pgtype.Hstore":
+		return "!" + v + ".Valid"
+	}
+	return "reflect.ValueOf(" + v + ").IsZero()"
+}
+
+// insertFields returns the fields sent by INSERT.
+func insertFields(st *Struct) []*StructField {
+	var fs []*StructField
+	for _, f := range st.Fields {
+		if isInsertColumn(st, f) {
+			fs = append(fs, f)
+		}
+	}
+	return fs
+}
+
+// returningFields returns the fields assigned by the database, which are
+// scanned back with RETURNING after INSERT.
+func returningFields(st *Struct) []*StructField {
+	var fs []*StructField
+	for _, f := range st.Fields {
+		if !isInsertColumn(st, f) {
+			fs = append(fs, f)
 		}
 	}
+	return fs
+}
+
+func createInsertScan(st *Struct) string {
+	var fs []string
+	for _, f := range returningFields(st) {
+		fs = append(fs, "&r."+f.Name)
+	}
+	return flatten(fs, ", ")
+}
+
+func createInsertParams(st *Struct) string {
+	var fs []string
+	for _, f := range insertFields(st) {
+		fs = append(fs, "&r."+f.Name)
+	}
 	return flatten(fs, ", ")
 }
 
@@ -114,86 +606,407 @@ func placeholders(l []string) string {
 	return ph
 }
 
+// insertValuesSQL returns the INSERT statement without RETURNING. The
+// timestamp columns of the database clock are set to now().
+func insertValuesSQL(st *Struct) string {
+	fs := insertFields(st)
+	nows := dbNowFields(st, TimestampCreated, TimestampUpdated)
+	if len(fs) == 0 && len(nows) == 0 {
+		return "INSERT INTO " + st.Table.Name + " (" + st.Table.Columns[0].Name + ") VALUES (DEFAULT)"
+	}
+	var colNames []string
+	for _, f := range fs {
+		colNames = append(colNames, f.Column.Name)
+	}
+	values := placeholders(colNames)
+	for _, f := range nows {
+		colNames = append(colNames, f.Column.Name)
+		if values != "" {
+			values = values + ", "
+		}
+		values = values + "now()"
+	}
+	return "INSERT INTO " + st.Table.Name + " (" + flatten(colNames, ", ") + ") VALUES (" + values + ")"
+}
+
+// isDBNowField returns true if the field is a timestamp column set to now()
+// of the database.
+func isDBNowField(st *Struct, f *StructField) bool {
+	return f.Timestamp != "" && st.Config.TimestampClock == TimestampClockDB
+}
+
+// dbNowFields returns the timestamp columns of the kinds set to now() of the
+// database.
+func dbNowFields(st *Struct, kinds ...string) []*StructField {
+	var fs []*StructField
+	for _, f := range st.Fields {
+		if isDBNowField(st, f) && contains(f.Timestamp, kinds) {
+			fs = append(fs, f)
+		}
+	}
+	return fs
+}
+
+// nowAssignment returns the statement assigning now of time.Time to the
+// field, or empty if the type of the field cannot hold it.
+func nowAssignment(f *StructField) string {
+	v := "r." + f.Name
+	switch f.Type {
+	case "time.Time":
+		return v + " = now"
+	case "*time.Time":
+		return v + " = new(time.Time)\n*" + v + " = now"
+	case "sql.NullTime", "pq.NullTime", "pgtype.Timestamptz", "pgtype.Timestamp":
+		return v + " = " + f.Type + "{Time: now, Valid: true}"
+	case "sql.Null[time.Time]":
+		return v + " = sql.Null[time.Time]{V: now, Valid: true}"
+	}
+	return ""
+}
+
+// setNow returns the statements setting the timestamp columns to Now() of
+// the Go clock before the write, which is "create" or "update", or empty if
+// there is none.
+func setNow(st *Struct, write string) string {
+	if st.Config.TimestampClock != TimestampClockGo {
+		return ""
+	}
+	var stmts []string
+	for _, f := range st.Fields {
+		if f.Timestamp == TimestampUpdated || (f.Timestamp == TimestampCreated && write == "create") {
+			stmts = append(stmts, nowAssignment(f))
+		}
+	}
+	if len(stmts) == 0 {
+		return ""
+	}
+	return "now := Now()\n" + flatten(stmts, "\n")
+}
+
+// setCopyNow returns the statements setting the timestamp columns to Now()
+// before COPY, which are the columns of the Go clock or, with the database
+// clock, the ones COPY loads in place of now().
+func setCopyNow(st *Struct) string {
+	if st.Config.TimestampClock == TimestampClockGo {
+		return setNow(st, "create")
+	}
+	var stmts []string
+	for _, f := range copyNowFields(st) {
+		stmts = append(stmts, nowAssignment(f))
+	}
+	if len(stmts) == 0 {
+		return ""
+	}
+	return "now := Now()\n" + flatten(stmts, "\n")
+}
+
+// returningSQL returns the RETURNING clause of the columns assigned by the
+// database, or empty if there is none.
+func returningSQL(st *Struct) string {
+	var colNames []string
+	for _, f := range returningFields(st) {
+		colNames = append(colNames, f.Column.Name)
+	}
+	if len(colNames) == 0 {
+		return ""
+	}
+	return " RETURNING " + flatten(colNames, ", ")
+}
+
 func createInsertSQL(st *Struct) string {
-	var sql string
-	sql = "INSERT INTO " + st.Table.Name + " ("
+	return insertValuesSQL(st) + returningSQL(st)
+}
 
-	if len(st.Table.Columns) == 1 && st.Table.Columns[0].IsPrimaryKey && st.Table.AutoGenPk {
-		sql = sql + st.Table.Columns[0].Name + ") VALUES (DEFAULT)"
-	} else {
-		var colNames []string
-		for _, c := range st.Table.Columns {
-			if c.IsPrimaryKey && st.Table.AutoGenPk {
-				continue
-			} else {
-				colNames = append(colNames, c.Name)
-			}
+func createInsertOnConflictDoNothingSQL(st *Struct) string {
+	return insertValuesSQL(st) + " ON CONFLICT DO NOTHING" + returningSQL(st)
+}
+
+// updateFields returns the fields set by UPDATE, which are the columns out of
+// the primary key except the serial, identity and generated columns, the
+// version column, the soft delete column, the created_at columns and the
+// updated_at columns of the database clock.
+func updateFields(st *Struct) []*StructField {
+	var fs []*StructField
+	for _, f := range st.Fields {
+		c := f.Column
+		if c.IsPrimaryKey || c.AutoGen || c.Generated != "" || f == st.Version || f == st.SoftDelete ||
+			f.Timestamp == TimestampCreated || isDBNowField(st, f) {
+			continue
 		}
-		sql = sql + flatten(colNames, ", ") + ") VALUES ("
+		fs = append(fs, f)
+	}
+	return fs
+}
 
-		var fieldNames []string
-		for _, f := range st.Fields {
-			if f.Column.IsPrimaryKey && st.Table.AutoGenPk {
-				continue
-			} else {
-				fieldNames = append(fieldNames, f.Name)
-			}
+// pkFields returns the fields of the primary key columns.
+func pkFields(st *Struct) []*StructField {
+	var fs []*StructField
+	for _, f := range st.Fields {
+		if f.Column.IsPrimaryKey {
+			fs = append(fs, f)
 		}
-		sql = sql + placeholders(fieldNames) + ")"
 	}
+	return fs
+}
 
-	if st.Table.AutoGenPk {
-		sql = sql + " RETURNING "
-		for i, c := range st.Table.PrimaryKeys {
-			if i == 0 {
-				sql = sql + c.Name
-			} else {
-				sql = sql + ", " + c.Name
-			}
+// updateReturningFields returns the fields scanned back after UPDATE, which
+// are the generated columns, the version column and the updated_at columns
+// of the database clock.
+func updateReturningFields(st *Struct) []*StructField {
+	var fs []*StructField
+	for _, f := range st.Fields {
+		if f.Column.Generated != "" || f == st.Version || (f.Timestamp == TimestampUpdated && isDBNowField(st, f)) {
+			fs = append(fs, f)
 		}
 	}
+	return fs
+}
+
+// versionCondition returns the condition of the version column following the
+// n placeholders, or empty if the struct has no version column.
+func versionCondition(st *Struct, n int) string {
+	if st.Version == nil {
+		return ""
+	}
+	return fmt.Sprintf(" AND %s = $%d", st.Version.Column.Name, n+1)
+}
+
+func createUpdateSQL(st *Struct) string {
+	var sets, pkNames, retNames []string
+	for i, f := range updateFields(st) {
+		sets = append(sets, fmt.Sprintf("%s = $%d", f.Column.Name, i+1))
+	}
+	for _, f := range dbNowFields(st, TimestampUpdated) {
+		sets = append(sets, f.Column.Name+" = now()")
+	}
+	if st.Version != nil {
+		sets = append(sets, st.Version.Column.Name+" = "+st.Version.Column.Name+" + 1")
+	}
+	for _, c := range st.Table.PrimaryKeys {
+		pkNames = append(pkNames, c.Name)
+	}
+	for _, f := range updateReturningFields(st) {
+		retNames = append(retNames, f.Column.Name)
+	}
+	n := len(updateFields(st))
+	sql := "UPDATE " + st.Table.Name + " SET " + flatten(sets, ", ") +
+		" WHERE " + pkConditionFrom(pkNames, n+1) + versionCondition(st, n+len(pkNames))
+	if len(retNames) > 0 {
+		sql = sql + " RETURNING " + flatten(retNames, ", ")
+	}
 	return sql
 }
 
-func createInsertOnConflictDoNothingSQL(st *Struct) string {
-	var sql string
-	sql = "INSERT INTO " + st.Table.Name + " ("
+func createUpdateParams(st *Struct) string {
+	var fs []string
+	for _, f := range append(updateFields(st), pkFields(st)...) {
+		fs = append(fs, "&r."+f.Name)
+	}
+	if st.Version != nil {
+		fs = append(fs, "&r."+st.Version.Name)
+	}
+	return flatten(fs, ", ")
+}
 
-	if len(st.Table.Columns) == 1 && st.Table.Columns[0].IsPrimaryKey && st.Table.AutoGenPk {
-		sql = sql + st.Table.Columns[0].Name + ") VALUES (DEFAULT)"
-	} else {
-		var colNames []string
-		for _, c := range st.Table.Columns {
-			if c.IsPrimaryKey && st.Table.AutoGenPk {
-				continue
-			} else {
-				colNames = append(colNames, c.Name)
-			}
+func createUpdateScan(st *Struct) string {
+	var fs []string
+	for _, f := range updateReturningFields(st) {
+		fs = append(fs, "&r."+f.Name)
+	}
+	return flatten(fs, ", ")
+}
+
+// tracksChanges returns true if the struct tracks the changed fields, which
+// needs the primary key and the columns to update.
+func tracksChanges(st *Struct) bool {
+	return st.Config.DirtyTracking && len(st.Table.PrimaryKeys) > 0 && len(changeFields(st)) > 0
+}
+
+// methodNames returns the names of the exported methods of the struct
+// generated by the method template with the options of the struct.
+func methodNames(st *Struct) []string {
+	names := []string{"Create", "Validate", "CreateContext", "CreateOnConflictDoNothing"}
+	if st.Config.OverridingSystemValue && hasAutoGenColumn(st) {
+		names = append(names, "CreateOverridingSystemValueContext")
+	}
+	if len(st.Table.PrimaryKeys) > 0 {
+		if len(updateFields(st)) > 0 || st.Version != nil {
+			names = append(names, "UpdateContext")
 		}
-		sql = sql + flatten(colNames, ", ") + ") VALUES ("
+		names = append(names, "DeleteContext", "ReloadContext")
+	}
+	if tracksChanges(st) {
+		names = append(names, "Snapshot", "UpdateChangedContext")
+	}
+	return names
+}
 
-		var fieldNames []string
-		for _, f := range st.Fields {
-			if f.Column.IsPrimaryKey && st.Table.AutoGenPk {
-				continue
-			} else {
-				fieldNames = append(fieldNames, f.Name)
-			}
+// changeFields returns the fields compared with the snapshot by
+// UpdateChangedContext, which are the fields set by UPDATE except the
+// updated_at columns set regardless of the changes.
+func changeFields(st *Struct) []*StructField {
+	var fs []*StructField
+	for _, f := range updateFields(st) {
+		if f.Timestamp == "" {
+			fs = append(fs, f)
 		}
-		sql = sql + placeholders(fieldNames) + ")"
 	}
+	return fs
+}
 
-	sql = sql + " ON CONFLICT DO NOTHING"
+// isChangedField returns the Go expression which is true when the field
+// differs from the snapshot s.
+func isChangedField(f *StructField) string {
+	v, sv := "r."+f.Name, "s."+f.Name
+	switch {
+	case f.Type == "string", f.Type == "bool", contains(f.Type, goBasicTypes):
+		return v + " != " + sv
+	case f.Type == "time.Time":
+		return "!" + v + ".Equal(" + sv + ")"
+	}
+	return "!reflect.DeepEqual(" + v + ", " + sv + ")"
+}
 
-	if st.Table.AutoGenPk {
-		sql = sql + " RETURNING "
-		for i, c := range st.Table.PrimaryKeys {
-			if i == 0 {
-				sql = sql + c.Name
-			} else {
-				sql = sql + ", " + c.Name
-			}
+// cloneField returns the statement copying the field into the snapshot s
+// apart from the field, or empty if the value is copied by the assignment.
+func cloneField(f *StructField) string {
+	v, sv := "r."+f.Name, "s."+f.Name
+	switch {
+	case strings.HasPrefix(f.Type, "[]"), strings.HasPrefix(f.Type, "pgtype.FlatArray"):
+		return sv + " = append(" + v + "[:0:0], " + v + "...)"
+	case strings.HasPrefix(f.Type, "map["), f.Type == "pgtype.Hstore":
+		return sv + " = maps.Clone(" + v + ")"
+	case strings.HasPrefix(f.Type, "*"):
+		return "if " + v + " != nil {\nv := *" + v + "\n" + sv + " = &v\n}"
+	}
+	return ""
+}
+
+// createUpdateChangedWhere returns the WHERE and RETURNING clauses of
+// UpdateChangedContext, whose arguments of the primary key and the version
+// precede the changed columns.
+func createUpdateChangedWhere(st *Struct) string {
+	var pkNames, retNames []string
+	for _, c := range st.Table.PrimaryKeys {
+		pkNames = append(pkNames, c.Name)
+	}
+	for _, f := range updateReturningFields(st) {
+		retNames = append(retNames, f.Column.Name)
+	}
+	sql := " WHERE " + pkCondition(pkNames) + versionCondition(st, len(pkNames))
+	if len(retNames) > 0 {
+		sql = sql + " RETURNING " + flatten(retNames, ", ")
+	}
+	return sql
+}
+
+// createDeleteSQL returns the DELETE statement by the primary key, or the
+// UPDATE statement setting the soft delete column of the row.
+func createDeleteSQL(st *Struct) string {
+	var pkNames []string
+	for _, c := range st.Table.PrimaryKeys {
+		pkNames = append(pkNames, c.Name)
+	}
+	where := " WHERE " + pkCondition(pkNames) + versionCondition(st, len(pkNames))
+	if st.SoftDelete == nil {
+		return "DELETE FROM " + st.Table.Name + where
+	}
+	col := st.SoftDelete.Column.Name
+	sets := []string{col + " = now()"}
+	rets := []string{col}
+	if st.Version != nil {
+		sets = append(sets, st.Version.Column.Name+" = "+st.Version.Column.Name+" + 1")
+		rets = append(rets, st.Version.Column.Name)
+	}
+	return "UPDATE " + st.Table.Name + " SET " + flatten(sets, ", ") + where + andSoftDeleteCond(st) +
+		" RETURNING " + flatten(rets, ", ")
+}
+
+// createDeleteScan returns the fields scanned back after the soft delete.
+func createDeleteScan(st *Struct) string {
+	if st.SoftDelete == nil {
+		return ""
+	}
+	fs := []string{"&r." + st.SoftDelete.Name}
+	if st.Version != nil {
+		fs = append(fs, "&r."+st.Version.Name)
+	}
+	return flatten(fs, ", ")
+}
+
+func createDeleteParams(st *Struct) string {
+	var fs []string
+	for _, f := range pkFields(st) {
+		fs = append(fs, "&r."+f.Name)
+	}
+	if st.Version != nil {
+		fs = append(fs, "&r."+st.Version.Name)
+	}
+	return flatten(fs, ", ")
+}
+
+// createReloadParams returns the primary key fields of the struct as the
+// arguments of the select by the primary key.
+func createReloadParams(st *Struct) string {
+	var fs []string
+	for _, f := range pkFields(st) {
+		fs = append(fs, "r."+f.Name)
+	}
+	return flatten(fs, ", ")
+}
+
+// hasAutoGenColumn returns true if the table has a serial or identity column.
+func hasAutoGenColumn(st *Struct) bool {
+	for _, c := range st.Table.Columns {
+		if c.AutoGen {
+			return true
+		}
+	}
+	return false
+}
+
+// overridingFields returns the fields sent by INSERT with OVERRIDING SYSTEM
+// VALUE, which are all but the generated columns.
+func overridingFields(st *Struct) []*StructField {
+	var fs []*StructField
+	for _, f := range st.Fields {
+		if f.Column.Generated == "" {
+			fs = append(fs, f)
 		}
 	}
+	return fs
+}
+
+func createInsertOverridingSQL(st *Struct) string {
+	var colNames, retNames []string
+	for _, f := range st.Fields {
+		if f.Column.Generated == "" {
+			colNames = append(colNames, f.Column.Name)
+		} else {
+			retNames = append(retNames, f.Column.Name)
+		}
+	}
+	sql := "INSERT INTO " + st.Table.Name + " (" + flatten(colNames, ", ") + ") OVERRIDING SYSTEM VALUE VALUES (" + placeholders(colNames) + ")"
+	if len(retNames) > 0 {
+		sql = sql + " RETURNING " + flatten(retNames, ", ")
+	}
 	return sql
 }
+
+func createInsertOverridingParams(st *Struct) string {
+	var fs []string
+	for _, f := range overridingFields(st) {
+		fs = append(fs, "&r."+f.Name)
+	}
+	return flatten(fs, ", ")
+}
+
+func createInsertOverridingScan(st *Struct) string {
+	var fs []string
+	for _, f := range st.Fields {
+		if f.Column.Generated != "" {
+			fs = append(fs, "&r."+f.Name)
+		}
+	}
+	return flatten(fs, ", ")
+}
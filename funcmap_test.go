@@ -78,3 +78,31 @@ func TestCreateInsertScan(t *testing.T) {
 		t.Logf("%s", params)
 	}
 }
+
+func TestFilterColumnTypes(t *testing.T) {
+	tests := []struct {
+		dataType   string
+		comparable bool
+		ordered    bool
+	}{
+		{"bigint", true, true},
+		{"text", true, true},
+		{"timestamp with time zone", true, true},
+		{"boolean", true, false},
+		{"uuid", true, false},
+		{"jsonb", true, false},
+		{"json", false, false},
+		{"xml", false, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.dataType, func(t *testing.T) {
+			c := &PgColumn{DataType: tt.dataType}
+			if got := isComparableColumn(c); got != tt.comparable {
+				t.Errorf("isComparableColumn(%s) = %t, want %t", tt.dataType, got, tt.comparable)
+			}
+			if got := isOrderedColumn(c); got != tt.ordered {
+				t.Errorf("isOrderedColumn(%s) = %t, want %t", tt.dataType, got, tt.ordered)
+			}
+		})
+	}
+}
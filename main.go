@@ -11,7 +11,7 @@ import (
 
 var (
 	connStr = kingpin.Arg(
-		"conn", "PostgreSQL connection string in URL format").Required().String()
+		"conn", "PostgreSQL connection string in URL or keyword/value format (the libpq environment variables are used if omitted)").String()
 	schema = kingpin.Flag(
 		"schema", "PostgreSQL schema name").Default("public").Short('s').String()
 	pkgName          = kingpin.Flag("package", "package name").Default("main").Short('p').String()
@@ -22,8 +22,22 @@ var (
 	customTmpl       = kingpin.Flag("template", "custom template path").String()
 	outFile          = kingpin.Flag("output", "output file path").Short('o').String()
 	noQueryInterface = kingpin.Flag("no-interface", "output without Queryer interface").Bool()
+	noHelper         = kingpin.Flag("no-helper", "output without the helper declarations shared by the generated code").Bool()
 	deprecated       = kingpin.Flag("deprecated", "deprecated table names").Strings()
 	queryer          = kingpin.Flag("queryer", "Queryer type name").String()
+	errorWrap        = kingpin.Flag("error-wrap", "error wrapping style of generated code (pkg, std, none)").Default(ErrorWrapPkg).Enum(ErrorWrapPkg, ErrorWrapStd, ErrorWrapNone)
+	driverName       = kingpin.Flag("driver", "database driver of generated code (pq, pgx)").Default(DriverPq).Enum(DriverPq, DriverPgx)
+	nullStyle        = kingpin.Flag("null-style", "Go types of nullable columns (legacy, generic, pointer)").Default(NullStyleLegacy).Enum(NullStyleLegacy, NullStyleGeneric, NullStylePointer)
+	numeric          = kingpin.Flag("numeric", "Go type of numeric columns (float64, decimal)").Default(NumericFloat).Enum(NumericFloat, NumericDecimal)
+	defaults         = kingpin.Flag("defaults", "columns with defaults on insert (send, zero, omit)").Default(DefaultsSend).Enum(DefaultsSend, DefaultsZero, DefaultsOmit)
+	indexLookup      = kingpin.Flag("index-lookup", "generate lookup functions for the leading columns of the indexes").Bool()
+	overriding       = kingpin.Flag("overriding-system-value", "generate insert methods writing the generated keys and identities for data migrations").Bool()
+	versionColumns   = kingpin.Flag("version-column", `version columns of optimistic locking in "column" or "table.column" format`).Strings()
+	softDelete       = kingpin.Flag("soft-delete", "nullable timestamp column set by the delete methods instead of deleting the row").String()
+	createdAt        = kingpin.Flag("created-at", "timestamp columns set on insert, by name or pattern").Strings()
+	updatedAt        = kingpin.Flag("updated-at", "timestamp columns set on insert and update, by name or pattern").Strings()
+	timestampClock   = kingpin.Flag("timestamp-clock", "clock of the created-at and updated-at columns (db, go)").Default(TimestampClockDB).Enum(TimestampClockDB, TimestampClockGo)
+	dirtyTracking    = kingpin.Flag("dirty-tracking", "generate update methods sending only the changed columns").Bool()
 	version          string
 )
 
@@ -39,7 +53,23 @@ func main() {
 		log.Fatal(err)
 	}
 
-	st, err := PgCreateStruct(conn, *schema, *typeMapFilePath, *pkgName, *customTmpl, *exTbls, *exCols, *autGenKeyList, *deprecated, *queryer)
+	genCfg := NewGenConfig()
+	genCfg.ErrorWrap = *errorWrap
+	genCfg.Driver = *driverName
+	genCfg.NullStyle = *nullStyle
+	genCfg.Numeric = *numeric
+	genCfg.Defaults = *defaults
+	genCfg.IndexLookup = *indexLookup
+	genCfg.OverridingSystemValue = *overriding
+	genCfg.VersionColumns = *versionColumns
+	genCfg.SoftDeleteColumn = *softDelete
+	genCfg.CreatedAtColumns = *createdAt
+	genCfg.UpdatedAtColumns = *updatedAt
+	genCfg.TimestampClock = *timestampClock
+	genCfg.DirtyTracking = *dirtyTracking
+	genCfg.NoHelper = *noHelper
+
+	st, err := PgCreateStruct(conn, *schema, *typeMapFilePath, *pkgName, *customTmpl, *exTbls, *exCols, *autGenKeyList, *deprecated, *queryer, genCfg)
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -48,7 +78,7 @@ func main() {
 	if *noQueryInterface {
 		src = st
 	} else {
-		q := []byte(queryInterface)
+		q := []byte(QueryInterface(genCfg))
 		src = append(st, q...)
 	}
 
@@ -4,6 +4,14 @@ DROP TABLE IF EXISTS t3;
 DROP TABLE IF EXISTS t4;
 DROP TABLE IF EXISTS t5;
 DROP TABLE IF EXISTS t6;
+-- the tables of the fixtures in sql/test_*.sql
+DROP TABLE IF EXISTS t7;
+DROP TABLE IF EXISTS t8;
+DROP TABLE IF EXISTS t9;
+DROP TABLE IF EXISTS t_versioned;
+DROP TABLE IF EXISTS t_soft_deleted;
+DROP TABLE IF EXISTS t_timestamps;
+DROP TABLE IF EXISTS t_types;
 
 CREATE TABLE t1 (
   id bigserial primary key
@@ -0,0 +1,3 @@
+-- The check constraints of Validate
+ALTER TABLE t2 ADD CONSTRAINT t2_i_check CHECK (i >= 0);
+ALTER TABLE t2 ADD CONSTRAINT t2_str_check CHECK (length(str) > 0) NOT VALID;
@@ -0,0 +1,6 @@
+-- The columns with defaults of --defaults
+CREATE TABLE t8 (
+  code text primary key
+  , created_at timestamp with time zone not null default now()
+  , note text default ''
+);
@@ -0,0 +1,6 @@
+-- The stored generated column
+CREATE TABLE t7 (
+  id bigserial primary key
+  , i integer not null
+  , doubled integer GENERATED ALWAYS AS (i * 2) STORED
+);
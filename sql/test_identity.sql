@@ -0,0 +1,7 @@
+-- The identity and serial columns out of the primary key
+CREATE TABLE t9 (
+  code text primary key
+  , seq integer GENERATED ALWAYS AS IDENTITY
+  , n bigserial
+  , str text not null
+);
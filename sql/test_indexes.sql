@@ -0,0 +1,4 @@
+-- The indexes of --index-lookup
+CREATE INDEX t1_str_idx ON t1 (str);
+CREATE INDEX t1_lower_str_idx ON t1 (lower(str));
+CREATE INDEX t2_str_idx ON t2 (str) WHERE str <> '';
@@ -0,0 +1,7 @@
+-- The soft delete column of --soft-delete
+CREATE TABLE t_soft_deleted (
+  id bigserial primary key
+  , str text not null
+  , deleted_at timestamp with time zone
+);
+CREATE INDEX t_soft_deleted_str_idx ON t_soft_deleted (str);
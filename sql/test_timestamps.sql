@@ -0,0 +1,7 @@
+-- The timestamp columns of --created-at and --updated-at
+CREATE TABLE t_timestamps (
+  id bigserial primary key
+  , str text not null
+  , created_at timestamp with time zone not null default now()
+  , updated_at timestamp with time zone
+);
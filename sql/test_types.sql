@@ -0,0 +1,6 @@
+-- The types mapped to []byte, Interval and float64 or decimal.Decimal
+CREATE TABLE t_types (
+  b bytea not null
+  , iv interval not null
+  , n numeric not null
+);
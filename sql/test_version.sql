@@ -0,0 +1,6 @@
+-- The version column of --version-column
+CREATE TABLE t_versioned (
+  id bigserial primary key
+  , str text not null
+  , version integer not null default 1
+);
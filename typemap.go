@@ -63,15 +63,20 @@ db_types = ["real"]
 notnull_go_type = "float32"
 nullable_go_type = "sql.NullFloat64"
 
+[double]
+db_types = ["double precision"]
+notnull_go_type = "float64"
+nullable_go_type = "sql.NullFloat64"
+
 [numeric]
-db_types = ["numeric", "double precision"]
+db_types = ["numeric"]
 notnull_go_type = "float64"
 nullable_go_type = "sql.NullFloat64"
 
 [bytea]
 db_types = ["bytea"]
-notnull_go_type = "byte"
-nullable_go_type = "byte"
+notnull_go_type = "[]byte"
+nullable_go_type = "[]byte"
 
 [json]
 db_types = ["json", "jsonb"]
@@ -85,11 +90,193 @@ nullable_go_type = "[]byte"
 
 [interval]
 db_types = ["interval"]
-notnull_go_type = "time.Duration"
-nullable_go_type = "*time.Duration"
+notnull_go_type = "Interval"
+nullable_go_type = "*Interval"
 
 [default]
 db_types = ["*"]
 notnull_go_type = "interface{}"
 nullable_go_type = "interface{}"
 `
+
+// sizedTypeMap is the default type map of the generic and pointer null styles.
+// The Go types have the same width as the PostgreSQL types, and the nullable
+// types are derived from them.
+const sizedTypeMap = `
+[string]
+db_types = ["character", "character varying", "text", "money"]
+notnull_go_type = "string"
+
+[time]
+db_types = [
+    "time with time zone", "time without time zone",
+    "timestamp without time zone", "timestamp with time zone", "date"
+]
+notnull_go_type = "time.Time"
+
+[bool]
+db_types = ["boolean"]
+notnull_go_type = "bool"
+
+[smallint]
+db_types = ["smallint"]
+notnull_go_type = "int16"
+
+[integer]
+db_types = ["integer"]
+notnull_go_type = "int32"
+
+[bigint]
+db_types = ["bigint"]
+notnull_go_type = "int64"
+
+[real]
+db_types = ["real"]
+notnull_go_type = "float32"
+
+[double]
+db_types = ["double precision"]
+notnull_go_type = "float64"
+
+[numeric]
+db_types = ["numeric"]
+notnull_go_type = "float64"
+
+[bytea]
+db_types = ["bytea"]
+notnull_go_type = "[]byte"
+
+[json]
+db_types = ["json", "jsonb"]
+notnull_go_type = "[]byte"
+
+[xml]
+db_types = ["xml"]
+notnull_go_type = "[]byte"
+
+[interval]
+db_types = ["interval"]
+notnull_go_type = "Interval"
+
+[default]
+db_types = ["*"]
+notnull_go_type = "interface{}"
+`
+
+// pgxTypeMap is the default type map of the code generated for pgx. The
+// nullable columns are mapped to the pgtype types.
+const pgxTypeMap = `
+[string]
+db_types = ["character", "character varying", "text", "money"]
+notnull_go_type = "string"
+nullable_go_type = "pgtype.Text"
+
+[timestamptz]
+db_types = ["timestamp with time zone"]
+notnull_go_type = "time.Time"
+nullable_go_type = "pgtype.Timestamptz"
+
+[timestamp]
+db_types = ["timestamp without time zone"]
+notnull_go_type = "time.Time"
+nullable_go_type = "pgtype.Timestamp"
+
+[date]
+db_types = ["date"]
+notnull_go_type = "time.Time"
+nullable_go_type = "pgtype.Date"
+
+[time]
+db_types = ["time with time zone", "time without time zone"]
+notnull_go_type = "pgtype.Time"
+nullable_go_type = "pgtype.Time"
+
+[bool]
+db_types = ["boolean"]
+notnull_go_type = "bool"
+nullable_go_type = "pgtype.Bool"
+
+[smallint]
+db_types = ["smallint"]
+notnull_go_type = "int16"
+nullable_go_type = "pgtype.Int2"
+
+[integer]
+db_types = ["integer"]
+notnull_go_type = "int32"
+nullable_go_type = "pgtype.Int4"
+
+[bigint]
+db_types = ["bigint"]
+notnull_go_type = "int64"
+nullable_go_type = "pgtype.Int8"
+
+[real]
+db_types = ["real"]
+notnull_go_type = "float32"
+nullable_go_type = "pgtype.Float4"
+
+[double]
+db_types = ["double precision"]
+notnull_go_type = "float64"
+nullable_go_type = "pgtype.Float8"
+
+[numeric]
+db_types = ["numeric"]
+notnull_go_type = "pgtype.Numeric"
+nullable_go_type = "pgtype.Numeric"
+
+[uuid]
+db_types = ["uuid"]
+notnull_go_type = "pgtype.UUID"
+nullable_go_type = "pgtype.UUID"
+
+[bytea]
+db_types = ["bytea"]
+notnull_go_type = "[]byte"
+nullable_go_type = "[]byte"
+
+[json]
+db_types = ["json", "jsonb"]
+notnull_go_type = "[]byte"
+nullable_go_type = "[]byte"
+
+[xml]
+db_types = ["xml"]
+notnull_go_type = "[]byte"
+nullable_go_type = "[]byte"
+
+[interval]
+db_types = ["interval"]
+notnull_go_type = "pgtype.Interval"
+nullable_go_type = "pgtype.Interval"
+
+[default]
+db_types = ["*"]
+notnull_go_type = "interface{}"
+nullable_go_type = "interface{}"
+`
+
+// decimalTypeMap maps numeric to the exact decimal type of
+// github.com/shopspring/decimal, which is selected by --numeric=decimal
+var decimalTypeMap = TypeMap{
+	DBTypes:        []string{"numeric"},
+	NotNullGoType:  "decimal.Decimal",
+	NullableGoType: "decimal.NullDecimal",
+}
+
+// set maps the db types of tm with the key, and removes them from the other
+// entries, so that the type map of tm takes precedence
+func (c PgTypeMapConfig) set(key string, tm TypeMap) {
+	for k, v := range c {
+		var dbTypes []string
+		for _, t := range v.DBTypes {
+			if !contains(t, tm.DBTypes) {
+				dbTypes = append(dbTypes, t)
+			}
+		}
+		v.DBTypes = dbTypes
+		c[k] = v
+	}
+	c[key] = tm
+}
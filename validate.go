@@ -0,0 +1,350 @@
+package main
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Validation is a rule checked by the generated Validate method
+type Validation struct {
+	Field *StructField
+	// Constraint is the name of the check constraint, empty for the rules
+	// derived from the column definition
+	Constraint string
+	// Invalid is the Go expression which is true when the field is invalid
+	Invalid string
+	Message string
+}
+
+// goBasicTypes are the Go types which can be compared with the literals
+var goBasicTypes = []string{
+	"string", "int", "int16", "int32", "int64", "uint16", "uint32", "float32", "float64",
+}
+
+// nullableValueFields maps the nullable Go types to their value field and type
+var nullableValueFields = map[string][2]string{
+	"sql.NullString":  {"String", "string"},
+	"sql.NullInt16":   {"Int16", "int16"},
+	"sql.NullInt32":   {"Int32", "int32"},
+	"sql.NullInt64":   {"Int64", "int64"},
+	"sql.NullFloat64": {"Float64", "float64"},
+	"pgtype.Text":     {"String", "string"},
+	"pgtype.Int2":     {"Int16", "int16"},
+	"pgtype.Int4":     {"Int32", "int32"},
+	"pgtype.Int8":     {"Int64", "int64"},
+	"pgtype.Float4":   {"Float32", "float32"},
+	"pgtype.Float8":   {"Float64", "float64"},
+}
+
+// fieldValue returns the Go expression of the value of the field, the Go
+// expression which is true when the value is not NULL (empty for the
+// non-nullable types) and the Go type of the value. ok is false when the
+// type is not supported by the validation.
+func fieldValue(f *StructField) (value, valid, typ string, ok bool) {
+	v := "r." + f.Name
+	switch {
+	case contains(f.Type, goBasicTypes):
+		return v, "", f.Type, true
+	case strings.HasPrefix(f.Type, "sql.Null[") && strings.HasSuffix(f.Type, "]"):
+		typ = strings.TrimSuffix(strings.TrimPrefix(f.Type, "sql.Null["), "]")
+		return v + ".V", v + ".Valid", typ, contains(typ, goBasicTypes)
+	case strings.HasPrefix(f.Type, "*"):
+		typ = strings.TrimPrefix(f.Type, "*")
+		return "*" + v, v + " != nil", typ, contains(typ, goBasicTypes)
+	}
+	if vf, found := nullableValueFields[f.Type]; found {
+		return v + "." + vf[0], v + ".Valid", vf[1], true
+	}
+	return "", "", "", false
+}
+
+func isCharacterType(c *PgColumn) bool {
+	return c.DataType == "character varying" || c.DataType == "character"
+}
+
+func isTextType(c *PgColumn) bool {
+	return isCharacterType(c) || c.DataType == "text"
+}
+
+// invalidIf joins the NULL check of the field and the invalid condition
+func invalidIf(valid, cond string) string {
+	if valid == "" {
+		return cond
+	}
+	return valid + " && " + cond
+}
+
+// createValidations returns the rules of the Validate method of the struct:
+// non-empty NOT NULL text columns without default, the length of character
+// varying and character columns, and the check constraints which can be
+// translated into Go
+func createValidations(st *Struct) []*Validation {
+	var vs []*Validation
+	for _, f := range st.Fields {
+		c := f.Column
+		value, valid, typ, ok := fieldValue(f)
+		if !ok || typ != "string" {
+			continue
+		}
+		if c.NotNull && c.DefaultValue.String == "" && isTextType(c) {
+			vs = append(vs, &Validation{
+				Field:   f,
+				Invalid: value + ` == ""`,
+				Message: "must not be empty",
+			})
+		}
+		if c.MaxLength > 0 && isCharacterType(c) {
+			vs = append(vs, &Validation{
+				Field:   f,
+				Invalid: invalidIf(valid, fmt.Sprintf("utf8.RuneCountInString(%s) > %d", value, c.MaxLength)),
+				Message: fmt.Sprintf("must be at most %d characters", c.MaxLength),
+			})
+		}
+	}
+	for _, ck := range st.Table.Checks {
+		vs = append(vs, checkValidations(st, ck)...)
+	}
+	return vs
+}
+
+// checkValidations translates the check constraint into the rules, or
+// returns nil if any part of it cannot be translated
+func checkValidations(st *Struct, ck *PgCheckConstraint) []*Validation {
+	conds, ok := parseCheck(ck.Definition)
+	if !ok {
+		return nil
+	}
+	var vs []*Validation
+	for _, cond := range conds {
+		var f *StructField
+		for _, sf := range st.Fields {
+			if sf.Column.Name == cond.column {
+				f = sf
+			}
+		}
+		if f == nil {
+			return nil
+		}
+		value, valid, typ, ok := fieldValue(f)
+		if !ok {
+			return nil
+		}
+		var lits []string
+		for _, l := range cond.literals {
+			lit, ok := l.goLiteral(typ)
+			if !ok {
+				return nil
+			}
+			lits = append(lits, lit)
+		}
+		var invalid, message string
+		switch cond.op {
+		case "=":
+			invalid = fmt.Sprintf("%s != %s", value, lits[0])
+			message = "must be " + cond.literals[0].display()
+		case "<>":
+			invalid = fmt.Sprintf("%s == %s", value, lits[0])
+			message = "must not be " + cond.literals[0].display()
+		case "<", "<=", ">", ">=":
+			if typ == "string" {
+				return nil
+			}
+			invalid = fmt.Sprintf("!(%s %s %s)", value, cond.op, lits[0])
+			message = fmt.Sprintf("must be %s %s", cond.op, cond.literals[0].display())
+		case "= ANY", "<> ALL":
+			var eqs, displays []string
+			for i, lit := range lits {
+				eqs = append(eqs, fmt.Sprintf("%s == %s", value, lit))
+				displays = append(displays, cond.literals[i].display())
+			}
+			if cond.op == "= ANY" {
+				invalid = fmt.Sprintf("!(%s)", strings.Join(eqs, " || "))
+				message = "must be one of " + strings.Join(displays, ", ")
+			} else {
+				invalid = fmt.Sprintf("(%s)", strings.Join(eqs, " || "))
+				message = "must not be one of " + strings.Join(displays, ", ")
+			}
+		default:
+			return nil
+		}
+		vs = append(vs, &Validation{
+			Field:      f,
+			Constraint: ck.Name,
+			Invalid:    invalidIf(valid, invalid),
+			Message:    message,
+		})
+	}
+	return vs
+}
+
+// checkCond is a condition of a check constraint comparing a column with
+// literals, e.g. "(i >= 0)" and "(status = ANY (ARRAY['a'::text, 'b'::text]))"
+type checkCond struct {
+	column   string
+	op       string
+	literals []checkLiteral
+}
+
+// checkLiteral is a literal of a check constraint
+type checkLiteral struct {
+	value    string
+	isString bool
+}
+
+// goLiteral returns the literal in Go, if it can be compared with the Go type
+func (l checkLiteral) goLiteral(typ string) (string, bool) {
+	if typ == "string" {
+		return strconv.Quote(l.value), l.isString
+	}
+	if _, err := strconv.ParseFloat(l.value, 64); err != nil {
+		return "", false
+	}
+	if strings.HasPrefix(typ, "float") {
+		return l.value, true
+	}
+	if _, err := strconv.ParseInt(l.value, 10, 64); err != nil {
+		return "", false
+	}
+	if strings.HasPrefix(typ, "uint") && strings.HasPrefix(l.value, "-") {
+		return "", false
+	}
+	return l.value, true
+}
+
+func (l checkLiteral) display() string {
+	if l.isString {
+		return "'" + strings.ReplaceAll(l.value, "'", "''") + "'"
+	}
+	return l.value
+}
+
+const (
+	checkIdentPattern   = `"(?:[^"]|"")+"|[a-z_][a-z0-9_$]*`
+	checkCastPattern    = `(?:::([a-z ]+)(?:\[\])?)?`
+	checkLiteralPattern = `(?:'(?:[^']|'')*'|\(?-?[0-9]+(?:\.[0-9]+)?\)?)(?:::[a-z ]+(?:\[\])?)?`
+	checkColumnPattern  = `\(?(` + checkIdentPattern + `)\)?(?:::[a-z ]+(?:\[\])?)?`
+)
+
+var (
+	checkCompareRe = regexp.MustCompile(`^` + checkColumnPattern + ` (>=|<=|<>|=|<|>) (` + checkLiteralPattern + `)$`)
+	checkArrayRe   = regexp.MustCompile(`^` + checkColumnPattern + ` (= ANY|<> ALL) \(\(?ARRAY\[(.*?)\]\)?(?:::[a-z ]+\[\])?\)$`)
+	checkLiteralRe = regexp.MustCompile(`^(?:'((?:[^']|'')*)'|\(?(-?[0-9]+(?:\.[0-9]+)?)\)?)` + checkCastPattern + `$`)
+)
+
+// numericCasts are the casts of the numeric literals, which are quoted when
+// they are negative, e.g. '-1'::integer
+var numericCasts = []string{"smallint", "integer", "bigint", "numeric", "real", "double precision"}
+
+// parseCheck parses the output of pg_get_constraintdef of the check
+// constraint into the conditions joined by AND. ok is false if any of
+// them is not a simple comparison of a column with literals.
+func parseCheck(def string) (conds []checkCond, ok bool) {
+	def = strings.TrimSuffix(def, " NOT VALID")
+	if !strings.HasPrefix(def, "CHECK ") {
+		return nil, false
+	}
+	for _, expr := range splitTopLevel(stripParens(strings.TrimPrefix(def, "CHECK ")), " AND ") {
+		cond, ok := parseCheckCond(stripParens(expr))
+		if !ok {
+			return nil, false
+		}
+		conds = append(conds, cond)
+	}
+	return conds, true
+}
+
+func parseCheckCond(expr string) (checkCond, bool) {
+	var lits []string
+	m := checkCompareRe.FindStringSubmatch(expr)
+	if m != nil {
+		lits = []string{m[3]}
+	} else if m = checkArrayRe.FindStringSubmatch(expr); m != nil {
+		lits = splitTopLevel(m[3], ", ")
+	} else {
+		return checkCond{}, false
+	}
+	cond := checkCond{column: m[1], op: m[2]}
+	if strings.HasPrefix(cond.column, `"`) {
+		cond.column = strings.ReplaceAll(strings.Trim(cond.column, `"`), `""`, `"`)
+	}
+	for _, l := range lits {
+		lm := checkLiteralRe.FindStringSubmatch(l)
+		if lm == nil {
+			return checkCond{}, false
+		}
+		if strings.HasPrefix(l, "'") && !contains(lm[3], numericCasts) {
+			cond.literals = append(cond.literals, checkLiteral{value: strings.ReplaceAll(lm[1], "''", "'"), isString: true})
+		} else {
+			cond.literals = append(cond.literals, checkLiteral{value: lm[1] + lm[2]})
+		}
+	}
+	return cond, true
+}
+
+// scanTopLevel calls fn with the index of every byte of s out of the string
+// literals, quoted identifiers and parentheses
+func scanTopLevel(s string, fn func(i int)) {
+	depth := 0
+	var quote byte
+	for i := 0; i < len(s); i++ {
+		switch ch := s[i]; {
+		case quote != 0:
+			if ch == quote {
+				quote = 0
+			}
+		case ch == '\'' || ch == '"':
+			quote = ch
+		case ch == '(':
+			depth++
+		case ch == ')':
+			depth--
+		case depth == 0:
+			fn(i)
+		}
+	}
+}
+
+// splitTopLevel splits s by sep out of the string literals and parentheses
+func splitTopLevel(s, sep string) []string {
+	var parts []string
+	start := 0
+	scanTopLevel(s, func(i int) {
+		if i >= start && strings.HasPrefix(s[i:], sep) {
+			parts = append(parts, s[start:i])
+			start = i + len(sep)
+		}
+	})
+	return append(parts, s[start:])
+}
+
+// stripParens strips the parentheses enclosing the whole s
+func stripParens(s string) string {
+	for strings.HasPrefix(s, "(") && strings.HasSuffix(s, ")") {
+		inner := s[1 : len(s)-1]
+		enclosed := true
+		depth := 0
+		var quote byte
+		for i := 0; i < len(inner) && enclosed; i++ {
+			switch ch := inner[i]; {
+			case quote != 0:
+				if ch == quote {
+					quote = 0
+				}
+			case ch == '\'' || ch == '"':
+				quote = ch
+			case ch == '(':
+				depth++
+			case ch == ')':
+				depth--
+				enclosed = depth >= 0
+			}
+		}
+		if !enclosed {
+			break
+		}
+		s = inner
+	}
+	return s
+}
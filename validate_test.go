@@ -0,0 +1,113 @@
+package main
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseCheck(t *testing.T) {
+	tests := []struct {
+		def      string
+		expected []checkCond
+		ok       bool
+	}{
+		{
+			"CHECK ((i >= 0))",
+			[]checkCond{{column: "i", op: ">=", literals: []checkLiteral{{value: "0"}}}},
+			true,
+		},
+		{
+			"CHECK (((score >= 0) AND (score <= 100)))",
+			[]checkCond{
+				{column: "score", op: ">=", literals: []checkLiteral{{value: "0"}}},
+				{column: "score", op: "<=", literals: []checkLiteral{{value: "100"}}},
+			},
+			true,
+		},
+		{
+			"CHECK ((price > (0)::numeric))",
+			[]checkCond{{column: "price", op: ">", literals: []checkLiteral{{value: "0"}}}},
+			true,
+		},
+		{
+			"CHECK ((i > '-1'::integer)) NOT VALID",
+			[]checkCond{{column: "i", op: ">", literals: []checkLiteral{{value: "-1"}}}},
+			true,
+		},
+		{
+			"CHECK ((status = ANY (ARRAY['active'::text, 'it''s'::text])))",
+			[]checkCond{{column: "status", op: "= ANY", literals: []checkLiteral{
+				{value: "active", isString: true}, {value: "it's", isString: true},
+			}}},
+			true,
+		},
+		{
+			"CHECK (((status)::text = ANY ((ARRAY['a'::character varying, 'b, c'::character varying])::text[])))",
+			[]checkCond{{column: "status", op: "= ANY", literals: []checkLiteral{
+				{value: "a", isString: true}, {value: "b, c", isString: true},
+			}}},
+			true,
+		},
+		{
+			`CHECK (("Kind" <> ALL (ARRAY[1, 2])))`,
+			[]checkCond{{column: "Kind", op: "<> ALL", literals: []checkLiteral{{value: "1"}, {value: "2"}}}},
+			true,
+		},
+		{"CHECK (((name)::text <> status))", nil, false},
+		{"CHECK (((i > 0) OR (i < -10)))", nil, false},
+		{"CHECK ((char_length(name) <= 10))", nil, false},
+		{"UNIQUE (i)", nil, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.def, func(t *testing.T) {
+			conds, ok := parseCheck(tt.def)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.expected, conds)
+		})
+	}
+}
+
+func TestCreateValidations(t *testing.T) {
+	col := func(name, dataType string, notNull bool, def string, maxLength int) *PgColumn {
+		return &PgColumn{
+			Name:         name,
+			DataType:     dataType,
+			NotNull:      notNull,
+			DefaultValue: sql.NullString{String: def, Valid: true},
+			MaxLength:    maxLength,
+		}
+	}
+	st := &Struct{
+		Name: "T1",
+		Table: &PgTable{
+			Name: "t1",
+			Checks: []*PgCheckConstraint{
+				{Name: "t1_i_check", Columns: []string{"i"}, Definition: "CHECK ((i >= 0))"},
+				{Name: "t1_kind_check", Columns: []string{"kind"}, Definition: "CHECK ((kind = ANY (ARRAY['a'::text, 'b'::text])))"},
+				{Name: "t1_ratio_check", Columns: []string{"i"}, Definition: "CHECK ((i < 0.5))"},
+				{Name: "t1_excluded_check", Columns: []string{"excluded"}, Definition: "CHECK ((excluded > 0))"},
+			},
+		},
+		Fields: []*StructField{
+			{Name: "Name", Type: "string", Column: col("name", "character varying", true, "", 16)},
+			{Name: "Code", Type: "sql.NullString", Column: col("code", "character", false, "", 3)},
+			{Name: "Kind", Type: "*string", Column: col("kind", "text", false, "", 0)},
+			{Name: "Status", Type: "string", Column: col("status", "text", true, "'active'::text", 0)},
+			{Name: "I", Type: "sql.Null[int32]", Column: col("i", "integer", false, "", 0)},
+		},
+	}
+
+	var got [][3]string
+	for _, v := range createValidations(st) {
+		got = append(got, [3]string{v.Field.Name, v.Constraint, v.Invalid})
+	}
+	assert.Equal(t, [][3]string{
+		{"Name", "", `r.Name == ""`},
+		{"Name", "", "utf8.RuneCountInString(r.Name) > 16"},
+		{"Code", "", "r.Code.Valid && utf8.RuneCountInString(r.Code.String) > 3"},
+		{"I", "t1_i_check", "r.I.Valid && !(r.I.V >= 0)"},
+		{"Kind", "t1_kind_check", `r.Kind != nil && !(*r.Kind == "a" || *r.Kind == "b")`},
+	}, got)
+}